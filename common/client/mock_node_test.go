@@ -157,6 +157,51 @@ func (_c *mockNode_HighestUserObservations_Call[CHAIN_ID, RPC]) RunAndReturn(run
 	return _c
 }
 
+// LatencyStats provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, RPC]) LatencyStats() NodeLatencyStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatencyStats")
+	}
+
+	var r0 NodeLatencyStats
+	if rf, ok := ret.Get(0).(func() NodeLatencyStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(NodeLatencyStats)
+	}
+
+	return r0
+}
+
+// mockNode_LatencyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatencyStats'
+type mockNode_LatencyStats_Call[CHAIN_ID types.ID, RPC any] struct {
+	*mock.Call
+}
+
+// LatencyStats is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, RPC]) LatencyStats() *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	return &mockNode_LatencyStats_Call[CHAIN_ID, RPC]{Call: _e.mock.On("LatencyStats")}
+}
+
+func (_c *mockNode_LatencyStats_Call[CHAIN_ID, RPC]) Run(run func()) *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_LatencyStats_Call[CHAIN_ID, RPC]) Return(_a0 NodeLatencyStats) *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_LatencyStats_Call[CHAIN_ID, RPC]) RunAndReturn(run func() NodeLatencyStats) *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, RPC]) Name() string {
 	ret := _m.Called()
@@ -548,6 +593,51 @@ func (_c *mockNode_UnsubscribeAllExceptAliveLoop_Call[CHAIN_ID, RPC]) RunAndRetu
 	return _c
 }
 
+// Weight provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, RPC]) Weight() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Weight")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// mockNode_Weight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Weight'
+type mockNode_Weight_Call[CHAIN_ID types.ID, RPC any] struct {
+	*mock.Call
+}
+
+// Weight is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, RPC]) Weight() *mockNode_Weight_Call[CHAIN_ID, RPC] {
+	return &mockNode_Weight_Call[CHAIN_ID, RPC]{Call: _e.mock.On("Weight")}
+}
+
+func (_c *mockNode_Weight_Call[CHAIN_ID, RPC]) Run(run func()) *mockNode_Weight_Call[CHAIN_ID, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_Weight_Call[CHAIN_ID, RPC]) Return(_a0 uint32) *mockNode_Weight_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_Weight_Call[CHAIN_ID, RPC]) RunAndReturn(run func() uint32) *mockNode_Weight_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // newMockNode creates a new instance of mockNode. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockNode[CHAIN_ID types.ID, RPC any](t interface {
@@ -59,13 +59,14 @@ func NewMultiNode[
 	lggr logger.Logger,
 	selectionMode string, // type of the "best" RPC selector (e.g HighestHead, RoundRobin, etc.)
 	leaseDuration time.Duration, // defines interval on which new "best" RPC should be selected
+	switchMarginPercent uint32, // how much better another RPC must score to replace the selected one, only used by LatencyWeighted
 	primaryNodes []Node[CHAIN_ID, RPC],
 	sendOnlyNodes []SendOnlyNode[CHAIN_ID, RPC],
 	chainID CHAIN_ID, // configured chain ID (used to verify that passed primaryNodes belong to the same chain)
 	chainFamily string, // name of the chain family - used in the metrics
 	deathDeclarationDelay time.Duration,
 ) *MultiNode[CHAIN_ID, RPC] {
-	nodeSelector := newNodeSelector(selectionMode, primaryNodes)
+	nodeSelector := newNodeSelector(selectionMode, primaryNodes, leaseDuration, switchMarginPercent)
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
 	// aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 	const reportInterval = 6500 * time.Millisecond
@@ -29,6 +29,7 @@ type multiNodeOpts struct {
 	logger                logger.Logger
 	selectionMode         string
 	leaseDuration         time.Duration
+	switchMarginPercent   uint32
 	nodes                 []Node[types.ID, multiNodeRPCClient]
 	sendonlys             []SendOnlyNode[types.ID, multiNodeRPCClient]
 	chainID               types.ID
@@ -42,7 +43,7 @@ func newTestMultiNode(t *testing.T, opts multiNodeOpts) testMultiNode {
 	}
 
 	result := NewMultiNode[types.ID, multiNodeRPCClient](
-		opts.logger, opts.selectionMode, opts.leaseDuration, opts.nodes, opts.sendonlys, opts.chainID, opts.chainFamily, opts.deathDeclarationDelay)
+		opts.logger, opts.selectionMode, opts.leaseDuration, opts.switchMarginPercent, opts.nodes, opts.sendonlys, opts.chainID, opts.chainFamily, opts.deathDeclarationDelay)
 	return testMultiNode{
 		result,
 	}
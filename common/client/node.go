@@ -79,6 +79,10 @@ type Node[
 	ConfiguredChainID() CHAIN_ID
 	// Order - returns priority order configured for the RPC
 	Order() int32
+	// Weight - returns the selection weight configured for the RPC, used by the LatencyWeighted selector
+	Weight() uint32
+	// LatencyStats - returns the moving averages of the latency and failure ratio of calls made to the RPC by the node
+	LatencyStats() NodeLatencyStats
 	// Start - starts health checks
 	Start(context.Context) error
 	Close() error
@@ -97,6 +101,7 @@ type node[
 	nodePoolCfg NodeConfig
 	chainCfg    ChainConfig
 	order       int32
+	weight      uint32
 	chainFamily string
 
 	ws   *url.URL
@@ -109,6 +114,10 @@ type node[
 
 	poolInfoProvider PoolChainInfoProvider
 
+	latency latencyTracker
+	// rpcObservesCalls is true if the RPC reports every call made through it, including the health checks.
+	rpcObservesCalls bool
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
@@ -130,6 +139,7 @@ func NewNode[
 	id int,
 	chainID CHAIN_ID,
 	nodeOrder int32,
+	nodeWeight uint32,
 	rpc RPC,
 	chainFamily string,
 ) Node[CHAIN_ID, RPC] {
@@ -140,6 +150,7 @@ func NewNode[
 	n.nodePoolCfg = nodeCfg
 	n.chainCfg = chainCfg
 	n.order = nodeOrder
+	n.weight = nodeWeight
 	if wsuri != nil {
 		n.ws = wsuri
 	}
@@ -154,9 +165,14 @@ func NewNode[
 		"node", n.String(),
 		"chainID", chainID,
 		"nodeOrder", n.order,
+		"nodeWeight", n.weight,
 	)
 	n.lfcLog = logger.Named(lggr, "Lifecycle")
 	n.rpc = rpc
+	if o, ok := any(rpc).(CallObservable); ok {
+		o.SetCallObserver(n.observeRPCCall)
+		n.rpcObservesCalls = true
+	}
 	n.chainFamily = chainFamily
 	return n
 }
@@ -268,7 +284,10 @@ func (n *node[CHAIN_ID, HEAD, RPC]) verifyChainID(callerCtx context.Context, lgg
 
 	var chainID CHAIN_ID
 	var err error
-	if chainID, err = n.rpc.ChainID(callerCtx); err != nil {
+	start := time.Now()
+	chainID, err = n.rpc.ChainID(callerCtx)
+	n.observeCall(callerCtx, start, err)
+	if err != nil {
 		promFailed()
 		lggr.Errorw("Failed to verify chain ID for node", "err", err, "nodeState", n.getCachedState())
 		return nodeStateUnreachable
@@ -310,7 +329,9 @@ func (n *node[CHAIN_ID, HEAD, RPC]) verifyConn(ctx context.Context, lggr logger.
 	}
 
 	if n.nodePoolCfg.NodeIsSyncingEnabled() {
+		start := time.Now()
 		isSyncing, err := n.rpc.IsSyncing(ctx)
+		n.observeCall(ctx, start, err)
 		if err != nil {
 			lggr.Errorw("Unexpected error while verifying RPC node synchronization status", "err", err, "nodeState", n.getCachedState())
 			return nodeStateUnreachable
@@ -329,6 +350,32 @@ func (n *node[CHAIN_ID, HEAD, RPC]) Order() int32 {
 	return n.order
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) Weight() uint32 {
+	return n.weight
+}
+
+func (n *node[CHAIN_ID, HEAD, RPC]) LatencyStats() NodeLatencyStats {
+	return n.latency.get()
+}
+
+// observeCall records the latency and outcome of a health check call to the RPC which started at start, unless the
+// RPC already reports its calls. Calls aborted because ctx was cancelled, e.g. on shutdown, are not the RPC's fault
+// and are ignored.
+func (n *node[CHAIN_ID, HEAD, RPC]) observeCall(ctx context.Context, start time.Time, err error) {
+	if n.rpcObservesCalls || (err != nil && errors.Is(ctx.Err(), context.Canceled)) {
+		return
+	}
+	n.latency.observe(time.Since(start), err)
+}
+
+// observeRPCCall records a call reported by the RPC.
+func (n *node[CHAIN_ID, HEAD, RPC]) observeRPCCall(latency time.Duration, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	n.latency.observe(latency, err)
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) newCtx() (context.Context, context.CancelFunc) {
 	ctx, cancel := n.stopCh.NewCtx()
 	ctx = CtxAddHealthCheckFlag(ctx)
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// latencyEWMAAlpha is the weight of the latest call in the moving averages of a node's latency and failure ratio.
+const latencyEWMAAlpha = 0.2
+
+// NodeLatencyStats are the exponentially weighted moving averages of the latency and failure ratio of calls to a node.
+type NodeLatencyStats struct {
+	// Latency of successful calls.
+	Latency time.Duration
+	// FailureRatio is between 0, if no recent call failed, and 1, if all of them did.
+	FailureRatio float64
+	// Calls is the number of calls observed.
+	Calls uint64
+}
+
+// CallObservable is implemented by RPCs which report the latency and outcome of every call made through them, so
+// that the selection of nodes reflects the calls made by the MultiNode's users as well as the health checks. Nodes of
+// other RPCs only observe their health checks.
+type CallObservable interface {
+	// SetCallObserver registers the function called after each call. It is called once, before the node is started.
+	SetCallObserver(observe func(latency time.Duration, err error))
+}
+
+// latencyTracker keeps the NodeLatencyStats of a node up to date. It is safe for concurrent use.
+type latencyTracker struct {
+	mu    sync.RWMutex
+	stats NodeLatencyStats
+}
+
+// observe records a call which took the given time and failed if err is not nil.
+func (t *latencyTracker) observe(latency time.Duration, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	failure := 0.0
+	if err != nil {
+		failure = 1
+	}
+	if t.stats.Calls == 0 {
+		t.stats.FailureRatio = failure
+		if err == nil {
+			t.stats.Latency = latency
+		}
+	} else {
+		t.stats.FailureRatio += latencyEWMAAlpha * (failure - t.stats.FailureRatio)
+		// Failed calls often time out, so their latency says little about the node's responsiveness.
+		if err == nil {
+			if t.stats.Latency == 0 {
+				t.stats.Latency = latency
+			} else {
+				t.stats.Latency += time.Duration(latencyEWMAAlpha * float64(latency-t.stats.Latency))
+			}
+		}
+	}
+	t.stats.Calls++
+}
+
+func (t *latencyTracker) get() NodeLatencyStats {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.stats
+}
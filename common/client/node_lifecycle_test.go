@@ -1637,7 +1637,7 @@ func TestUnit_NodeLifecycle_outOfSyncWithPool(t *testing.T) {
 			},
 		}
 
-		for _, selectionMode := range []string{NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel} {
+		for _, selectionMode := range []string{NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLatencyWeighted} {
 			node := newTestNode(t, testNodeOpts{
 				config: testNodeConfig{
 					syncThreshold: syncThreshold,
@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -11,6 +12,7 @@ const (
 	NodeSelectionModeRoundRobin      = "RoundRobin"
 	NodeSelectionModeTotalDifficulty = "TotalDifficulty"
 	NodeSelectionModePriorityLevel   = "PriorityLevel"
+	NodeSelectionModeLatencyWeighted = "LatencyWeighted"
 )
 
 type NodeSelector[
@@ -27,7 +29,7 @@ type NodeSelector[
 func newNodeSelector[
 	CHAIN_ID types.ID,
 	RPC any,
-](selectionMode string, nodes []Node[CHAIN_ID, RPC]) NodeSelector[CHAIN_ID, RPC] {
+](selectionMode string, nodes []Node[CHAIN_ID, RPC], leaseDuration time.Duration, switchMarginPercent uint32) NodeSelector[CHAIN_ID, RPC] {
 	switch selectionMode {
 	case NodeSelectionModeHighestHead:
 		return NewHighestHeadNodeSelector[CHAIN_ID, RPC](nodes)
@@ -37,6 +39,8 @@ func newNodeSelector[
 		return NewTotalDifficultyNodeSelector[CHAIN_ID, RPC](nodes)
 	case NodeSelectionModePriorityLevel:
 		return NewPriorityLevelNodeSelector[CHAIN_ID, RPC](nodes)
+	case NodeSelectionModeLatencyWeighted:
+		return NewLatencyWeightedNodeSelector[CHAIN_ID, RPC](nodes, leaseDuration, switchMarginPercent)
 	default:
 		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
 	}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// latencyWeightedMaxFailureRatio is the failure ratio above which a node is considered unhealthy, and only
+	// selected if all alive nodes are unhealthy.
+	latencyWeightedMaxFailureRatio = 0.5
+	// latencyWeightedFailurePenalty scales the score of a node by its failure ratio, so that a node failing half of its
+	// calls scores as if it was six times slower.
+	latencyWeightedFailurePenalty = 10
+	// latencyWeightedMinLatency is the latency assumed for nodes without observed calls, or faster than it.
+	latencyWeightedMinLatency = time.Millisecond
+)
+
+type latencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+] struct {
+	nodes []Node[CHAIN_ID, RPC]
+	// leaseDuration is the minimum duration a healthy node is kept selected.
+	leaseDuration time.Duration
+	// switchMargin is the fraction by which the score of the best node must beat the selected one to switch to it.
+	switchMargin float64
+	// now returns the current time, it is only replaced in tests.
+	now func() time.Time
+
+	mu            sync.Mutex
+	selected      Node[CHAIN_ID, RPC]
+	selectedSince time.Time
+}
+
+// NewLatencyWeightedNodeSelector returns a NodeSelector which selects the alive node with the best score, which is
+// based on the moving averages of its call latency and failure ratio, divided by its configured weight. The stats of
+// every node are kept up to date by its health checks.
+//
+// The selected node is kept while it is alive for at least leaseDuration, and then until another node scores better
+// by more than switchMarginPercent, so that subscriptions are not moved between nodes of similar latency.
+// Unhealthy nodes, failing more than half of their calls, are only selected if no healthy node is alive, and are
+// replaced as soon as one is.
+func NewLatencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+](nodes []Node[CHAIN_ID, RPC], leaseDuration time.Duration, switchMarginPercent uint32) NodeSelector[CHAIN_ID, RPC] {
+	return &latencyWeightedNodeSelector[CHAIN_ID, RPC]{
+		nodes:         nodes,
+		leaseDuration: leaseDuration,
+		switchMargin:  float64(switchMarginPercent) / 100,
+		now:           time.Now,
+	}
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Select() Node[CHAIN_ID, RPC] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var best, current scoredNode[CHAIN_ID, RPC]
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		scored := newScoredNode(n)
+		if n == s.selected {
+			current = scored
+		}
+		if best.node == nil || scored.betterThan(best) {
+			best = scored
+		}
+	}
+
+	if current.node != nil && current.healthy == best.healthy &&
+		(s.now().Sub(s.selectedSince) < s.leaseDuration || current.score <= best.score*(1+s.switchMargin)) {
+		return current.node
+	}
+	s.selected, s.selectedSince = best.node, s.now()
+	return best.node
+}
+
+// scoredNode is an alive node, with its score.
+type scoredNode[
+	CHAIN_ID types.ID,
+	RPC any,
+] struct {
+	node    Node[CHAIN_ID, RPC]
+	score   float64
+	healthy bool
+}
+
+func newScoredNode[
+	CHAIN_ID types.ID,
+	RPC any,
+](n Node[CHAIN_ID, RPC]) scoredNode[CHAIN_ID, RPC] {
+	stats := n.LatencyStats()
+	return scoredNode[CHAIN_ID, RPC]{
+		node:    n,
+		score:   latencyWeightedScore(stats, n.Weight()),
+		healthy: stats.FailureRatio <= latencyWeightedMaxFailureRatio,
+	}
+}
+
+// betterThan orders healthy nodes first, then by score, and breaks ties by the configured order.
+func (s scoredNode[CHAIN_ID, RPC]) betterThan(other scoredNode[CHAIN_ID, RPC]) bool {
+	if s.healthy != other.healthy {
+		return s.healthy
+	}
+	if s.score != other.score {
+		return s.score < other.score
+	}
+	return s.node.Order() < other.node.Order()
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Name() string {
+	return NodeSelectionModeLatencyWeighted
+}
+
+// latencyWeightedScore returns the score of a node, lower is better.
+func latencyWeightedScore(stats NodeLatencyStats, weight uint32) float64 {
+	latency := max(stats.Latency, latencyWeightedMinLatency)
+	if weight == 0 {
+		weight = 1
+	}
+	return float64(latency) * (1 + latencyWeightedFailurePenalty*stats.FailureRatio) / float64(weight)
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeLatencyWeighted, nil, 0, 0)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLatencyWeighted)
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+	type nodeParams struct {
+		state  nodeState
+		stats  NodeLatencyStats
+		weight uint32
+		order  int32
+	}
+	newNodes := func(t *testing.T, params []*nodeParams) []Node[types.ID, nodeClient] {
+		var nodes []Node[types.ID, nodeClient]
+		for _, p := range params {
+			node := newMockNode[types.ID, nodeClient](t)
+			node.On("State").Return(func() nodeState { return p.state })
+			node.On("LatencyStats").Return(func() NodeLatencyStats { return p.stats }).Maybe()
+			node.On("Weight").Return(p.weight).Maybe()
+			node.On("Order").Return(p.order).Maybe()
+			nodes = append(nodes, node)
+		}
+		return nodes
+	}
+	selectBest := func(nodes []Node[types.ID, nodeClient]) Node[types.ID, nodeClient] {
+		return newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, 0, 0).Select()
+	}
+
+	t.Run("selects only alive nodes", func(t *testing.T) {
+		nodes := newNodes(t, []*nodeParams{
+			{state: nodeStateOutOfSync, stats: NodeLatencyStats{Latency: time.Millisecond}, weight: 1},
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 300 * time.Millisecond}, weight: 1},
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 50 * time.Millisecond}, weight: 1},
+		})
+		assert.Same(t, nodes[2], selectBest(nodes))
+	})
+
+	t.Run("weight favours slower node", func(t *testing.T) {
+		nodes := newNodes(t, []*nodeParams{
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 50 * time.Millisecond}, weight: 1},
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 90 * time.Millisecond}, weight: 2},
+		})
+		assert.Same(t, nodes[1], selectBest(nodes))
+	})
+
+	t.Run("failures penalize node", func(t *testing.T) {
+		nodes := newNodes(t, []*nodeParams{
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 50 * time.Millisecond, FailureRatio: 0.3}, weight: 1},
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 100 * time.Millisecond}, weight: 1},
+		})
+		// the failing node scores as if it took 200ms
+		assert.Same(t, nodes[1], selectBest(nodes))
+	})
+
+	t.Run("ties are broken by order", func(t *testing.T) {
+		nodes := newNodes(t, []*nodeParams{
+			{state: nodeStateAlive, weight: 1, order: 2},
+			{state: nodeStateAlive, weight: 1, order: 1},
+		})
+		assert.Same(t, nodes[1], selectBest(nodes))
+	})
+
+	t.Run("unhealthy node only selected as last resort", func(t *testing.T) {
+		nodes := newNodes(t, []*nodeParams{
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: time.Millisecond, FailureRatio: 0.6}, weight: 100},
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: time.Second, FailureRatio: 0.5}, weight: 1},
+		})
+		assert.Same(t, nodes[1], selectBest(nodes))
+
+		nodes = newNodes(t, []*nodeParams{
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: time.Millisecond, FailureRatio: 0.6}, weight: 1},
+			{state: nodeStateUnreachable},
+		})
+		assert.Same(t, nodes[0], selectBest(nodes))
+	})
+
+	t.Run("none alive", func(t *testing.T) {
+		nodes := newNodes(t, []*nodeParams{
+			{state: nodeStateOutOfSync},
+			{state: nodeStateUnreachable},
+		})
+		assert.Nil(t, selectBest(nodes))
+	})
+
+	t.Run("keeps the selected node", func(t *testing.T) {
+		params := []*nodeParams{
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 100 * time.Millisecond}, weight: 1},
+			{state: nodeStateAlive, stats: NodeLatencyStats{Latency: 110 * time.Millisecond}, weight: 1},
+		}
+		nodes := newNodes(t, params)
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, time.Minute, 20)
+		now := time.Now()
+		selector.(*latencyWeightedNodeSelector[types.ID, nodeClient]).now = func() time.Time { return now }
+		require.Same(t, nodes[0], selector.Select())
+
+		// the other node is better, but not by more than 20%
+		params[1].stats.Latency = 90 * time.Millisecond
+		now = now.Add(time.Hour)
+		assert.Same(t, nodes[0], selector.Select())
+
+		// the other node is better by more than 20%, but the lease has not expired
+		params[1].stats.Latency = 50 * time.Millisecond
+		now = now.Add(time.Hour)
+		params[0].state = nodeStateOutOfSync
+		require.Same(t, nodes[1], selector.Select())
+		params[0].state = nodeStateAlive
+		params[0].stats.Latency = 10 * time.Millisecond
+		now = now.Add(30 * time.Second)
+		assert.Same(t, nodes[1], selector.Select())
+
+		// once it has, the better node is selected
+		now = now.Add(30 * time.Second)
+		assert.Same(t, nodes[0], selector.Select())
+		assert.Same(t, nodes[0], selector.Select())
+
+		// an unhealthy node is replaced by a healthy one within its lease
+		params[0].stats.FailureRatio = 0.6
+		assert.Same(t, nodes[1], selector.Select())
+	})
+}
+
+func TestLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	var tracker latencyTracker
+	assert.Equal(t, NodeLatencyStats{}, tracker.get())
+
+	tracker.observe(100*time.Millisecond, nil)
+	assert.Equal(t, NodeLatencyStats{Latency: 100 * time.Millisecond, Calls: 1}, tracker.get())
+
+	tracker.observe(200*time.Millisecond, nil)
+	stats := tracker.get()
+	assert.Equal(t, 120*time.Millisecond, stats.Latency)
+	assert.Zero(t, stats.FailureRatio)
+
+	// failures don't affect latency
+	tracker.observe(10*time.Second, errors.New("timeout"))
+	stats = tracker.get()
+	assert.Equal(t, 120*time.Millisecond, stats.Latency)
+	assert.InDelta(t, latencyEWMAAlpha, stats.FailureRatio, 1e-9)
+	assert.Equal(t, uint64(3), stats.Calls)
+
+	var failing latencyTracker
+	failing.observe(time.Second, errors.New("unreachable"))
+	assert.Equal(t, NodeLatencyStats{FailureRatio: 1, Calls: 1}, failing.get())
+	failing.observe(50*time.Millisecond, nil)
+	assert.Equal(t, 50*time.Millisecond, failing.get().Latency)
+}
+
+type observableRPCClient struct {
+	*mockRPCClient[types.ID, Head]
+	observe func(latency time.Duration, err error)
+}
+
+func (c *observableRPCClient) SetCallObserver(observe func(latency time.Duration, err error)) {
+	c.observe = observe
+}
+
+func TestNode_ObservesRPCCalls(t *testing.T) {
+	t.Parallel()
+
+	rpc := &observableRPCClient{mockRPCClient: newMockRPCClient[types.ID, Head](t)}
+	n := NewNode[types.ID, Head, RPCClient[types.ID, Head]](testNodeConfig{}, nil, logger.Test(t),
+		nil, nil, "test node", 42, types.RandomID(), 0, 1, rpc, "test node chain family").(*node[types.ID, Head, RPCClient[types.ID, Head]])
+	require.NotNil(t, rpc.observe)
+
+	rpc.observe(100*time.Millisecond, nil)
+	rpc.observe(time.Second, errors.New("connection refused"))
+	// calls cancelled by the caller are ignored
+	rpc.observe(time.Second, context.Canceled)
+	stats := n.LatencyStats()
+	assert.Equal(t, uint64(2), stats.Calls)
+	assert.Equal(t, 100*time.Millisecond, stats.Latency)
+
+	// health checks are not counted twice
+	n.observeCall(context.Background(), time.Now(), nil)
+	assert.Equal(t, uint64(2), n.LatencyStats().Calls)
+}
@@ -73,6 +73,7 @@ type testNodeOpts struct {
 	id          int
 	chainID     types.ID
 	nodeOrder   int32
+	nodeWeight  uint32
 	rpc         *mockRPCClient[types.ID, Head]
 	chainFamily string
 }
@@ -99,7 +100,7 @@ func newTestNode(t *testing.T, opts testNodeOpts) testNode {
 	}
 
 	nodeI := NewNode[types.ID, Head, RPCClient[types.ID, Head]](opts.config, opts.chainConfig, opts.lggr,
-		opts.wsuri, opts.httpuri, opts.name, opts.id, opts.chainID, opts.nodeOrder, opts.rpc, opts.chainFamily)
+		opts.wsuri, opts.httpuri, opts.name, opts.id, opts.chainID, opts.nodeOrder, opts.nodeWeight, opts.rpc, opts.chainFamily)
 
 	return testNode{
 		nodeI.(*node[types.ID, Head, RPCClient[types.ID, Head]]),
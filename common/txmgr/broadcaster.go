@@ -62,6 +62,16 @@ var (
 
 var ErrTxRemoved = errors.New("tx removed")
 
+// RevertError is returned by a TransmitChecker if the transaction would revert. The reason is stored on the fatally
+// errored transaction, next to the error.
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	return "transaction reverted during simulation: " + e.Reason
+}
+
 type ProcessUnstartedTxs[ADDR types.Hashable] func(ctx context.Context, fromAddress ADDR) (retryable bool, err error)
 
 // TransmitCheckerFactory creates a transmit checker based on a spec.
@@ -121,6 +131,7 @@ type Broadcaster[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	sequenceTracker txmgrtypes.SequenceTracker[ADDR, SEQ]
 	resumeCallback  ResumeCallback
+	events          *TxEvents[ADDR, TX_HASH]
 	chainID         CHAIN_ID
 	chainType       string
 	config          txmgrtypes.BroadcasterChainConfig
@@ -260,6 +271,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetR
 	eb.resumeCallback = callback
 }
 
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetTxEvents(events *TxEvents[ADDR, TX_HASH]) {
+	eb.events = events
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Name() string {
 	return eb.lggr.Name()
 }
@@ -467,6 +482,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		lgr.Warn("Transmission checker timed out, sending anyway")
 	} else if err != nil {
 		etx.Error = null.StringFrom(err.Error())
+		var revertErr *RevertError
+		if errors.As(err, &revertErr) {
+			etx.RevertReason = null.StringFrom(revertErr.Reason)
+		}
 		lgr.Warnw("Transmission checker failed, fatally erroring transaction.", "err", err)
 		return eb.saveFatallyErroredTransaction(lgr, etx), true
 	}
@@ -478,6 +497,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	} else if err != nil {
 		return fmt.Errorf("processUnstartedTxs failed on UpdateTxUnstartedToInProgress: %w", err), true
 	}
+	eb.events.Publish(NewTxAttemptEvent(TxEventInProgress, *etx, attempt))
 
 	return eb.handleInProgressTx(ctx, *etx, attempt, time.Now(), 0)
 }
@@ -554,6 +574,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		if err != nil {
 			return err, true
 		}
+		eb.events.Publish(NewTxAttemptEvent(TxEventUnconfirmed, etx, attempt))
 		// Increment sequence if successfully broadcasted
 		eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
 		return err, true
@@ -618,6 +639,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 			if err != nil {
 				return err, true
 			}
+			eb.events.Publish(NewTxAttemptEvent(TxEventUnconfirmed, etx, attempt))
 			// Increment sequence if successfully broadcasted
 			eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
 			return err, true
@@ -675,7 +697,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) vali
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) nextUnstartedTransactionWithSequence(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
-	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.txConfig.Priority().StarvationTimeout(), eb.chainID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// Finish. No more transactions left to process. Hoorah!
@@ -715,6 +737,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) repl
 	if err = eb.txStore.SaveReplacementInProgressAttempt(ctx, attempt, &bumpedAttempt); err != nil {
 		return bumpedAttempt, true, err
 	}
+	eb.events.Publish(NewTxAttemptEvent(TxEventBump, etx, bumpedAttempt))
 
 	lgr.Debugw("Bumped fee on initial send", "oldFee", attempt.TxFee.String(), "newFee", bumpedFee.String(), "newFeeLimit", bumpedFeeLimit)
 	return bumpedAttempt, true, err
@@ -769,7 +792,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) save
 			}
 		}
 	}
-	return eb.txStore.UpdateTxFatalError(ctx, etx)
+	if err := eb.txStore.UpdateTxFatalError(ctx, etx); err != nil {
+		return err
+	}
+	eb.events.Publish(NewTxEvent(TxEventFatalError, *etx))
+	return nil
 }
 
 func observeTimeUntilBroadcast[CHAIN_ID types.ID](chainID CHAIN_ID, createdAt, broadcastAt time.Time) {
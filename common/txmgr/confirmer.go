@@ -129,6 +129,7 @@ type Confirmer[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	resumeCallback  ResumeCallback
+	events          *TxEvents[ADDR, TX_HASH]
 	chainConfig     txmgrtypes.ConfirmerChainConfig
 	feeConfig       txmgrtypes.ConfirmerFeeConfig
 	txConfig        txmgrtypes.ConfirmerTransactionsConfig
@@ -251,6 +252,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Set
 	ec.resumeCallback = callback
 }
 
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetTxEvents(events *TxEvents[ADDR, TX_HASH]) {
+	ec.events = events
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Name() string {
 	return ec.lggr.Name()
 }
@@ -492,6 +497,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 		go func(tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
 			defer wg.Done()
 			lggr := tx.GetLogger(ec.lggr)
+			ec.events.Publish(NewTxEvent(TxEventStuckDetected, tx))
 			// Create a purge attempt for tx
 			purgeAttempt, err := ec.TxAttemptBuilder.NewPurgeTxAttempt(ctx, tx, lggr)
 			if err != nil {
@@ -508,6 +514,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 				return
 			}
 			lggr.Warnw("marked transaction as terminally stuck", "etx", tx)
+			ec.events.Publish(NewTxAttemptEvent(TxEventPurge, tx, purgeAttempt))
 			// Send purge attempt
 			if err := ec.handleInProgressAttempt(ctx, lggr, tx, purgeAttempt, blockNum); err != nil {
 				errMu.Lock()
@@ -597,6 +604,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 			return fmt.Errorf("saveFetchedReceipts failed: %w", err)
 		}
 		promNumConfirmedTxs.WithLabelValues(ec.chainID.String()).Add(float64(len(receipts)))
+		ec.publishReceiptEvents(batch, receipts, stuckTxFatalErrMsg)
 
 		allReceipts = append(allReceipts, receipts...)
 	}
@@ -625,6 +633,34 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sep
 	return
 }
 
+// publishReceiptEvents publishes a confirmed event for each attempt included on chain, or a fatal_error event if the
+// attempt was purging its transaction.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) publishReceiptEvents(attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], receipts []R, purgeErrMsg string) {
+	if ec.events == nil || len(receipts) == 0 {
+		return
+	}
+	receiptMap := make(map[TX_HASH]R)
+	for _, receipt := range receipts {
+		receiptMap[receipt.GetTxHash()] = receipt
+	}
+	for _, attempt := range attempts {
+		receipt, ok := receiptMap[attempt.Hash]
+		if !ok {
+			continue
+		}
+		ev := NewTxAttemptEvent(TxEventConfirmed, attempt.Tx, attempt)
+		if attempt.IsPurgeAttempt {
+			ev.Type = TxEventFatalError
+			ev.Error = purgeErrMsg
+		}
+		if blockNum := receipt.GetBlockNumber(); blockNum != nil {
+			n := blockNum.Int64()
+			ev.BlockNumber = &n
+		}
+		ec.events.Publish(ev)
+	}
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
 	if !etx.PipelineTaskRunID.Valid || ec.resumeCallback == nil || !etx.SignalCallback || etx.CallbackCompleted {
 		return nil
@@ -795,6 +831,9 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) reb
 		if err := ec.txStore.SaveInProgressAttempt(ctx, &attempt); err != nil {
 			return fmt.Errorf("saveInProgressAttempt failed: %w", err)
 		}
+		if len(etx.TxAttempts) > 0 {
+			ec.events.Publish(NewTxAttemptEvent(TxEventBump, *etx, attempt))
+		}
 
 		if err := ec.handleInProgressAttempt(ctx, lggr, *etx, attempt, blockHeight); err != nil {
 			return fmt.Errorf("handleInProgressAttempt failed: %w", err)
@@ -1199,52 +1238,74 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) mar
 	return nil
 }
 
+// RebroadcastResult is the outcome of force rebroadcasting a single sequence.
+type RebroadcastResult[SEQ types.Sequence] struct {
+	Sequence SEQ
+	// TxID is the ID of the rebroadcast tx, or nil if an empty transaction was sent instead
+	TxID   *int64
+	TxHash string
+	Err    error
+}
+
 // ForceRebroadcast sends a transaction for every sequence in the given sequence range at the given gas price.
 // If an tx exists for this sequence, we re-send the existing tx with the supplied parameters.
 // If an tx doesn't exist for this sequence, we send a zero transaction.
 // This operates completely orthogonal to the normal Confirmer and can result in untracked attempts!
 // Only for emergency usage.
 // This is in case of some unforeseen scenario where the node is refusing to release the lock. KISS.
-func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ForceRebroadcast(ctx context.Context, seqs []SEQ, fee FEE, address ADDR, overrideGasLimit uint64) error {
+// The outcome for each sequence is returned, in the order given.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ForceRebroadcast(ctx context.Context, seqs []SEQ, fee FEE, address ADDR, overrideGasLimit uint64) ([]RebroadcastResult[SEQ], error) {
 	if len(seqs) == 0 {
 		ec.lggr.Infof("ForceRebroadcast: No sequences provided. Skipping")
-		return nil
+		return nil, nil
 	}
 	ec.lggr.Infof("ForceRebroadcast: will rebroadcast transactions for all sequences between %v and %v", seqs[0], seqs[len(seqs)-1])
 
+	results := make([]RebroadcastResult[SEQ], 0, len(seqs))
 	for _, seq := range seqs {
 		etx, err := ec.txStore.FindTxWithSequence(ctx, address, seq)
 		if err != nil {
-			return fmt.Errorf("ForceRebroadcast failed: %w", err)
+			return results, fmt.Errorf("ForceRebroadcast failed: %w", err)
 		}
+		result := RebroadcastResult[SEQ]{Sequence: seq}
 		if etx == nil {
 			ec.lggr.Debugf("ForceRebroadcast: no tx found with sequence %s, will rebroadcast empty transaction", seq)
 			hashStr, err := ec.sendEmptyTransaction(ctx, address, seq, overrideGasLimit, fee)
 			if err != nil {
 				ec.lggr.Errorw("ForceRebroadcast: failed to send empty transaction", "sequence", seq, "err", err)
+				result.Err = err
+				results = append(results, result)
 				continue
 			}
 			ec.lggr.Infow("ForceRebroadcast: successfully rebroadcast empty transaction", "sequence", seq, "hash", hashStr)
+			result.TxHash = hashStr
 		} else {
 			ec.lggr.Debugf("ForceRebroadcast: got tx %v with sequence %v, will rebroadcast this transaction", etx.ID, *etx.Sequence)
+			result.TxID = &etx.ID
 			if overrideGasLimit != 0 {
 				etx.FeeLimit = overrideGasLimit
 			}
 			attempt, _, err := ec.NewCustomTxAttempt(ctx, *etx, fee, etx.FeeLimit, 0x0, ec.lggr)
 			if err != nil {
 				ec.lggr.Errorw("ForceRebroadcast: failed to create new attempt", "txID", etx.ID, "err", err)
+				result.Err = err
+				results = append(results, result)
 				continue
 			}
 			attempt.Tx = *etx // for logging
 			ec.lggr.Debugw("Sending transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "meta", etx.Meta, "feeLimit", attempt.ChainSpecificFeeLimit, "callerProvidedFeeLimit", etx.FeeLimit, "attempt", attempt)
+			result.TxHash = attempt.Hash.String()
 			if errCode, err := ec.client.SendTransactionReturnCode(ctx, *etx, attempt, ec.lggr); errCode != client.Successful && err != nil {
 				ec.lggr.Errorw(fmt.Sprintf("ForceRebroadcast: failed to rebroadcast tx %v with sequence %v, gas limit %v, and caller provided fee Limit %v	: %s", etx.ID, *etx.Sequence, attempt.ChainSpecificFeeLimit, etx.FeeLimit, err.Error()), "err", err, "fee", attempt.TxFee)
+				result.Err = err
+				results = append(results, result)
 				continue
 			}
 			ec.lggr.Infof("ForceRebroadcast: successfully rebroadcast tx %v with hash: 0x%x", etx.ID, attempt.Hash)
 		}
+		results = append(results, result)
 	}
-	return nil
+	return results, nil
 }
 
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sendEmptyTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, overrideGasLimit uint64, fee FEE) (string, error) {
@@ -0,0 +1,200 @@
+package txmgr
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// txEventBufferSize is the number of events a subscription buffers. Events are dropped while the buffer is full.
+const txEventBufferSize = 100
+
+// TxEventType is the type of a transaction lifecycle event.
+type TxEventType string
+
+const (
+	// TxEventUnstarted is emitted when a transaction is created.
+	TxEventUnstarted TxEventType = "unstarted"
+	// TxEventInProgress is emitted when a transaction is assigned a sequence, before it is first sent.
+	TxEventInProgress TxEventType = "in_progress"
+	// TxEventUnconfirmed is emitted when a transaction is first broadcast.
+	TxEventUnconfirmed TxEventType = "unconfirmed"
+	// TxEventConfirmed is emitted when a receipt is saved for a transaction.
+	TxEventConfirmed TxEventType = "confirmed"
+	// TxEventFinalized is emitted when the block including a transaction is finalized.
+	TxEventFinalized TxEventType = "finalized"
+	// TxEventFatalError is emitted when a transaction fails permanently.
+	TxEventFatalError TxEventType = "fatal_error"
+	// TxEventBump is emitted when a new attempt with a bumped fee is created for a transaction.
+	TxEventBump TxEventType = "bump"
+	// TxEventResend is emitted when the attempts of a transaction are resent.
+	TxEventResend TxEventType = "resend"
+	// TxEventStuckDetected is emitted when a transaction is detected as terminally stuck.
+	TxEventStuckDetected TxEventType = "stuck_detected"
+	// TxEventPurge is emitted when a purge attempt is created for a stuck transaction.
+	TxEventPurge TxEventType = "purge"
+)
+
+// TxEvent is a transition in the lifecycle of a transaction.
+type TxEvent[ADDR types.Hashable, TX_HASH types.Hashable] struct {
+	Type           TxEventType `json:"type"`
+	TxID           int64       `json:"txID,omitempty"`
+	IdempotencyKey *string     `json:"idempotencyKey,omitempty"`
+	FromAddress    ADDR        `json:"fromAddress"`
+	ToAddress      ADDR        `json:"toAddress"`
+	Sequence       *int64      `json:"sequence,omitempty"`
+	// TxHash is the hash of the attempt sent, bumped, resent or included.
+	TxHash            *TX_HASH      `json:"txHash,omitempty"`
+	BlockNumber       *int64        `json:"blockNumber,omitempty"`
+	PipelineTaskRunID uuid.NullUUID `json:"pipelineTaskRunID"`
+	Error             string        `json:"error,omitempty"`
+	Timestamp         time.Time     `json:"timestamp"`
+}
+
+// NewTxEvent returns an event of the given type for the transaction.
+func NewTxEvent[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+](typ TxEventType, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) TxEvent[ADDR, TX_HASH] {
+	ev := TxEvent[ADDR, TX_HASH]{
+		Type:              typ,
+		TxID:              tx.ID,
+		IdempotencyKey:    tx.IdempotencyKey,
+		FromAddress:       tx.FromAddress,
+		ToAddress:         tx.ToAddress,
+		PipelineTaskRunID: tx.PipelineTaskRunID,
+		Error:             tx.Error.String,
+		Timestamp:         time.Now(),
+	}
+	if tx.Sequence != nil {
+		seq := (*tx.Sequence).Int64()
+		ev.Sequence = &seq
+	}
+	return ev
+}
+
+// NewTxAttemptEvent returns an event of the given type for the transaction, and the attempt sent or saved.
+func NewTxAttemptEvent[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+](typ TxEventType, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) TxEvent[ADDR, TX_HASH] {
+	ev := NewTxEvent(typ, tx)
+	if ev.TxID == 0 {
+		ev.TxID = attempt.TxID
+	}
+	hash := attempt.Hash
+	ev.TxHash = &hash
+	return ev
+}
+
+// TxEvents fans out transaction lifecycle events to in-process subscribers. Publishing never blocks: events are
+// dropped for subscribers which do not keep up. A nil *TxEvents discards all events.
+type TxEvents[ADDR types.Hashable, TX_HASH types.Hashable] struct {
+	lggr logger.SugaredLogger
+
+	mu     sync.Mutex
+	subs   map[*TxEventSubscription[ADDR, TX_HASH]]struct{}
+	closed bool
+}
+
+func NewTxEvents[ADDR types.Hashable, TX_HASH types.Hashable](lggr logger.Logger) *TxEvents[ADDR, TX_HASH] {
+	return &TxEvents[ADDR, TX_HASH]{
+		lggr: logger.Sugared(logger.Named(lggr, "TxEvents")),
+		subs: make(map[*TxEventSubscription[ADDR, TX_HASH]]struct{}),
+	}
+}
+
+// TxEventSubscription receives the events matching its filter, until it is closed.
+type TxEventSubscription[ADDR types.Hashable, TX_HASH types.Hashable] struct {
+	filter func(TxEvent[ADDR, TX_HASH]) bool
+	ch     chan TxEvent[ADDR, TX_HASH]
+	events *TxEvents[ADDR, TX_HASH]
+
+	closeOnce sync.Once
+	dropped   int64 // guarded by events.mu
+}
+
+// Events returns the channel on which events are delivered. It is closed when the subscription is closed.
+func (s *TxEventSubscription[ADDR, TX_HASH]) Events() <-chan TxEvent[ADDR, TX_HASH] {
+	return s.ch
+}
+
+// Close stops the delivery of events. It is safe to call more than once.
+func (s *TxEventSubscription[ADDR, TX_HASH]) Close() {
+	if s.events != nil {
+		s.events.mu.Lock()
+		defer s.events.mu.Unlock()
+		delete(s.events.subs, s)
+	}
+	s.closeOnce.Do(func() { close(s.ch) })
+}
+
+// Subscribe returns a subscription to the events matching filter, or all events if filter is nil.
+func (e *TxEvents[ADDR, TX_HASH]) Subscribe(filter func(TxEvent[ADDR, TX_HASH]) bool) *TxEventSubscription[ADDR, TX_HASH] {
+	sub := &TxEventSubscription[ADDR, TX_HASH]{
+		filter: filter,
+		ch:     make(chan TxEvent[ADDR, TX_HASH], txEventBufferSize),
+	}
+	if e == nil {
+		sub.Close()
+		return sub
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		sub.Close()
+		return sub
+	}
+	sub.events = e
+	e.subs[sub] = struct{}{}
+	return sub
+}
+
+// Close closes all the subscriptions, so that subscribers notice that no more events will be published. Subscriptions
+// created afterwards are closed immediately.
+func (e *TxEvents[ADDR, TX_HASH]) Close() {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+	for sub := range e.subs {
+		delete(e.subs, sub)
+		sub.closeOnce.Do(func() { close(sub.ch) })
+	}
+}
+
+// Publish delivers the event to the matching subscriptions.
+func (e *TxEvents[ADDR, TX_HASH]) Publish(ev TxEvent[ADDR, TX_HASH]) {
+	if e == nil {
+		return
+	}
+	// Hold the write lock so that a subscription cannot be closed while an event is sent to it.
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for sub := range e.subs {
+		if sub.filter != nil && !sub.filter(ev) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			sub.dropped++
+			e.lggr.Warnw("Dropped transaction event, subscriber is not keeping up", "type", ev.Type, "txID", ev.TxID, "dropped", sub.dropped)
+		}
+	}
+}
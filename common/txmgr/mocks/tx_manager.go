@@ -925,6 +925,39 @@ func (_c *TxManager_RegisterResumeCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, B
 	return _c
 }
 
+// ReplayForwarderDiscovery provides a mock function with given fields: fromBlock
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplayForwarderDiscovery(fromBlock int64) {
+	_m.Called(fromBlock)
+}
+
+// TxManager_ReplayForwarderDiscovery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayForwarderDiscovery'
+type TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// ReplayForwarderDiscovery is a helper method to define mock.On call
+//   - fromBlock int64
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplayForwarderDiscovery(fromBlock interface{}) *TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("ReplayForwarderDiscovery", fromBlock)}
+}
+
+func (_c *TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(fromBlock int64)) *TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return() *TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(int64)) *TxManager_ReplayForwarderDiscovery_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reset provides a mock function with given fields: addr, abandon
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Reset(addr ADDR, abandon bool) error {
 	ret := _m.Called(addr, abandon)
@@ -1079,6 +1112,54 @@ func (_c *TxManager_Start_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, F
 	return _c
 }
 
+// SubscribeTxEvents provides a mock function with given fields: filter
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeTxEvents(filter func(txmgr.TxEvent[ADDR, TX_HASH]) bool) *txmgr.TxEventSubscription[ADDR, TX_HASH] {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeTxEvents")
+	}
+
+	var r0 *txmgr.TxEventSubscription[ADDR, TX_HASH]
+	if rf, ok := ret.Get(0).(func(func(txmgr.TxEvent[ADDR, TX_HASH]) bool) *txmgr.TxEventSubscription[ADDR, TX_HASH]); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txmgr.TxEventSubscription[ADDR, TX_HASH])
+		}
+	}
+
+	return r0
+}
+
+// TxManager_SubscribeTxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeTxEvents'
+type TxManager_SubscribeTxEvents_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// SubscribeTxEvents is a helper method to define mock.On call
+//   - filter func(txmgr.TxEvent[ADDR, TX_HASH]) bool
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeTxEvents(filter interface{}) *TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("SubscribeTxEvents", filter)}
+}
+
+func (_c *TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(filter func(txmgr.TxEvent[ADDR, TX_HASH]) bool)) *TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(txmgr.TxEvent[ADDR, TX_HASH]) bool))
+	})
+	return _c
+}
+
+func (_c *TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 *txmgr.TxEventSubscription[ADDR, TX_HASH]) *TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(func(txmgr.TxEvent[ADDR, TX_HASH]) bool) *txmgr.TxEventSubscription[ADDR, TX_HASH]) *TxManager_SubscribeTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Trigger provides a mock function with given fields: addr
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Trigger(addr ADDR) {
 	_m.Called(addr)
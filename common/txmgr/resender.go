@@ -57,6 +57,7 @@ type Resender[
 
 	stopCh services.StopChan
 	chDone chan struct{}
+	events *TxEvents[ADDR, TX_HASH]
 }
 
 func NewResender[
@@ -94,9 +95,14 @@ func NewResender[
 		make(map[string]time.Time),
 		make(chan struct{}),
 		make(chan struct{}),
+		nil,
 	}
 }
 
+func (er *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetTxEvents(events *TxEvents[ADDR, TX_HASH]) {
+	er.events = events
+}
+
 // Start is a comment which satisfies the linter
 func (er *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Start(ctx context.Context) {
 	er.logger.Debugf("Enabled with poll interval of %s and age threshold of %s", er.interval, er.txConfig.ResendAfterThreshold())
@@ -180,6 +186,9 @@ func (er *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resendUnco
 		return fmt.Errorf("failed to re-send transactions: %w", err)
 	}
 	logResendResult(er.logger, txErrTypes)
+	for _, attempt := range allAttempts {
+		er.events.Publish(NewTxAttemptEvent(TxEventResend, attempt.Tx, attempt))
+	}
 
 	return nil
 }
@@ -62,3 +62,39 @@ func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrty
 	}
 	return
 }
+
+var _ txmgrtypes.PrioritizedTxStrategy = PriorityStrategy{}
+
+// PriorityStrategy queues txes with a priority class. If a queue size is specified, the oldest txes of the same
+// subject and priority class are dropped once it is exceeded, so each class is capped separately.
+type PriorityStrategy struct {
+	subject   uuid.UUID
+	priority  txmgrtypes.TxPriority
+	queueSize uint32
+}
+
+// NewPriorityStrategy creates a new TxStrategy which queues txes with the given priority. The subject may be
+// uuid.Nil if the queue size is zero.
+func NewPriorityStrategy(subject uuid.UUID, priority txmgrtypes.TxPriority, queueSize uint32) PriorityStrategy {
+	return PriorityStrategy{subject, priority, queueSize}
+}
+
+func (s PriorityStrategy) Subject() uuid.NullUUID {
+	return uuid.NullUUID{UUID: s.subject, Valid: s.subject != uuid.Nil}
+}
+
+func (s PriorityStrategy) Priority() txmgrtypes.TxPriority {
+	return s.priority
+}
+
+func (s PriorityStrategy) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) (ids []int64, err error) {
+	if s.queueSize == 0 || s.subject == uuid.Nil {
+		return nil, nil
+	}
+	// NOTE: We prune one less than the queue size for the same reason as DropOldestStrategy.
+	ids, err = pruneService.PruneUnstartedTxQueueByPriority(ctx, s.queueSize-1, s.subject, s.priority)
+	if err != nil {
+		return ids, fmt.Errorf("PriorityStrategy#PruneQueue failed: %w", err)
+	}
+	return
+}
@@ -63,6 +63,11 @@ type TxManager[
 	FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error)
 	CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (count uint32, err error)
 	GetTransactionStatus(ctx context.Context, transactionID string) (state commontypes.TransactionStatus, err error)
+	// SubscribeTxEvents returns a subscription to the lifecycle events of transactions matching filter, or of all
+	// transactions if filter is nil.
+	SubscribeTxEvents(filter func(TxEvent[ADDR, TX_HASH]) bool) *TxEventSubscription[ADDR, TX_HASH]
+	// ReplayForwarderDiscovery replays the logs from fromBlock, and rescans them for forwarders once replayed.
+	ReplayForwarderDiscovery(fromBlock int64)
 }
 
 type reset struct {
@@ -112,6 +117,7 @@ type Txm[
 	fwdMgr             txmgrtypes.ForwarderManager[ADDR]
 	txAttemptBuilder   txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	newErrorClassifier NewErrorClassifier
+	events             *TxEvents[ADDR, TX_HASH]
 }
 
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterResumeCallback(fn ResumeCallback) {
@@ -168,6 +174,16 @@ func NewTxm[
 		tracker:            tracker,
 		newErrorClassifier: newErrorClassifierFunc,
 		finalizer:          finalizer,
+		events:             NewTxEvents[ADDR, TX_HASH](lggr),
+	}
+	if broadcaster != nil {
+		broadcaster.SetTxEvents(b.events)
+	}
+	if confirmer != nil {
+		confirmer.SetTxEvents(b.events)
+	}
+	if resender != nil {
+		resender.SetTxEvents(b.events)
 	}
 
 	if txCfg.ResendAfterThreshold() <= 0 {
@@ -284,6 +300,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (m
 			merr = errors.Join(merr, fmt.Errorf("Txm: failed to close TxAttemptBuilder: %w", err))
 		}
 
+		// All the components publishing events are stopped.
+		b.events.Close()
+
 		return nil
 	})
 }
@@ -528,6 +547,18 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		return tx, err
 	}
 
+	if txRequest.Checker.Simulate {
+		// Reject conflicting checks now, rather than failing to build the checker at broadcast
+		if _, err = b.checkerFactory.BuildChecker(txRequest.Checker); err != nil {
+			return tx, fmt.Errorf("Txm#CreateTransaction: invalid transmit checker: %w", err)
+		}
+	}
+
+	if b.txConfig.ForwardersEnabled() && !utils.IsZero(txRequest.ForwarderAddress) && b.fwdMgr.Revoked(txRequest.ForwarderAddress, txRequest.FromAddress) {
+		b.logger.Warnw("Forwarder no longer authorizes the sending key, sending the transaction directly instead", "forwarder", txRequest.ForwarderAddress, "fromAddress", txRequest.FromAddress)
+		txRequest.ForwarderAddress = *new(ADDR)
+	}
+
 	if b.txConfig.ForwardersEnabled() && (!utils.IsZero(txRequest.ForwarderAddress)) {
 		fwdPayload, fwdErr := b.fwdMgr.ConvertPayload(txRequest.ToAddress, txRequest.EncodedPayload)
 		if fwdErr == nil {
@@ -550,6 +581,11 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 	if err != nil {
 		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
 	}
+	priority := txmgrtypes.TxPriorityOf(txRequest.Strategy)
+	err = b.txStore.CheckTxQueueCapacityByPriority(ctx, txRequest.FromAddress, b.txConfig.Priority().MaxQueued(priority), priority, b.chainID)
+	if err != nil {
+		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
+	}
 
 	tx, err = b.pruneQueueAndCreateTxn(ctx, txRequest, b.chainID)
 	if err != nil {
@@ -675,6 +711,21 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransac
 	}
 }
 
+// TxEvents returns the transaction lifecycle events of the Txm, to be published by chain specific components.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) TxEvents() *TxEvents[ADDR, TX_HASH] {
+	return b.events
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SubscribeTxEvents(filter func(TxEvent[ADDR, TX_HASH]) bool) *TxEventSubscription[ADDR, TX_HASH] {
+	return b.events.Subscribe(filter)
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReplayForwarderDiscovery(fromBlock int64) {
+	if b.txConfig.ForwardersEnabled() {
+		b.fwdMgr.ReplayDiscovery(fromBlock)
+	}
+}
+
 type NullTxManager[
 	CHAIN_ID types.ID,
 	HEAD types.Head[BLOCK_HASH],
@@ -762,6 +813,15 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Get
 	return
 }
 
+// SubscribeTxEvents returns a closed subscription, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeTxEvents(filter func(TxEvent[ADDR, TX_HASH]) bool) *TxEventSubscription[ADDR, TX_HASH] {
+	var events *TxEvents[ADDR, TX_HASH]
+	return events.Subscribe(filter)
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplayForwarderDiscovery(fromBlock int64) {
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueueAndCreateTxn(
 	ctx context.Context,
 	txRequest txmgrtypes.TxRequest[ADDR, TX_HASH],
@@ -794,6 +854,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueue
 		"meta", txRequest.Meta,
 		"transactionID", tx.ID,
 	)
+	b.events.Publish(NewTxEvent(TxEventUnstarted, tx))
 
 	return tx, nil
 }
@@ -33,6 +33,18 @@ type BroadcasterFeeConfig interface {
 
 type BroadcasterTransactionsConfig interface {
 	MaxInFlight() uint32
+	Priority() TxPriorityConfig
+}
+
+// TxPriorityConfig controls the order in which the transactions of each priority class are broadcast, and how many of
+// them may be queued.
+type TxPriorityConfig interface {
+	// StarvationTimeout is how long an unstarted transaction may wait before it is treated as TxPriorityHigh, zero if
+	// it never is.
+	StarvationTimeout() time.Duration
+	// MaxQueued is the maximum number of unstarted transactions of the priority class from an address, zero if only
+	// the MaxQueued of the transactions config applies.
+	MaxQueued(priority TxPriority) uint64
 }
 
 type BroadcasterListenerConfig interface {
@@ -14,4 +14,8 @@ type ForwarderManager[ADDR types.Hashable] interface {
 	ForwarderForOCR2Feeds(ctx context.Context, eoa, ocr2Aggregator ADDR) (forwarder ADDR, err error)
 	// Converts payload to be forwarder-friendly
 	ConvertPayload(dest ADDR, origPayload []byte) ([]byte, error)
+	// ReplayDiscovery replays the logs from fromBlock, and rescans them for forwarders once replayed
+	ReplayDiscovery(fromBlock int64)
+	// Revoked returns true if forwarder is known to no longer authorize eoa
+	Revoked(forwarder, eoa ADDR) bool
 }
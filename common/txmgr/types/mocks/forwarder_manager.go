@@ -379,6 +379,86 @@ func (_c *ForwarderManager_Ready_Call[ADDR]) RunAndReturn(run func() error) *For
 	return _c
 }
 
+// ReplayDiscovery provides a mock function with given fields: fromBlock
+func (_m *ForwarderManager[ADDR]) ReplayDiscovery(fromBlock int64) {
+	_m.Called(fromBlock)
+}
+
+// ForwarderManager_ReplayDiscovery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDiscovery'
+type ForwarderManager_ReplayDiscovery_Call[ADDR types.Hashable] struct {
+	*mock.Call
+}
+
+// ReplayDiscovery is a helper method to define mock.On call
+//   - fromBlock int64
+func (_e *ForwarderManager_Expecter[ADDR]) ReplayDiscovery(fromBlock interface{}) *ForwarderManager_ReplayDiscovery_Call[ADDR] {
+	return &ForwarderManager_ReplayDiscovery_Call[ADDR]{Call: _e.mock.On("ReplayDiscovery", fromBlock)}
+}
+
+func (_c *ForwarderManager_ReplayDiscovery_Call[ADDR]) Run(run func(fromBlock int64)) *ForwarderManager_ReplayDiscovery_Call[ADDR] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ForwarderManager_ReplayDiscovery_Call[ADDR]) Return() *ForwarderManager_ReplayDiscovery_Call[ADDR] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ForwarderManager_ReplayDiscovery_Call[ADDR]) RunAndReturn(run func(int64)) *ForwarderManager_ReplayDiscovery_Call[ADDR] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoked provides a mock function with given fields: forwarder, eoa
+func (_m *ForwarderManager[ADDR]) Revoked(forwarder ADDR, eoa ADDR) bool {
+	ret := _m.Called(forwarder, eoa)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoked")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(ADDR, ADDR) bool); ok {
+		r0 = rf(forwarder, eoa)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ForwarderManager_Revoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoked'
+type ForwarderManager_Revoked_Call[ADDR types.Hashable] struct {
+	*mock.Call
+}
+
+// Revoked is a helper method to define mock.On call
+//   - forwarder ADDR
+//   - eoa ADDR
+func (_e *ForwarderManager_Expecter[ADDR]) Revoked(forwarder interface{}, eoa interface{}) *ForwarderManager_Revoked_Call[ADDR] {
+	return &ForwarderManager_Revoked_Call[ADDR]{Call: _e.mock.On("Revoked", forwarder, eoa)}
+}
+
+func (_c *ForwarderManager_Revoked_Call[ADDR]) Run(run func(forwarder ADDR, eoa ADDR)) *ForwarderManager_Revoked_Call[ADDR] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(ADDR), args[1].(ADDR))
+	})
+	return _c
+}
+
+func (_c *ForwarderManager_Revoked_Call[ADDR]) Return(_a0 bool) *ForwarderManager_Revoked_Call[ADDR] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForwarderManager_Revoked_Call[ADDR]) RunAndReturn(run func(ADDR, ADDR) bool) *ForwarderManager_Revoked_Call[ADDR] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *ForwarderManager[ADDR]) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -130,6 +130,56 @@ func (_c *TxStore_CheckTxQueueCapacity_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH,
 	return _c
 }
 
+// CheckTxQueueCapacityByPriority provides a mock function with given fields: ctx, fromAddress, maxQueuedTransactions, priority, chainID
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CheckTxQueueCapacityByPriority(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, priority txmgrtypes.TxPriority, chainID CHAIN_ID) error {
+	ret := _m.Called(ctx, fromAddress, maxQueuedTransactions, priority, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTxQueueCapacityByPriority")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, uint64, txmgrtypes.TxPriority, CHAIN_ID) error); ok {
+		r0 = rf(ctx, fromAddress, maxQueuedTransactions, priority, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxStore_CheckTxQueueCapacityByPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckTxQueueCapacityByPriority'
+type TxStore_CheckTxQueueCapacityByPriority_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// CheckTxQueueCapacityByPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - maxQueuedTransactions uint64
+//   - priority txmgrtypes.TxPriority
+//   - chainID CHAIN_ID
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CheckTxQueueCapacityByPriority(ctx interface{}, fromAddress interface{}, maxQueuedTransactions interface{}, priority interface{}, chainID interface{}) *TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("CheckTxQueueCapacityByPriority", ctx, fromAddress, maxQueuedTransactions, priority, chainID)}
+}
+
+func (_c *TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, priority txmgrtypes.TxPriority, chainID CHAIN_ID)) *TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(uint64), args[3].(txmgrtypes.TxPriority), args[4].(CHAIN_ID))
+	})
+	return _c
+}
+
+func (_c *TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(err error) *TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, uint64, txmgrtypes.TxPriority, CHAIN_ID) error) *TxStore_CheckTxQueueCapacityByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() {
 	_m.Called()
@@ -613,9 +663,9 @@ func (_c *TxStore_FindLatestSequence_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R
 	return _c
 }
 
-// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID
-func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
-	ret := _m.Called(ctx, fromAddress, chainID)
+// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, starvationTimeout, chainID
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress ADDR, starvationTimeout time.Duration, chainID CHAIN_ID) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, fromAddress, starvationTimeout, chainID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindNextUnstartedTransactionFromAddress")
@@ -623,19 +673,19 @@ func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindNextUns
 
 	var r0 *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
-		return rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, time.Duration, CHAIN_ID) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, fromAddress, starvationTimeout, chainID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ADDR, CHAIN_ID) *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
-		r0 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, time.Duration, CHAIN_ID) *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, fromAddress, starvationTimeout, chainID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ADDR, CHAIN_ID) error); ok {
-		r1 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, time.Duration, CHAIN_ID) error); ok {
+		r1 = rf(ctx, fromAddress, starvationTimeout, chainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -651,14 +701,15 @@ type TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR types.Hashable, C
 // FindNextUnstartedTransactionFromAddress is a helper method to define mock.On call
 //   - ctx context.Context
 //   - fromAddress ADDR
+//   - starvationTimeout time.Duration
 //   - chainID CHAIN_ID
-func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}) *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
-	return &TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, chainID)}
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, starvationTimeout interface{}, chainID interface{}) *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, starvationTimeout, chainID)}
 }
 
-func (_c *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID)) *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+func (_c *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, starvationTimeout time.Duration, chainID CHAIN_ID)) *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(ADDR), args[2].(CHAIN_ID))
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(time.Duration), args[3].(CHAIN_ID))
 	})
 	return _c
 }
@@ -668,7 +719,7 @@ func (_c *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, T
 	return _c
 }
 
-func (_c *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, CHAIN_ID) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+func (_c *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, time.Duration, CHAIN_ID) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxStore_FindNextUnstartedTransactionFromAddress_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2011,6 +2062,67 @@ func (_c *TxStore_PruneUnstartedTxQueue_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH
 	return _c
 }
 
+// PruneUnstartedTxQueueByPriority provides a mock function with given fields: ctx, queueSize, subject, priority
+func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority txmgrtypes.TxPriority) ([]int64, error) {
+	ret := _m.Called(ctx, queueSize, subject, priority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneUnstartedTxQueueByPriority")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, txmgrtypes.TxPriority) ([]int64, error)); ok {
+		return rf(ctx, queueSize, subject, priority)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, txmgrtypes.TxPriority) []int64); ok {
+		r0 = rf(ctx, queueSize, subject, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uuid.UUID, txmgrtypes.TxPriority) error); ok {
+		r1 = rf(ctx, queueSize, subject, priority)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxStore_PruneUnstartedTxQueueByPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneUnstartedTxQueueByPriority'
+type TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR types.Hashable, CHAIN_ID types.ID, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH], SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// PruneUnstartedTxQueueByPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueSize uint32
+//   - subject uuid.UUID
+//   - priority txmgrtypes.TxPriority
+func (_e *TxStore_Expecter[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) PruneUnstartedTxQueueByPriority(ctx interface{}, queueSize interface{}, subject interface{}, priority interface{}) *TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{Call: _e.mock.On("PruneUnstartedTxQueueByPriority", ctx, queueSize, subject, priority)}
+}
+
+func (_c *TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Run(run func(ctx context.Context, queueSize uint32, subject uuid.UUID, priority txmgrtypes.TxPriority)) *TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(uuid.UUID), args[3].(txmgrtypes.TxPriority))
+	})
+	return _c
+}
+
+func (_c *TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Return(ids []int64, err error) *TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(ids, err)
+	return _c
+}
+
+func (_c *TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RunAndReturn(run func(context.Context, uint32, uuid.UUID, txmgrtypes.TxPriority) ([]int64, error)) *TxStore_PruneUnstartedTxQueueByPriority_Call[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReapTxHistory provides a mock function with given fields: ctx, timeThreshold, chainID
 func (_m *TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReapTxHistory(ctx context.Context, timeThreshold time.Time, chainID CHAIN_ID) error {
 	ret := _m.Called(ctx, timeThreshold, chainID)
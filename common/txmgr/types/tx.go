@@ -31,6 +31,55 @@ type TxStrategy interface {
 	PruneQueue(ctx context.Context, pruneService UnstartedTxQueuePruner) (ids []int64, err error)
 }
 
+// TxPriority is the priority class of a transaction. Among the unstarted transactions from an address, those with the
+// highest priority are broadcast first. To prevent starvation, a transaction which has been waiting for longer than
+// the StarvationTimeout of the TxPriorityConfig is treated as TxPriorityHigh.
+type TxPriority int16
+
+const (
+	TxPriorityLow    TxPriority = -1
+	TxPriorityNormal TxPriority = 0
+	TxPriorityHigh   TxPriority = 1
+)
+
+var txPriorityStrings = map[TxPriority]string{
+	TxPriorityLow:    "low",
+	TxPriorityNormal: "normal",
+	TxPriorityHigh:   "high",
+}
+
+// ParseTxPriority parses the name of a priority class, as returned by TxPriority.String.
+func ParseTxPriority(s string) (TxPriority, error) {
+	for p, name := range txPriorityStrings {
+		if strings.EqualFold(s, name) {
+			return p, nil
+		}
+	}
+	return TxPriorityNormal, fmt.Errorf("invalid tx priority %q: must be one of low, normal or high", s)
+}
+
+func (p TxPriority) String() string {
+	if name, ok := txPriorityStrings[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("TxPriority(%d)", int16(p))
+}
+
+// PrioritizedTxStrategy is a TxStrategy which assigns a priority class to the txes it queues.
+type PrioritizedTxStrategy interface {
+	TxStrategy
+	Priority() TxPriority
+}
+
+// TxPriorityOf returns the priority class of txes queued with the given strategy, TxPriorityNormal unless it is a
+// PrioritizedTxStrategy.
+func TxPriorityOf(strategy TxStrategy) TxPriority {
+	if s, ok := strategy.(PrioritizedTxStrategy); ok {
+		return s.Priority()
+	}
+	return TxPriorityNormal
+}
+
 type TxAttemptState int8
 
 type TxState string
@@ -108,6 +157,11 @@ type TransmitCheckerSpec[ADDR types.Hashable] struct {
 	// VRFRequestBlockNumber is the block number in which the provided VRF request has been made.
 	// This should be set iff CheckerType is TransmitCheckerTypeVRFV2.
 	VRFRequestBlockNumber *big.Int `json:",omitempty"`
+
+	// Simulate indicates that the transaction should also be simulated against the pending block
+	// right before it is broadcast, after the check selected by CheckerType passes. It must not be
+	// combined with a CheckerType that already simulates the transaction.
+	Simulate bool `json:",omitempty"`
 }
 
 // TransmitCheckerType describes the type of check that should be performed before a transaction is
@@ -155,6 +209,9 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	// When this is set, it indicates tx is forwarded through To address.
 	FwdrDestAddress *ADDR `json:"ForwarderDestAddress,omitempty"`
 
+	// Used by the BalanceMonitor, tracks the key topped up by the tx.
+	TopUpAddress *ADDR `json:"TopUpAddress,omitempty"`
+
 	// MessageIDs is used by CCIP for tx to executed messages correlation in logs
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
@@ -206,6 +263,8 @@ type Tx[
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
 	FeeLimit uint64
 	Error    null.String
+	// RevertReason is the decoded revert reason, if the tx was fatally errored because it would revert.
+	RevertReason null.String
 	// BroadcastAt is updated every time an attempt for this tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt *time.Time
@@ -220,6 +279,8 @@ type Tx[
 	Meta    *sqlutil.JSON
 	Subject uuid.NullUUID
 	ChainID CHAIN_ID
+	// Priority class of the tx, see TxPriority
+	Priority TxPriority
 
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  clnull.Uint32
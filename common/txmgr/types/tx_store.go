@@ -41,6 +41,8 @@ type TxStore[
 
 	// additional methods for tx store management
 	CheckTxQueueCapacity(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, chainID CHAIN_ID) (err error)
+	// CheckTxQueueCapacityByPriority is like CheckTxQueueCapacity, but only counts the txes of the given priority class.
+	CheckTxQueueCapacityByPriority(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, priority TxPriority, chainID CHAIN_ID) (err error)
 	Close()
 	Abandon(ctx context.Context, id CHAIN_ID, addr ADDR) error
 	// Find transactions by a field in the TxMeta blob and transaction states
@@ -78,7 +80,9 @@ type TransactionStore[
 	FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string, chainID CHAIN_ID) (tx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Search for Tx using the fromAddress and sequence
 	FindTxWithSequence(ctx context.Context, fromAddress ADDR, seq SEQ) (etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
-	FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
+	// FindNextUnstartedTransactionFromAddress returns the next tx to broadcast, treating the txes which have waited for
+	// longer than starvationTimeout as TxPriorityHigh, unless it is zero.
+	FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress ADDR, starvationTimeout time.Duration, chainID CHAIN_ID) (*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
 
 	// FindTransactionsConfirmedInBlockRange retrieves tx with attempts and partial receipt values for optimization purpose
 	FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber, lowBlockNumber int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
@@ -115,6 +119,8 @@ type TxHistoryReaper[CHAIN_ID types.ID] interface {
 
 type UnstartedTxQueuePruner interface {
 	PruneUnstartedTxQueue(ctx context.Context, queueSize uint32, subject uuid.UUID) (ids []int64, err error)
+	// PruneUnstartedTxQueueByPriority is like PruneUnstartedTxQueue, but only counts and prunes the txes of the given priority class.
+	PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority TxPriority) (ids []int64, err error)
 }
 
 // R is the raw unparsed transaction receipt
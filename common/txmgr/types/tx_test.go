@@ -48,3 +48,14 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestTxPriority(t *testing.T) {
+	for _, p := range []TxPriority{TxPriorityLow, TxPriorityNormal, TxPriorityHigh} {
+		parsed, err := ParseTxPriority(p.String())
+		assert.NoError(t, err)
+		assert.Equal(t, p, parsed)
+	}
+	_, err := ParseTxPriority("urgent")
+	assert.Error(t, err)
+	assert.Equal(t, "TxPriority(5)", TxPriority(5).String())
+}
@@ -10,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -19,6 +21,7 @@ import (
 type BridgeTypeRequest struct {
 	Name                   BridgeName    `json:"name"`
 	URL                    models.WebURL `json:"url"`
+	FallbackURLs           WebURLs       `json:"fallbackURLs"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
 }
@@ -44,6 +47,7 @@ func (bt *BridgeTypeRequest) SetID(value string) error {
 type BridgeTypeAuthentication struct {
 	Name                   BridgeName
 	URL                    models.WebURL
+	FallbackURLs           WebURLs
 	Confirmations          uint32
 	IncomingToken          string
 	OutgoingToken          string
@@ -55,6 +59,7 @@ type BridgeTypeAuthentication struct {
 type BridgeType struct {
 	Name                   BridgeName
 	URL                    models.WebURL
+	FallbackURLs           WebURLs `db:"fallback_urls"`
 	Confirmations          uint32
 	IncomingTokenHash      string
 	Salt                   string
@@ -80,6 +85,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	return &BridgeTypeAuthentication{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
+			FallbackURLs:           btr.FallbackURLs,
 			Confirmations:          btr.Confirmations,
 			IncomingToken:          incomingToken,
 			OutgoingToken:          outgoingToken,
@@ -87,6 +93,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 		}, &BridgeType{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
+			FallbackURLs:           btr.FallbackURLs,
 			Confirmations:          btr.Confirmations,
 			IncomingTokenHash:      hash,
 			Salt:                   salt,
@@ -95,6 +102,11 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 		}, nil
 }
 
+// URLs returns the primary URL of the bridge followed by its fallback URLs, in the order they should be tried.
+func (bt BridgeType) URLs() []models.WebURL {
+	return append([]models.WebURL{bt.URL}, bt.FallbackURLs...)
+}
+
 // AuthenticateBridgeType returns true if the passed token matches its
 // IncomingToken, or returns false with an error.
 func AuthenticateBridgeType(bt *BridgeType, token string) (bool, error) {
@@ -200,6 +212,39 @@ func (t *BridgeName) Scan(value interface{}) error {
 	return nil
 }
 
+// WebURLs is a list of URLs, stored as a text array.
+type WebURLs []models.WebURL
+
+// Strings returns the URLs as strings.
+func (w WebURLs) Strings() []string {
+	s := make([]string, len(w))
+	for i, u := range w {
+		s[i] = u.String()
+	}
+	return s
+}
+
+// Value returns this instance serialized for database storage.
+func (w WebURLs) Value() (driver.Value, error) {
+	return pq.StringArray(w.Strings()).Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (w *WebURLs) Scan(value interface{}) error {
+	var s pq.StringArray
+	if err := s.Scan(value); err != nil {
+		return fmt.Errorf("unable to convert %v of %T to WebURLs: %w", value, value, err)
+	}
+	urls := make(WebURLs, len(s))
+	for i := range s {
+		if err := urls[i].Scan(s[i]); err != nil {
+			return err
+		}
+	}
+	*w = urls
+	return nil
+}
+
 type BridgeResponse struct {
 	DotID      string
 	SpecID     int32
@@ -0,0 +1,436 @@
+package bridges
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const (
+	HealthMonitorServiceName = "BridgeHealthMonitor"
+
+	// latencySamples is the number of recent successful requests kept per bridge URL to compute latency percentiles.
+	latencySamples = 128
+	// maxConcurrentChecks caps the number of health check probes in flight.
+	maxConcurrentChecks = 10
+	// bridgesPageSize is the page size used to load the bridges to probe.
+	bridgesPageSize = 100
+)
+
+// NOTE: These metrics generate new labels per bridge URL, which is safe since the number of bridges is almost always
+// relatively small (<< 1000). URLs are reported without credentials or query, see metricURL.
+var (
+	promBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_circuit_breaker_state",
+		Help: "Circuit breaker state of a bridge URL: 0 closed, 1 half-open, 2 open",
+	},
+		[]string{"name", "url"},
+	)
+	promBreakerOpened = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_circuit_breaker_opened_total",
+		Help: "Number of times the circuit breaker of a bridge URL opened",
+	},
+		[]string{"name", "url"},
+	)
+	promRequestLatency = promauto.NewSummaryVec(prometheus.SummaryOpts{
+		Name:       "bridge_request_latency_seconds",
+		Help:       "Latency percentiles of successful bridge requests, scoped by bridge URL",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	},
+		[]string{"name", "url"},
+	)
+	promHealthCheckFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_health_check_failures_total",
+		Help: "Number of failed health check probes of a bridge URL",
+	},
+		[]string{"name", "url"},
+	)
+)
+
+// BreakerState is the state of the circuit breaker of a bridge URL.
+type BreakerState string
+
+const (
+	// BreakerClosed lets all requests through.
+	BreakerClosed BreakerState = "closed"
+	// BreakerHalfOpen lets a single trial request through, which closes the breaker on success.
+	BreakerHalfOpen BreakerState = "half-open"
+	// BreakerOpen rejects all requests until the cooldown elapses.
+	BreakerOpen BreakerState = "open"
+)
+
+func (s BreakerState) metricValue() float64 {
+	switch s {
+	case BreakerHalfOpen:
+		return 1
+	case BreakerOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// HealthConfig configures the HealthMonitor.
+type HealthConfig interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+	FailureThreshold() uint32
+	Cooldown() time.Duration
+}
+
+// LatencyPercentiles of the recent successful requests to a bridge URL.
+type LatencyPercentiles struct {
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+	// Samples is the number of requests the percentiles were computed from.
+	Samples int
+}
+
+// EndpointStatus is a snapshot of the health of a bridge URL.
+type EndpointStatus struct {
+	URL                 string
+	State               BreakerState
+	ConsecutiveFailures uint32
+	LastError           string
+	LastCheckedAt       *time.Time
+	Latency             LatencyPercentiles
+}
+
+// HealthMonitor tracks the health of bridge URLs. Each URL has a circuit breaker, which opens after FailureThreshold
+// consecutive failures and half-opens after Cooldown to let a single trial request through. Failures are reported by
+// the callers of the bridges and, when CheckInterval is set, by an active health check loop probing every URL.
+type HealthMonitor struct {
+	orm    ORM
+	cfg    HealthConfig
+	client *http.Client
+	now    func() time.Time
+
+	services.Service
+	eng *services.Engine
+
+	mu        sync.Mutex
+	endpoints map[endpointKey]*endpoint
+}
+
+var _ services.Service = (*HealthMonitor)(nil)
+
+type endpointKey struct {
+	name BridgeName
+	url  string
+}
+
+type endpoint struct {
+	state               BreakerState
+	consecutiveFailures uint32
+	openedAt            time.Time
+	trialStartedAt      time.Time
+	lastError           string
+	lastCheckedAt       time.Time
+
+	// latencies is a ring buffer of the most recent successful request latencies.
+	latencies []time.Duration
+	next      int
+}
+
+// NewHealthMonitor returns a HealthMonitor probing the bridges of orm with client. Bridges are called from the node's
+// own database configuration, so client is expected to be unrestricted.
+func NewHealthMonitor(orm ORM, cfg HealthConfig, client *http.Client, lggr logger.Logger) *HealthMonitor {
+	m := &HealthMonitor{
+		orm:       orm,
+		cfg:       cfg,
+		client:    client,
+		now:       time.Now,
+		endpoints: make(map[endpointKey]*endpoint),
+	}
+	m.Service, m.eng = services.Config{
+		Name:  HealthMonitorServiceName,
+		Start: m.start,
+	}.NewServiceEngine(lggr)
+	return m
+}
+
+func (m *HealthMonitor) start(_ context.Context) error {
+	interval := m.cfg.CheckInterval()
+	if interval <= 0 {
+		m.eng.Info("Bridge health checks disabled")
+		return nil
+	}
+	ticker := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(interval)
+	m.eng.GoTick(ticker, m.checkAll)
+	return nil
+}
+
+// Allow returns whether a request to the bridge URL may be sent. When the circuit breaker of the URL is half-open,
+// only a single trial request is allowed until its result is recorded.
+func (m *HealthMonitor) Allow(name BridgeName, u models.WebURL) bool {
+	threshold := m.cfg.FailureThreshold()
+	if threshold == 0 {
+		return true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.endpoints[endpointKey{name, u.String()}]
+	if !ok {
+		return true
+	}
+	now := m.now()
+	cooldown := m.cfg.Cooldown()
+	switch e.state {
+	case BreakerOpen:
+		if now.Sub(e.openedAt) < cooldown {
+			return false
+		}
+		m.setState(name, u, e, BreakerHalfOpen)
+	case BreakerHalfOpen:
+		// A trial request that never reported back must not keep the breaker half-open forever.
+		if !e.trialStartedAt.IsZero() && now.Sub(e.trialStartedAt) < cooldown {
+			return false
+		}
+	default:
+		return true
+	}
+	e.trialStartedAt = now
+	return true
+}
+
+// RecordSuccess reports a successful request to the bridge URL, closing its circuit breaker.
+func (m *HealthMonitor) RecordSuccess(name BridgeName, u models.WebURL, latency time.Duration) {
+	promRequestLatency.WithLabelValues(name.String(), metricURL(u)).Observe(latency.Seconds())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e := m.endpoint(name, u)
+	e.consecutiveFailures = 0
+	e.lastError = ""
+	e.trialStartedAt = time.Time{}
+	if len(e.latencies) < latencySamples {
+		e.latencies = append(e.latencies, latency)
+	} else {
+		e.latencies[e.next] = latency
+	}
+	e.next = (e.next + 1) % latencySamples
+	m.setState(name, u, e, BreakerClosed)
+}
+
+// RecordFailure reports a failed request to the bridge URL. The circuit breaker opens after FailureThreshold
+// consecutive failures, or after any failed trial request while half-open.
+func (m *HealthMonitor) RecordFailure(name BridgeName, u models.WebURL, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.recordFailure(name, u, m.endpoint(name, u), err)
+}
+
+func (m *HealthMonitor) recordFailure(name BridgeName, u models.WebURL, e *endpoint, err error) {
+	e.consecutiveFailures++
+	if err != nil {
+		e.lastError = err.Error()
+	}
+	threshold := m.cfg.FailureThreshold()
+	if threshold == 0 {
+		return
+	}
+	if e.state == BreakerHalfOpen || e.state == BreakerOpen || e.consecutiveFailures >= threshold {
+		if e.state != BreakerOpen {
+			promBreakerOpened.WithLabelValues(name.String(), metricURL(u)).Inc()
+			m.eng.Warnw("Bridge circuit breaker opened", "bridge", name, "url", metricURL(u),
+				"consecutiveFailures", e.consecutiveFailures, "err", e.lastError)
+		}
+		e.openedAt = m.now()
+		e.trialStartedAt = time.Time{}
+		m.setState(name, u, e, BreakerOpen)
+	}
+}
+
+// Status returns the health of each URL of the bridge, primary URL first.
+func (m *HealthMonitor) Status(bt BridgeType) []EndpointStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var statuses []EndpointStatus
+	for _, u := range bt.URLs() {
+		status := EndpointStatus{URL: u.String(), State: BreakerClosed}
+		if e, ok := m.endpoints[endpointKey{bt.Name, u.String()}]; ok {
+			status.State = e.state
+			status.ConsecutiveFailures = e.consecutiveFailures
+			status.LastError = e.lastError
+			if !e.lastCheckedAt.IsZero() {
+				lastCheckedAt := e.lastCheckedAt
+				status.LastCheckedAt = &lastCheckedAt
+			}
+			status.Latency = percentiles(e.latencies)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// endpoint returns the state of the bridge URL, creating it if needed. m.mu must be held.
+func (m *HealthMonitor) endpoint(name BridgeName, u models.WebURL) *endpoint {
+	key := endpointKey{name, u.String()}
+	e, ok := m.endpoints[key]
+	if !ok {
+		e = &endpoint{state: BreakerClosed}
+		m.endpoints[key] = e
+		promBreakerState.WithLabelValues(name.String(), metricURL(u)).Set(e.state.metricValue())
+	}
+	return e
+}
+
+func (m *HealthMonitor) setState(name BridgeName, u models.WebURL, e *endpoint, state BreakerState) {
+	if e.state == state {
+		return
+	}
+	if state == BreakerClosed && e.state != BreakerClosed {
+		m.eng.Infow("Bridge circuit breaker closed", "bridge", name, "url", metricURL(u))
+	}
+	e.state = state
+	promBreakerState.WithLabelValues(name.String(), metricURL(u)).Set(state.metricValue())
+}
+
+func (m *HealthMonitor) checkAll(ctx context.Context) {
+	var bts []BridgeType
+	for offset := 0; ; offset += bridgesPageSize {
+		page, count, err := m.orm.BridgeTypes(ctx, offset, bridgesPageSize)
+		if err != nil {
+			m.eng.Warnw("Failed to load bridges for health checks", "err", err)
+			return
+		}
+		bts = append(bts, page...)
+		if len(page) == 0 || offset+len(page) >= count {
+			break
+		}
+	}
+
+	active := make(map[endpointKey]struct{})
+	sem := make(chan struct{}, maxConcurrentChecks)
+	var wg sync.WaitGroup
+	for _, bt := range bts {
+		for _, u := range bt.URLs() {
+			active[endpointKey{bt.Name, u.String()}] = struct{}{}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				wg.Wait()
+				return
+			}
+			wg.Add(1)
+			go func(name BridgeName, u models.WebURL) {
+				defer func() { <-sem; wg.Done() }()
+				m.check(ctx, name, u)
+			}(bt.Name, u)
+		}
+	}
+	wg.Wait()
+
+	m.prune(active)
+}
+
+// check probes the bridge URL with a GET request. A 2xx response means the adapter is up, though not necessarily able
+// to serve bridge requests: an open circuit breaker is only half-opened, leaving it to the next request to close it.
+func (m *HealthMonitor) check(ctx context.Context, name BridgeName, u models.WebURL) {
+	probeCtx, cancel := context.WithTimeout(ctx, m.cfg.CheckTimeout())
+	defer cancel()
+
+	err := m.probe(probeCtx, u)
+	if ctx.Err() != nil {
+		return // shutting down
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e := m.endpoint(name, u)
+	e.lastCheckedAt = m.now()
+	if err != nil {
+		promHealthCheckFailures.WithLabelValues(name.String(), metricURL(u)).Inc()
+		m.eng.Debugw("Bridge health check failed", "bridge", name, "url", metricURL(u), "err", err)
+		m.recordFailure(name, u, e, err)
+		return
+	}
+	if e.state == BreakerOpen {
+		m.eng.Infow("Bridge health check succeeded, half-opening circuit breaker", "bridge", name, "url", metricURL(u))
+		e.trialStartedAt = time.Time{}
+		m.setState(name, u, e, BreakerHalfOpen)
+	}
+}
+
+func (m *HealthMonitor) probe(ctx context.Context, u models.WebURL) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// an adapter which does not serve GET requests cannot be told apart from a broken one
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health check failed with status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// prune forgets the URLs which no longer belong to a bridge.
+func (m *HealthMonitor) prune(active map[endpointKey]struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key := range m.endpoints {
+		if _, ok := active[key]; ok {
+			continue
+		}
+		delete(m.endpoints, key)
+		if u, err := url.Parse(key.url); err == nil {
+			labels := prometheus.Labels{"name": key.name.String(), "url": metricURL(models.WebURL(*u))}
+			promBreakerState.Delete(labels)
+			promBreakerOpened.Delete(labels)
+			promRequestLatency.Delete(labels)
+			promHealthCheckFailures.Delete(labels)
+		}
+	}
+}
+
+// metricURL strips the credentials, query and fragment of u, which may hold secrets.
+func metricURL(u models.WebURL) string {
+	stripped := url.URL(u)
+	stripped.User = nil
+	stripped.RawQuery = ""
+	stripped.ForceQuery = false
+	stripped.Fragment = ""
+	stripped.RawFragment = ""
+	return stripped.String()
+}
+
+func percentiles(latencies []time.Duration) LatencyPercentiles {
+	if len(latencies) == 0 {
+		return LatencyPercentiles{}
+	}
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	at := func(p int) time.Duration {
+		// nearest-rank method
+		rank := (p*len(sorted) + 99) / 100
+		return sorted[max(rank, 1)-1]
+	}
+	return LatencyPercentiles{P50: at(50), P90: at(90), P99: at(99), Samples: len(sorted)}
+}
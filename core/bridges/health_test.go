@@ -0,0 +1,193 @@
+package bridges_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+type healthConfig struct {
+	checkInterval    time.Duration
+	checkTimeout     time.Duration
+	failureThreshold uint32
+	cooldown         time.Duration
+}
+
+func (c healthConfig) CheckInterval() time.Duration { return c.checkInterval }
+func (c healthConfig) CheckTimeout() time.Duration  { return c.checkTimeout }
+func (c healthConfig) FailureThreshold() uint32     { return c.failureThreshold }
+func (c healthConfig) Cooldown() time.Duration      { return c.cooldown }
+
+func newTestHealthMonitor(t *testing.T, orm bridges.ORM, cfg healthConfig) (*bridges.HealthMonitor, *time.Time) {
+	m := bridges.NewHealthMonitor(orm, cfg, http.DefaultClient, logger.TestLogger(t))
+	now := time.Unix(1700000000, 0)
+	m.SetNow(func() time.Time { return now })
+	return m, &now
+}
+
+func TestHealthMonitor_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	name := bridges.BridgeName("adapter")
+	primary := *testutils.MustParseURL(t, "http://primary.adapter.com")
+	fallback := *testutils.MustParseURL(t, "http://fallback.adapter.com")
+	bt := bridges.BridgeType{Name: name, URL: models.WebURL(primary), FallbackURLs: bridges.WebURLs{models.WebURL(fallback)}}
+	u := bt.URL
+	errDown := errors.New("connection refused")
+
+	t.Run("opens after consecutive failures and half-opens after cooldown", func(t *testing.T) {
+		t.Parallel()
+		m, now := newTestHealthMonitor(t, nil, healthConfig{failureThreshold: 3, cooldown: time.Minute})
+
+		m.RecordFailure(name, u, errDown)
+		m.RecordFailure(name, u, errDown)
+		m.RecordSuccess(name, u, time.Second)
+		m.RecordFailure(name, u, errDown)
+		m.RecordFailure(name, u, errDown)
+		assert.True(t, m.Allow(name, u), "failures must be consecutive")
+
+		m.RecordFailure(name, u, errDown)
+		assert.False(t, m.Allow(name, u))
+		assert.True(t, m.Allow(name, bt.FallbackURLs[0]), "breakers are per URL")
+		status := m.Status(bt)
+		require.Len(t, status, 2)
+		assert.Equal(t, bridges.BreakerOpen, status[0].State)
+		assert.Equal(t, uint32(3), status[0].ConsecutiveFailures)
+		assert.Equal(t, errDown.Error(), status[0].LastError)
+		assert.Equal(t, bridges.BreakerClosed, status[1].State)
+
+		*now = now.Add(time.Minute)
+		assert.True(t, m.Allow(name, u), "a trial request is let through after the cooldown")
+		assert.False(t, m.Allow(name, u), "only one trial request at a time")
+		assert.Equal(t, bridges.BreakerHalfOpen, m.Status(bt)[0].State)
+
+		m.RecordFailure(name, u, errDown)
+		assert.Equal(t, bridges.BreakerOpen, m.Status(bt)[0].State, "a failed trial opens the breaker again")
+		assert.False(t, m.Allow(name, u))
+
+		*now = now.Add(time.Minute)
+		assert.True(t, m.Allow(name, u))
+		m.RecordSuccess(name, u, time.Second)
+		assert.Equal(t, bridges.BreakerClosed, m.Status(bt)[0].State)
+		assert.Zero(t, m.Status(bt)[0].ConsecutiveFailures)
+		assert.True(t, m.Allow(name, u))
+		assert.True(t, m.Allow(name, u))
+	})
+
+	t.Run("abandoned trial request", func(t *testing.T) {
+		t.Parallel()
+		m, now := newTestHealthMonitor(t, nil, healthConfig{failureThreshold: 1, cooldown: time.Minute})
+
+		m.RecordFailure(name, u, errDown)
+		*now = now.Add(time.Minute)
+		assert.True(t, m.Allow(name, u))
+		assert.False(t, m.Allow(name, u))
+		*now = now.Add(time.Minute)
+		assert.True(t, m.Allow(name, u))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+		m, _ := newTestHealthMonitor(t, nil, healthConfig{failureThreshold: 0, cooldown: time.Minute})
+
+		for i := 0; i < 10; i++ {
+			m.RecordFailure(name, u, errDown)
+		}
+		assert.True(t, m.Allow(name, u))
+		assert.Equal(t, bridges.BreakerClosed, m.Status(bt)[0].State)
+		assert.Equal(t, uint32(10), m.Status(bt)[0].ConsecutiveFailures)
+	})
+}
+
+func TestHealthMonitor_Latency(t *testing.T) {
+	t.Parallel()
+
+	name := bridges.BridgeName("adapter")
+	bt := bridges.BridgeType{Name: name, URL: models.WebURL(*testutils.MustParseURL(t, "http://adapter.com"))}
+	m, _ := newTestHealthMonitor(t, nil, healthConfig{failureThreshold: 5, cooldown: time.Minute})
+
+	assert.Equal(t, bridges.LatencyPercentiles{}, m.Status(bt)[0].Latency)
+
+	for i := 200; i > 0; i-- {
+		m.RecordSuccess(name, bt.URL, time.Duration(i)*time.Millisecond)
+	}
+	// only the 128 most recent samples are kept: 1ms to 128ms
+	assert.Equal(t, bridges.LatencyPercentiles{
+		P50:     64 * time.Millisecond,
+		P90:     116 * time.Millisecond,
+		P99:     127 * time.Millisecond,
+		Samples: 128,
+	}, m.Status(bt)[0].Latency)
+}
+
+func TestHealthMonitor_CheckAll(t *testing.T) {
+	t.Parallel()
+
+	var healthy atomic.Bool
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+	}))
+	t.Cleanup(up.Close)
+	postOnly := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	t.Cleanup(postOnly.Close)
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(notFound.Close)
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	t.Cleanup(flaky.Close)
+
+	bt := bridges.BridgeType{
+		Name: "adapter",
+		URL:  models.WebURL(*testutils.MustParseURL(t, flaky.URL)),
+		FallbackURLs: bridges.WebURLs{
+			models.WebURL(*testutils.MustParseURL(t, up.URL)),
+			models.WebURL(*testutils.MustParseURL(t, postOnly.URL)),
+			models.WebURL(*testutils.MustParseURL(t, notFound.URL)),
+		},
+	}
+	orm := mocks.NewORM(t)
+	orm.On("BridgeTypes", mock.Anything, 0, mock.Anything).Return([]bridges.BridgeType{bt}, 1, nil)
+	m, now := newTestHealthMonitor(t, orm, healthConfig{checkTimeout: time.Second, failureThreshold: 2, cooldown: time.Hour})
+	ctx := testutils.Context(t)
+
+	healthy.Store(false)
+	m.CheckAll(ctx)
+	m.CheckAll(ctx)
+	status := m.Status(bt)
+	require.Len(t, status, 4)
+	assert.Equal(t, bridges.BreakerOpen, status[0].State)
+	assert.Equal(t, "health check failed with status code 502", status[0].LastError)
+	assert.Equal(t, now.Unix(), status[0].LastCheckedAt.Unix())
+	assert.Equal(t, bridges.BreakerClosed, status[1].State)
+	assert.Zero(t, status[1].ConsecutiveFailures)
+	assert.Equal(t, bridges.BreakerOpen, status[2].State, "adapters must answer GET requests to be healthy")
+	assert.Equal(t, "health check failed with status code 405", status[2].LastError)
+	assert.Equal(t, bridges.BreakerOpen, status[3].State)
+	assert.Equal(t, "health check failed with status code 404", status[3].LastError)
+	assert.False(t, m.Allow(bt.Name, bt.URL))
+
+	healthy.Store(true)
+	m.CheckAll(ctx)
+	assert.Equal(t, bridges.BreakerHalfOpen, m.Status(bt)[0].State)
+	assert.True(t, m.Allow(bt.Name, bt.URL), "a trial request is let through without waiting for the cooldown")
+	m.RecordSuccess(bt.Name, bt.URL, time.Second)
+	assert.Equal(t, bridges.BreakerClosed, m.Status(bt)[0].State)
+}
@@ -0,0 +1,10 @@
+package bridges
+
+import (
+	"context"
+	"time"
+)
+
+func (m *HealthMonitor) SetNow(now func() time.Time) { m.now = now }
+
+func (m *HealthMonitor) CheckAll(ctx context.Context) { m.checkAll(ctx) }
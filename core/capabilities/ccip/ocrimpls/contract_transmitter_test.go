@@ -545,6 +545,10 @@ func (t *TestHeadTrackerConfig) PersistenceEnabled() bool {
 	return true
 }
 
+func (t *TestHeadTrackerConfig) GapRepair() evmconfig.HeadTrackerGapRepair {
+	return nil
+}
+
 var _ evmconfig.HeadTracker = (*TestHeadTrackerConfig)(nil)
 
 type TestEvmConfig struct {
@@ -623,6 +627,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) FeeOracle() evmconfig.FeeOracle {
+	return nil
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
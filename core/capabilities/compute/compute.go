@@ -82,6 +82,7 @@ type Compute struct {
 	emitter  custmsg.MessageEmitter
 	registry coretypes.CapabilitiesRegistry
 	modules  *moduleCache
+	quotas   *resourceQuotas
 
 	// transformer is used to transform a values.Map into a ParsedConfig struct on each execution
 	// of a request.
@@ -158,11 +159,18 @@ func (c *Compute) execute(ctx context.Context, respCh chan response, req capabil
 		return
 	}
 
+	owner := copiedReq.Metadata.WorkflowOwner
+	if err = c.quotas.apply(owner, cfg.ModuleConfig); err != nil {
+		respCh <- response{err: observeQuotaViolation(err)}
+		return
+	}
+
 	id := generateID(cfg.Binary)
+	key := c.quotas.moduleKey(id, owner)
 
-	m, ok := c.modules.get(id)
+	m, ok := c.modules.get(key)
 	if !ok {
-		mod, innerErr := c.initModule(id, cfg.ModuleConfig, cfg.Binary, copiedReq.Metadata)
+		mod, innerErr := c.initModule(key, cfg.ModuleConfig, cfg.Binary, copiedReq.Metadata)
 		if innerErr != nil {
 			respCh <- response{err: innerErr}
 			return
@@ -179,7 +187,7 @@ func (c *Compute) execute(ctx context.Context, respCh chan response, req capabil
 	}
 }
 
-func (c *Compute) initModule(id string, cfg *host.ModuleConfig, binary []byte, requestMetadata capabilities.RequestMetadata) (*module, error) {
+func (c *Compute) initModule(key string, cfg *host.ModuleConfig, binary []byte, requestMetadata capabilities.RequestMetadata) (*module, error) {
 	initStart := time.Now()
 
 	cfg.Fetch = c.createFetcher()
@@ -194,7 +202,7 @@ func (c *Compute) initModule(id string, cfg *host.ModuleConfig, binary []byte, r
 	computeWASMInit.WithLabelValues(requestMetadata.WorkflowID, requestMetadata.ReferenceID).Observe(float64(initDuration))
 
 	m := &module{module: mod}
-	c.modules.add(id, m)
+	c.modules.add(key, m)
 	return m, nil
 }
 
@@ -211,9 +219,11 @@ func (c *Compute) executeWithModule(ctx context.Context, module *host.Module, co
 			},
 		},
 	}
+	c.quotas.fetches.start(wasmReq.Id)
+	defer c.quotas.fetches.finish(wasmReq.Id)
 	resp, err := module.Run(ctx, wasmReq)
 	if err != nil {
-		return capabilities.CapabilityResponse{}, fmt.Errorf("error running module: %w", err)
+		return capabilities.CapabilityResponse{}, fmt.Errorf("error running module: %w", observeQuotaViolation(runError(req.Metadata.WorkflowOwner, err)))
 	}
 
 	cresppb := resp.GetComputeResponse().GetResponse()
@@ -285,6 +295,13 @@ func (c *Compute) createFetcher() func(ctx context.Context, req *wasmpb.FetchReq
 			return nil, fmt.Errorf("workflow execution ID %q is invalid: %w", req.Metadata.WorkflowExecutionId, err)
 		}
 
+		if err := c.quotas.checkFetchCount(req.Metadata.WorkflowOwner, c.quotas.fetches.inc(req.Id)); err != nil {
+			return nil, observeQuotaViolation(err)
+		}
+		if err := c.quotas.checkFetch(req.Metadata.WorkflowOwner, req.Url); err != nil {
+			return nil, observeQuotaViolation(err)
+		}
+
 		cma := c.emitter.With(
 			platform.KeyWorkflowID, req.Metadata.WorkflowId,
 			platform.KeyWorkflowName, req.Metadata.WorkflowName,
@@ -323,10 +340,9 @@ func (c *Compute) createFetcher() func(ctx context.Context, req *wasmpb.FetchReq
 		}
 
 		c.log.Debugw("received gateway response", "resp", resp)
-		var response wasmpb.FetchResponse
-		err = json.Unmarshal(resp.Body.Payload, &response)
+		response, err := c.quotas.decodeResponse(req.Metadata.WorkflowOwner, resp.Body.Payload)
 		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal fetch response: %w", err)
+			return nil, observeQuotaViolation(err)
 		}
 
 		// Only log if the response is not in the 200 range
@@ -338,7 +354,7 @@ func (c *Compute) createFetcher() func(ctx context.Context, req *wasmpb.FetchReq
 			}
 		}
 
-		return &response, nil
+		return response, nil
 	}
 }
 
@@ -349,6 +365,8 @@ const (
 type Config struct {
 	webapi.ServiceConfig
 	NumWorkers int
+	// Quotas are set from the node config rather than the job spec.
+	Quotas QuotasConfig `toml:"-"`
 }
 
 func NewAction(
@@ -371,6 +389,7 @@ func NewAction(
 			emitter:                  labeler,
 			registry:                 registry,
 			modules:                  newModuleCache(clockwork.NewRealClock(), 1*time.Minute, 10*time.Minute, 3),
+			quotas:                   newResourceQuotas(config.Quotas),
 			transformer:              NewTransformer(lggr, labeler),
 			outgoingConnectorHandler: handler,
 			idGenerator:              idGenerator,
@@ -1,3 +1,15 @@
 package compute
 
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
 const timestampKey = "computeTimestamp"
+
+var (
+	computeQuotaViolations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "compute_quota_violation",
+		Help: "resource quota violations of custom compute modules",
+	}, []string{"resource"})
+)
@@ -0,0 +1,297 @@
+package compute
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytecodealliance/wasmtime-go/v23"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
+	wasmpb "github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/pb"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// Resources limited by a ResourceQuota, as reported in QuotaExceededError and metrics.
+const (
+	ResourceMemory        = "memory"
+	ResourceFuel          = "fuel"
+	ResourceWallTime      = "wallTime"
+	ResourceFetchRequests = "fetchRequests"
+	ResourceResponseBytes = "responseBytes"
+	ResourceFetchHost     = "fetchHost"
+)
+
+// responseEnvelopeBytes allows for the status code and headers of a fetch response, on top of its encoded body.
+const responseEnvelopeBytes = 64 * 1024
+
+// ResourceQuota limits the resources a WASM module may use. Zero values leave the resource unlimited by the quota, in
+// which case the host defaults apply.
+type ResourceQuota struct {
+	// MaxMemoryMBs is the most memory a module may request.
+	MaxMemoryMBs int64
+	// MaxFuel is the most fuel given to each execution.
+	MaxFuel uint64
+	// MaxTimeout is the longest a module may request to run for.
+	MaxTimeout time.Duration
+	// MaxFetchRequests is the number of fetches allowed per execution.
+	MaxFetchRequests int
+	// MaxResponseBytes is the largest fetch response body a module may receive.
+	MaxResponseBytes int
+	// AllowedHosts are the hosts a module may fetch from, as path.Match patterns, e.g. "*.example.com". Any host is
+	// allowed if empty.
+	AllowedHosts []string
+}
+
+// QuotasConfig configures the resource quotas of WASM modules by workflow owner. It is set from the node config, see
+// NewQuotasConfig.
+type QuotasConfig struct {
+	// Default applies to workflow owners without a quota of their own.
+	Default ResourceQuota
+	// Owners holds the quotas of specific workflow owners, keyed by address. They replace the default quota.
+	Owners map[string]ResourceQuota
+}
+
+// resourceQuotas resolves the quota of a workflow owner.
+type resourceQuotas struct {
+	enabled bool
+	def     ResourceQuota
+	owners  map[string]ResourceQuota
+	fetches *fetchCounter
+}
+
+// NewQuotasConfig returns the quotas of the node's Capabilities.ComputeQuotas config, which is validated on load.
+func NewQuotasConfig(cfg config.CapabilitiesComputeQuotas) QuotasConfig {
+	owners := cfg.Owners()
+	c := QuotasConfig{
+		Default: newResourceQuota(cfg.Default()),
+		Owners:  make(map[string]ResourceQuota, len(owners)),
+	}
+	for owner, quota := range owners {
+		c.Owners[owner] = newResourceQuota(quota)
+	}
+	return c
+}
+
+func newResourceQuota(q config.ComputeQuota) ResourceQuota {
+	return ResourceQuota{
+		MaxMemoryMBs:     q.MaxMemoryMBs(),
+		MaxFuel:          q.MaxFuel(),
+		MaxTimeout:       q.MaxTimeout(),
+		MaxFetchRequests: int(q.MaxFetchRequests()),
+		MaxResponseBytes: int(q.MaxResponseBytes()),
+		AllowedHosts:     q.AllowedHosts(),
+	}
+}
+
+func newResourceQuotas(cfg QuotasConfig) *resourceQuotas {
+	q := &resourceQuotas{
+		enabled: !isZeroQuota(cfg.Default) || len(cfg.Owners) > 0,
+		def:     cfg.Default,
+		owners:  make(map[string]ResourceQuota, len(cfg.Owners)),
+		fetches: newFetchCounter(),
+	}
+	for owner, quota := range cfg.Owners {
+		q.owners[normalizeOwner(owner)] = quota
+	}
+	return q
+}
+
+func isZeroQuota(q ResourceQuota) bool {
+	return q.MaxMemoryMBs == 0 && q.MaxFuel == 0 && q.MaxTimeout == 0 && q.MaxFetchRequests == 0 &&
+		q.MaxResponseBytes == 0 && len(q.AllowedHosts) == 0
+}
+
+func normalizeOwner(owner string) string {
+	return strings.TrimPrefix(strings.ToLower(owner), "0x")
+}
+
+func (q *resourceQuotas) forOwner(owner string) ResourceQuota {
+	if quota, ok := q.owners[normalizeOwner(owner)]; ok {
+		return quota
+	}
+	return q.def
+}
+
+// moduleKey returns the key of a module in the module cache. Modules are configured according to the quota of their
+// owner, so they can't be shared between owners once quotas are enabled.
+func (q *resourceQuotas) moduleKey(id, owner string) string {
+	if !q.enabled {
+		return id
+	}
+	return id + "/" + normalizeOwner(owner)
+}
+
+// apply limits the module config to the quota of the owner. Requests for more than the quota are rejected.
+func (q *resourceQuotas) apply(owner string, cfg *host.ModuleConfig) error {
+	quota := q.forOwner(owner)
+	if quota.MaxMemoryMBs > 0 {
+		if cfg.MaxMemoryMBs > quota.MaxMemoryMBs {
+			return NewQuotaExceededError(owner, ResourceMemory, fmt.Sprintf("requested %d MB, quota is %d MB", cfg.MaxMemoryMBs, quota.MaxMemoryMBs))
+		}
+		if cfg.MaxMemoryMBs == 0 {
+			cfg.MaxMemoryMBs = quota.MaxMemoryMBs
+		}
+	}
+	if quota.MaxTimeout > 0 {
+		if cfg.Timeout != nil && *cfg.Timeout > quota.MaxTimeout {
+			return NewQuotaExceededError(owner, ResourceWallTime, fmt.Sprintf("requested timeout %s, quota is %s", *cfg.Timeout, quota.MaxTimeout))
+		}
+		if cfg.Timeout == nil {
+			timeout := quota.MaxTimeout
+			cfg.Timeout = &timeout
+		}
+	}
+	// Fuel and fetch limits set by the module are kept when they are stricter than the quota.
+	if quota.MaxFuel > 0 && (cfg.InitialFuel == 0 || cfg.InitialFuel > quota.MaxFuel) {
+		cfg.InitialFuel = quota.MaxFuel
+	}
+	if quota.MaxFetchRequests > 0 && (cfg.MaxFetchRequests == 0 || cfg.MaxFetchRequests > quota.MaxFetchRequests) {
+		// The host rejects fetches over its limit without reporting them, so it is set one higher to let
+		// checkFetchCount report the violation.
+		cfg.MaxFetchRequests = quota.MaxFetchRequests + 1
+	}
+	return nil
+}
+
+// checkFetchCount rejects the n-th fetch of an execution if it exceeds the owner's quota.
+func (q *resourceQuotas) checkFetchCount(owner string, n int) error {
+	quota := q.forOwner(owner)
+	if quota.MaxFetchRequests > 0 && n > quota.MaxFetchRequests {
+		return NewQuotaExceededError(owner, ResourceFetchRequests, fmt.Sprintf("%d fetch requests, quota is %d", n, quota.MaxFetchRequests))
+	}
+	return nil
+}
+
+// checkFetch rejects fetches to hosts outside the owner's allowed hosts.
+func (q *resourceQuotas) checkFetch(owner, rawURL string) error {
+	quota := q.forOwner(owner)
+	if len(quota.AllowedHosts) == 0 {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid fetch URL: %w", err)
+	}
+	hostname := strings.ToLower(u.Hostname())
+	for _, pattern := range quota.AllowedHosts {
+		if ok, _ := path.Match(strings.ToLower(pattern), hostname); ok {
+			return nil
+		}
+	}
+	return NewQuotaExceededError(owner, ResourceFetchHost, fmt.Sprintf("host %q is not allowed", hostname))
+}
+
+// checkResponse rejects fetch responses larger than the owner's quota.
+func (q *resourceQuotas) checkResponse(owner string, size int) error {
+	quota := q.forOwner(owner)
+	if quota.MaxResponseBytes > 0 && size > quota.MaxResponseBytes {
+		return NewQuotaExceededError(owner, ResourceResponseBytes, fmt.Sprintf("response of %d bytes, quota is %d bytes", size, quota.MaxResponseBytes))
+	}
+	return nil
+}
+
+// decodeResponse decodes a fetch response from the gateway. The payload is read no further than the owner's quota allows
+// for, so oversized responses are rejected without decoding their body.
+func (q *resourceQuotas) decodeResponse(owner string, payload []byte) (*wasmpb.FetchResponse, error) {
+	var response wasmpb.FetchResponse
+	quota := q.forOwner(owner)
+	if quota.MaxResponseBytes <= 0 {
+		if err := json.Unmarshal(payload, &response); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal fetch response: %w", err)
+		}
+		return &response, nil
+	}
+
+	limit := int64(base64.StdEncoding.EncodedLen(quota.MaxResponseBytes) + responseEnvelopeBytes)
+	r := &io.LimitedReader{R: bytes.NewReader(payload), N: limit + 1}
+	if err := json.NewDecoder(r).Decode(&response); err != nil {
+		if r.N == 0 {
+			return nil, NewQuotaExceededError(owner, ResourceResponseBytes, fmt.Sprintf("response payload larger than %d bytes, quota is %d bytes", limit, quota.MaxResponseBytes))
+		}
+		return nil, fmt.Errorf("failed to unmarshal fetch response: %w", err)
+	}
+	if err := q.checkResponse(owner, len(response.Body)); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// runError converts an error running a module into a QuotaExceededError, if the module ran out of fuel or time.
+func runError(owner string, err error) error {
+	var trap *wasmtime.Trap
+	if !errors.As(err, &trap) || trap.Code() == nil {
+		return err
+	}
+	switch *trap.Code() {
+	case wasmtime.OutOfFuel:
+		return NewQuotaExceededError(owner, ResourceFuel, err.Error())
+	case wasmtime.Interrupt:
+		return NewQuotaExceededError(owner, ResourceWallTime, err.Error())
+	default:
+		return err
+	}
+}
+
+// fetchCounter counts the fetches of each module run, keyed by request ID.
+type fetchCounter struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newFetchCounter() *fetchCounter {
+	return &fetchCounter{counts: map[string]int{}}
+}
+
+func (f *fetchCounter) start(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.counts[id] = 0
+}
+
+func (f *fetchCounter) finish(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.counts, id)
+}
+
+// inc counts a fetch and returns the number of fetches of the run so far.
+func (f *fetchCounter) inc(id string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.counts[id]++
+	return f.counts[id]
+}
+
+// QuotaExceededError is returned when a module exceeds the resource quota of its workflow owner.
+type QuotaExceededError struct {
+	Owner    string
+	Resource string
+	Detail   string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s quota exceeded for workflow owner %s: %s", e.Resource, e.Owner, e.Detail)
+}
+
+func NewQuotaExceededError(owner, resource, detail string) *QuotaExceededError {
+	return &QuotaExceededError{Owner: owner, Resource: resource, Detail: detail}
+}
+
+// observeQuotaViolation counts err in the quota violation metric, if it is a QuotaExceededError, and returns it.
+func observeQuotaViolation(err error) error {
+	var qerr *QuotaExceededError
+	if errors.As(err, &qerr) {
+		computeQuotaViolations.WithLabelValues(qerr.Resource).Inc()
+	}
+	return err
+}
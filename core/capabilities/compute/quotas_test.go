@@ -0,0 +1,160 @@
+package compute
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const (
+	testOwner      = "0xAbC0000000000000000000000000000000000001"
+	testOtherOwner = "0xabc0000000000000000000000000000000000002"
+)
+
+type testComputeQuotas struct {
+	def    testComputeQuota
+	owners map[string]testComputeQuota
+}
+
+func (q testComputeQuotas) Default() config.ComputeQuota { return q.def }
+
+func (q testComputeQuotas) Owners() map[string]config.ComputeQuota {
+	owners := make(map[string]config.ComputeQuota, len(q.owners))
+	for owner, quota := range q.owners {
+		owners[owner] = quota
+	}
+	return owners
+}
+
+type testComputeQuota struct{ q ResourceQuota }
+
+func (q testComputeQuota) MaxMemoryMBs() int64       { return q.q.MaxMemoryMBs }
+func (q testComputeQuota) MaxFuel() uint64           { return q.q.MaxFuel }
+func (q testComputeQuota) MaxTimeout() time.Duration { return q.q.MaxTimeout }
+func (q testComputeQuota) MaxFetchRequests() uint32  { return uint32(q.q.MaxFetchRequests) }
+func (q testComputeQuota) MaxResponseBytes() uint32  { return uint32(q.q.MaxResponseBytes) }
+func (q testComputeQuota) AllowedHosts() []string    { return q.q.AllowedHosts }
+
+func TestNewQuotasConfig(t *testing.T) {
+	t.Parallel()
+
+	def := ResourceQuota{MaxMemoryMBs: 64, MaxTimeout: 5 * time.Second, AllowedHosts: []string{"*.example.com"}}
+	owner := ResourceQuota{MaxFuel: 1000, MaxFetchRequests: 2, MaxResponseBytes: 1024}
+	cfg := NewQuotasConfig(testComputeQuotas{
+		def:    testComputeQuota{def},
+		owners: map[string]testComputeQuota{testOtherOwner: testComputeQuota{owner}},
+	})
+
+	assert.Equal(t, def, cfg.Default)
+	assert.Equal(t, map[string]ResourceQuota{testOtherOwner: owner}, cfg.Owners)
+}
+
+func TestConfig_TOMLIgnoresQuotas(t *testing.T) {
+	t.Parallel()
+
+	var cfg Config
+	require.NoError(t, toml.Unmarshal([]byte(`
+NumWorkers = 2
+
+[Quotas.Default]
+MaxMemoryMBs = 64
+`), &cfg))
+
+	assert.Equal(t, 2, cfg.NumWorkers)
+	assert.Equal(t, QuotasConfig{}, cfg.Quotas)
+}
+
+func TestResourceQuotas_Apply(t *testing.T) {
+	t.Parallel()
+	q := newResourceQuotas(QuotasConfig{
+		Default: ResourceQuota{MaxMemoryMBs: 64, MaxTimeout: 5 * time.Second},
+		Owners: map[string]ResourceQuota{
+			testOwner: {MaxFuel: 1000, MaxFetchRequests: 2},
+		},
+	})
+
+	cfg := &host.ModuleConfig{}
+	require.NoError(t, q.apply(testOtherOwner, cfg))
+	assert.Equal(t, int64(64), cfg.MaxMemoryMBs)
+	require.NotNil(t, cfg.Timeout)
+	assert.Equal(t, 5*time.Second, *cfg.Timeout)
+
+	cfg = &host.ModuleConfig{}
+	require.NoError(t, q.apply(testOwner, cfg))
+	assert.Equal(t, uint64(1000), cfg.InitialFuel)
+	assert.Equal(t, 3, cfg.MaxFetchRequests)
+	assert.Zero(t, cfg.MaxMemoryMBs)
+
+	cfg = &host.ModuleConfig{InitialFuel: 500, MaxFetchRequests: 1}
+	require.NoError(t, q.apply(testOwner, cfg))
+	assert.Equal(t, uint64(500), cfg.InitialFuel)
+	assert.Equal(t, 1, cfg.MaxFetchRequests)
+
+	cfg = &host.ModuleConfig{InitialFuel: 5000, MaxFetchRequests: 10}
+	require.NoError(t, q.apply(testOwner, cfg))
+	assert.Equal(t, uint64(1000), cfg.InitialFuel)
+	assert.Equal(t, 3, cfg.MaxFetchRequests)
+
+	var qerr *QuotaExceededError
+	err := q.apply(testOtherOwner, &host.ModuleConfig{MaxMemoryMBs: 128})
+	require.True(t, errors.As(err, &qerr))
+	assert.Equal(t, ResourceMemory, qerr.Resource)
+
+	timeout := 10 * time.Second
+	err = q.apply(testOtherOwner, &host.ModuleConfig{Timeout: &timeout})
+	require.True(t, errors.As(err, &qerr))
+	assert.Equal(t, ResourceWallTime, qerr.Resource)
+
+	assert.Equal(t, "id/abc0000000000000000000000000000000000001", q.moduleKey("id", testOwner))
+	assert.Equal(t, "id", newResourceQuotas(QuotasConfig{}).moduleKey("id", testOwner))
+}
+
+func TestResourceQuotas_Fetch(t *testing.T) {
+	t.Parallel()
+	q := newResourceQuotas(QuotasConfig{
+		Default: ResourceQuota{AllowedHosts: []string{"*.example.com", "api.chain.link"}, MaxResponseBytes: 10, MaxFetchRequests: 1},
+	})
+
+	require.NoError(t, q.checkFetch(testOwner, "https://data.example.com/price"))
+	require.NoError(t, q.checkFetch(testOwner, "https://API.chain.link:8443/"))
+
+	var qerr *QuotaExceededError
+	require.True(t, errors.As(q.checkFetch(testOwner, "https://example.org"), &qerr))
+	assert.Equal(t, ResourceFetchHost, qerr.Resource)
+	assert.Error(t, q.checkFetch(testOwner, "https://example.com"))
+
+	require.NoError(t, q.checkResponse(testOwner, 10))
+	require.True(t, errors.As(q.checkResponse(testOwner, 11), &qerr))
+	assert.Equal(t, ResourceResponseBytes, qerr.Resource)
+
+	response, err := q.decodeResponse(testOwner, []byte(`{"statusCode":200,"body":"MDEyMzQ1Njc4OQ=="}`))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("0123456789"), response.Body)
+	_, err = q.decodeResponse(testOwner, []byte(`{"statusCode":200,"body":"MDEyMzQ1Njc4OTA="}`))
+	require.True(t, errors.As(err, &qerr))
+	assert.Equal(t, ResourceResponseBytes, qerr.Resource)
+	_, err = q.decodeResponse(testOwner, []byte(`{"statusCode":200,"body":"`+strings.Repeat("A", responseEnvelopeBytes+16)+`"}`))
+	require.True(t, errors.As(err, &qerr))
+	assert.Equal(t, ResourceResponseBytes, qerr.Resource)
+	_, err = q.decodeResponse(testOwner, []byte(`{"statusCode":`))
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &qerr))
+
+	q.fetches.start("req")
+	require.NoError(t, q.checkFetchCount(testOwner, q.fetches.inc("req")))
+	require.True(t, errors.As(q.checkFetchCount(testOwner, q.fetches.inc("req")), &qerr))
+	assert.Equal(t, ResourceFetchRequests, qerr.Resource)
+	q.fetches.finish("req")
+	assert.Empty(t, q.fetches.counts)
+
+	assert.NoError(t, newResourceQuotas(QuotasConfig{}).checkFetch(testOwner, "https://example.org"))
+}
@@ -110,6 +110,7 @@ func NewChainClient(
 	lggr logger.Logger,
 	selectionMode string,
 	leaseDuration time.Duration,
+	switchMarginPercent uint32,
 	nodes []commonclient.Node[*big.Int, *RPCClient],
 	sendonlys []commonclient.SendOnlyNode[*big.Int, *RPCClient],
 	chainID *big.Int,
@@ -122,6 +123,7 @@ func NewChainClient(
 		lggr,
 		selectionMode,
 		leaseDuration,
+		switchMarginPercent,
 		nodes,
 		sendonlys,
 		chainID,
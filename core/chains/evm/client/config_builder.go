@@ -29,6 +29,7 @@ type NodeConfig struct {
 func NewClientConfigs(
 	selectionMode *string,
 	leaseDuration time.Duration,
+	switchMarginPercent *uint32,
 	chainType string,
 	nodeCfgs []NodeConfig,
 	pollFailureThreshold *uint32,
@@ -52,6 +53,7 @@ func NewClientConfigs(
 	nodePool := toml.NodePool{
 		SelectionMode:              selectionMode,
 		LeaseDuration:              commonconfig.MustNewDuration(leaseDuration),
+		SwitchMarginPercent:        switchMarginPercent,
 		PollFailureThreshold:       pollFailureThreshold,
 		PollInterval:               commonconfig.MustNewDuration(pollInterval),
 		SyncThreshold:              syncThreshold,
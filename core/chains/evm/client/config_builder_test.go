@@ -18,6 +18,7 @@ func TestClientConfigBuilder(t *testing.T) {
 
 	selectionMode := ptr("HighestHead")
 	leaseDuration := 0 * time.Second
+	switchMarginPercent := ptr(uint32(20))
 	pollFailureThreshold := ptr(uint32(5))
 	pollInterval := 10 * time.Second
 	syncThreshold := ptr(uint32(5))
@@ -38,7 +39,7 @@ func TestClientConfigBuilder(t *testing.T) {
 	finalityTagEnabled := ptr(true)
 	noNewHeadsThreshold := time.Second
 	newHeadsPollInterval := 0 * time.Second
-	chainCfg, nodePool, nodes, err := client.NewClientConfigs(selectionMode, leaseDuration, chainTypeStr, nodeConfigs,
+	chainCfg, nodePool, nodes, err := client.NewClientConfigs(selectionMode, leaseDuration, switchMarginPercent, chainTypeStr, nodeConfigs,
 		pollFailureThreshold, pollInterval, syncThreshold, nodeIsSyncingEnabled, noNewHeadsThreshold, finalityDepth,
 		finalityTagEnabled, finalizedBlockOffset, enforceRepeatableRead, deathDeclarationDelay, noNewFinalizedBlocksThreshold,
 		pollInterval, newHeadsPollInterval)
@@ -47,6 +48,7 @@ func TestClientConfigBuilder(t *testing.T) {
 	// Validate node pool configs
 	require.Equal(t, *selectionMode, nodePool.SelectionMode())
 	require.Equal(t, leaseDuration, nodePool.LeaseDuration())
+	require.Equal(t, *switchMarginPercent, nodePool.SwitchMarginPercent())
 	require.Equal(t, *pollFailureThreshold, nodePool.PollFailureThreshold())
 	require.Equal(t, pollInterval, nodePool.PollInterval())
 	require.Equal(t, *syncThreshold, nodePool.SyncThreshold())
@@ -28,14 +28,18 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 		} else {
 			rpc := NewRPCClient(cfg, lggr, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i,
 				chainID, commonclient.Primary, largePayloadRPCTimeout, defaultRPCTimeout, chainType)
+			weight := uint32(1)
+			if node.Weight != nil {
+				weight = *node.Weight
+			}
 			primaryNode := commonclient.NewNode(cfg, chainCfg,
-				lggr, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i, chainID, *node.Order,
+				lggr, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i, chainID, *node.Order, weight,
 				rpc, "EVM")
 			primaries = append(primaries, primaryNode)
 		}
 	}
 
-	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(),
+	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), cfg.SwitchMarginPercent(),
 		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType), nil
 }
 
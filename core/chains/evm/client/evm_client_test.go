@@ -19,6 +19,7 @@ func TestNewEvmClient(t *testing.T) {
 	noNewHeadsThreshold := 3 * time.Minute
 	selectionMode := ptr("HighestHead")
 	leaseDuration := 0 * time.Second
+	switchMarginPercent := ptr(uint32(20))
 	pollFailureThreshold := ptr(uint32(5))
 	pollInterval := 10 * time.Second
 	syncThreshold := ptr(uint32(5))
@@ -39,7 +40,7 @@ func TestNewEvmClient(t *testing.T) {
 	}
 	finalityDepth := ptr(uint32(10))
 	finalityTagEnabled := ptr(true)
-	chainCfg, nodePool, nodes, err := client.NewClientConfigs(selectionMode, leaseDuration, chainTypeStr, nodeConfigs,
+	chainCfg, nodePool, nodes, err := client.NewClientConfigs(selectionMode, leaseDuration, switchMarginPercent, chainTypeStr, nodeConfigs,
 		pollFailureThreshold, pollInterval, syncThreshold, nodeIsSyncingEnabled, noNewHeadsThreshold, finalityDepth,
 		finalityTagEnabled, finalizedBlockOffset, enforceRepeatableRead, deathDeclarationDelay, noNewFinalizedBlocksThreshold,
 		finalizedBlockPollInterval, newHeadsPollInterval)
@@ -86,6 +86,7 @@ type TestNodePoolConfig struct {
 	NodeSelectionMode              string
 	NodeSyncThreshold              uint32
 	NodeLeaseDuration              time.Duration
+	NodeSwitchMarginPercent        uint32
 	NodeIsSyncingEnabledVal        bool
 	NodeFinalizedBlockPollInterval time.Duration
 	NodeErrors                     config.ClientErrors
@@ -102,6 +103,10 @@ func (tc TestNodePoolConfig) LeaseDuration() time.Duration {
 	return tc.NodeLeaseDuration
 }
 
+func (tc TestNodePoolConfig) SwitchMarginPercent() uint32 {
+	return tc.NodeSwitchMarginPercent
+}
+
 func (tc TestNodePoolConfig) NodeIsSyncingEnabled() bool {
 	return tc.NodeIsSyncingEnabledVal
 }
@@ -153,7 +158,7 @@ func NewChainClientWithTestNode(
 	rpc := NewRPCClient(nodePoolCfg, lggr, parsed, rpcHTTPURL, "eth-primary-rpc-0", id, chainID, commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
 
 	n := commonclient.NewNode[*big.Int, *evmtypes.Head, *RPCClient](
-		nodeCfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, rpcHTTPURL, "eth-primary-node-0", id, chainID, 1, rpc, "EVM")
+		nodeCfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, rpcHTTPURL, "eth-primary-node-0", id, chainID, 1, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *RPCClient]{n}
 
 	var sendonlys []commonclient.SendOnlyNode[*big.Int, *RPCClient]
@@ -168,7 +173,7 @@ func NewChainClientWithTestNode(
 	}
 
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, primaries, sendonlys, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, 0, primaries, sendonlys, chainID, &clientErrors, 0, "")
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -182,7 +187,7 @@ func NewChainClientWithEmptyNode(
 ) Client {
 	lggr := logger.Test(t)
 
-	c := NewChainClient(lggr, selectionMode, leaseDuration, nil, nil, chainID, nil, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, 0, nil, nil, chainID, nil, 0, "")
 	t.Cleanup(c.Close)
 	return c
 }
@@ -203,10 +208,10 @@ func NewChainClientWithMockedRpc(
 	parsed, _ := url.ParseRequestURI("ws://test")
 
 	n := commonclient.NewNode[*big.Int, *evmtypes.Head, *RPCClient](
-		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, nil, "eth-primary-node-0", 1, chainID, 1, rpc, "EVM")
+		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, nil, "eth-primary-node-0", 1, chainID, 1, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *RPCClient]{n}
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, selectionMode, leaseDuration, primaries, nil, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, 0, primaries, nil, chainID, &clientErrors, 0, "")
 	t.Cleanup(c.Close)
 	return c
 }
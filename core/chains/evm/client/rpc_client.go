@@ -119,10 +119,14 @@ type RPCClient struct {
 	highestUserObservations commonclient.ChainInfo
 	// most recent chain info observed during current lifecycle (reseted on DisconnectAll)
 	latestChainInfo commonclient.ChainInfo
+
+	// observeCall is called with the duration and outcome of every call, see SetCallObserver
+	observeCall func(latency time.Duration, err error)
 }
 
 var _ commonclient.RPCClient[*big.Int, *evmtypes.Head] = (*RPCClient)(nil)
 var _ commonclient.SendTxRPCClient[*types.Transaction] = (*RPCClient)(nil)
+var _ commonclient.CallObservable = (*RPCClient)(nil)
 
 func NewRPCClient(
 	cfg config.NodePool,
@@ -285,6 +289,11 @@ func (r *RPCClient) String() string {
 	return s
 }
 
+// SetCallObserver registers observe to be called with the duration and outcome of every call made by the client.
+func (r *RPCClient) SetCallObserver(observe func(latency time.Duration, err error)) {
+	r.observeCall = observe
+}
+
 func (r *RPCClient) logResult(
 	lggr logger.Logger,
 	err error,
@@ -294,6 +303,15 @@ func (r *RPCClient) logResult(
 	results ...interface{},
 ) {
 	lggr = logger.With(lggr, "duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
+	if r.observeCall != nil {
+		// JSON-RPC errors, such as reverts, are answers from the RPC and not a sign of its health.
+		var jsonErr rpc.Error
+		if errors.As(err, &jsonErr) {
+			r.observeCall(callDuration, nil)
+		} else {
+			r.observeCall(callDuration, err)
+		}
+	}
 	promEVMPoolRPCNodeCalls.WithLabelValues(r.chainID.String(), r.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(r.chainID.String(), r.name).Inc()
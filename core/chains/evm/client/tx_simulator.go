@@ -29,6 +29,14 @@ func simulateTransactionDefault(ctx context.Context, client simulatorClient, msg
 	return client.CallContext(ctx, &result, "eth_estimateGas", toCallArg(msg), "pending")
 }
 
+// SimulateCall executes the call against the pending block, and returns its return value. If the call reverts, the
+// error holds the revert data, see ExtractRPCError.
+func SimulateCall(ctx context.Context, client simulatorClient, msg ethereum.CallMsg) ([]byte, error) {
+	var result hexutil.Bytes
+	err := client.CallContext(ctx, &result, "eth_call", toCallArg(msg), "pending")
+	return result, err
+}
+
 func toCallArg(msg ethereum.CallMsg) interface{} {
 	arg := map[string]interface{}{
 		"from": msg.From,
@@ -49,7 +49,7 @@ func (e *EVMConfig) TOMLString() (string, error) {
 }
 
 func (e *EVMConfig) BalanceMonitor() BalanceMonitor {
-	return &balanceMonitorConfig{c: e.C.BalanceMonitor}
+	return &balanceMonitorConfig{c: e.C.BalanceMonitor, k: e.C.KeySpecific}
 }
 
 func (e *EVMConfig) Transactions() Transactions {
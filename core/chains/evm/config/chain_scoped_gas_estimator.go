@@ -1,6 +1,7 @@
 package config
 
 import (
+	"net/url"
 	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -43,6 +44,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) FeeOracle() FeeOracle {
+	return &feeOracleConfig{c: g.c.FeeOracle}
+}
+
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
 	return &daOracleConfig{c: g.c.DAOracle}
 }
@@ -216,3 +221,55 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type feeOracleConfig struct {
+	c toml.FeeOracleEstimator
+}
+
+func (o *feeOracleConfig) URLs() []*url.URL {
+	if o.c.URLs == nil {
+		return nil
+	}
+	urls := make([]*url.URL, len(*o.c.URLs))
+	for i, u := range *o.c.URLs {
+		urls[i] = u.URL()
+	}
+	return urls
+}
+
+func (o *feeOracleConfig) PricePath() string {
+	if o.c.PricePath == nil {
+		return ""
+	}
+	return *o.c.PricePath
+}
+
+func (o *feeOracleConfig) TipCapPath() string {
+	if o.c.TipCapPath == nil {
+		return ""
+	}
+	return *o.c.TipCapPath
+}
+
+func (o *feeOracleConfig) FeeCapPath() string {
+	if o.c.FeeCapPath == nil {
+		return ""
+	}
+	return *o.c.FeeCapPath
+}
+
+func (o *feeOracleConfig) Unit() string {
+	return *o.c.Unit
+}
+
+func (o *feeOracleConfig) CacheTimeout() time.Duration {
+	return o.c.CacheTimeout.Duration()
+}
+
+func (o *feeOracleConfig) RequestTimeout() time.Duration {
+	return o.c.RequestTimeout.Duration()
+}
+
+func (o *feeOracleConfig) FallbackMode() string {
+	return *o.c.FallbackMode
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -33,3 +35,31 @@ func (h *headTrackerConfig) MaxAllowedFinalityDepth() uint32 {
 func (h *headTrackerConfig) PersistenceEnabled() bool {
 	return *h.c.PersistenceEnabled
 }
+
+func (h *headTrackerConfig) GapRepair() HeadTrackerGapRepair {
+	return &headTrackerGapRepairConfig{c: h.c.GapRepair}
+}
+
+type headTrackerGapRepairConfig struct {
+	c toml.HeadTrackerGapRepair
+}
+
+func (r *headTrackerGapRepairConfig) Enabled() bool {
+	return *r.c.Enabled
+}
+
+func (r *headTrackerGapRepairConfig) BatchSize() uint32 {
+	return *r.c.BatchSize
+}
+
+func (r *headTrackerGapRepairConfig) Interval() time.Duration {
+	return r.c.Interval.Duration()
+}
+
+func (r *headTrackerGapRepairConfig) TrustedCheckpointNumber() *int64 {
+	return r.c.TrustedCheckpointNumber
+}
+
+func (r *headTrackerGapRepairConfig) TrustedCheckpointHash() *common.Hash {
+	return r.c.TrustedCheckpointHash
+}
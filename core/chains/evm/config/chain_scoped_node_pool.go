@@ -30,6 +30,10 @@ func (n *NodePoolConfig) LeaseDuration() time.Duration {
 	return n.C.LeaseDuration.Duration()
 }
 
+func (n *NodePoolConfig) SwitchMarginPercent() uint32 {
+	return *n.C.SwitchMarginPercent
+}
+
 func (n *NodePoolConfig) NodeIsSyncingEnabled() bool {
 	return *n.C.NodeIsSyncingEnabled
 }
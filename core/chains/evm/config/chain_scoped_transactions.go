@@ -4,6 +4,9 @@ import (
 	"net/url"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -39,6 +42,14 @@ func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) Forwarders() Forwarders {
+	return &forwardersConfig{c: t.c.Forwarders}
+}
+
+func (t *transactionsConfig) Priority() txmgrtypes.TxPriorityConfig {
+	return &priorityConfig{c: t.c.Priority}
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +69,55 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type forwardersConfig struct {
+	c toml.ForwardersConfig
+}
+
+func (f *forwardersConfig) DiscoveryEnabled() bool {
+	return *f.c.DiscoveryEnabled
+}
+
+func (f *forwardersConfig) DiscoveryAddresses() (addrs []gethcommon.Address) {
+	if f.c.DiscoveryAddresses == nil {
+		return nil
+	}
+	for _, a := range *f.c.DiscoveryAddresses {
+		addrs = append(addrs, a.Address())
+	}
+	return
+}
+
+func (f *forwardersConfig) DiscoveryStartBlock() int64 {
+	if f.c.DiscoveryStartBlock == nil {
+		return 0
+	}
+	return *f.c.DiscoveryStartBlock
+}
+
+func (f *forwardersConfig) DiscoveryInterval() time.Duration {
+	return f.c.DiscoveryInterval.Duration()
+}
+
+func (f *forwardersConfig) VerificationInterval() time.Duration {
+	return f.c.VerificationInterval.Duration()
+}
+
+type priorityConfig struct {
+	c toml.PriorityConfig
+}
+
+func (p *priorityConfig) StarvationTimeout() time.Duration {
+	return p.c.StarvationTimeout.Duration()
+}
+
+func (p *priorityConfig) MaxQueued(priority txmgrtypes.TxPriority) uint64 {
+	switch {
+	case priority >= txmgrtypes.TxPriorityHigh:
+		return uint64(*p.c.MaxQueuedHigh)
+	case priority <= txmgrtypes.TxPriorityLow:
+		return uint64(*p.c.MaxQueuedLow)
+	default:
+		return uint64(*p.c.MaxQueuedNormal)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
@@ -78,10 +79,30 @@ type HeadTracker interface {
 	FinalityTagBypass() bool
 	MaxAllowedFinalityDepth() uint32
 	PersistenceEnabled() bool
+	GapRepair() HeadTrackerGapRepair
+}
+
+type HeadTrackerGapRepair interface {
+	Enabled() bool
+	BatchSize() uint32
+	Interval() time.Duration
+	TrustedCheckpointNumber() *int64
+	TrustedCheckpointHash() *gethcommon.Hash
 }
 
 type BalanceMonitor interface {
 	Enabled() bool
+	LowBalanceThreshold() *assets.Wei
+	LowBalanceThresholdKey(gethcommon.Address) *assets.Wei
+	WebhookURL() *url.URL
+	TopUp() BalanceMonitorTopUp
+}
+
+type BalanceMonitorTopUp interface {
+	Enabled() bool
+	TreasuryAddress() *types.EIP55Address
+	Amount() *assets.Wei
+	MinInterval() time.Duration
 }
 
 type ClientErrors interface {
@@ -110,6 +131,16 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	Forwarders() Forwarders
+	Priority() txmgrtypes.TxPriorityConfig
+}
+
+type Forwarders interface {
+	DiscoveryEnabled() bool
+	DiscoveryAddresses() []gethcommon.Address
+	DiscoveryStartBlock() int64
+	DiscoveryInterval() time.Duration
+	VerificationInterval() time.Duration
 }
 
 type AutoPurgeConfig interface {
@@ -122,6 +153,7 @@ type AutoPurgeConfig interface {
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	FeeOracle() FeeOracle
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -174,6 +206,17 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type FeeOracle interface {
+	URLs() []*url.URL
+	PricePath() string
+	TipCapPath() string
+	FeeCapPath() string
+	Unit() string
+	CacheTimeout() time.Duration
+	RequestTimeout() time.Duration
+	FallbackMode() string
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -186,6 +229,7 @@ type NodePool interface {
 	SelectionMode() string
 	SyncThreshold() uint32
 	LeaseDuration() time.Duration
+	SwitchMarginPercent() uint32
 	NodeIsSyncingEnabled() bool
 	FinalizedBlockPollInterval() time.Duration
 	Errors() ClientErrors
@@ -7,9 +7,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
@@ -247,6 +251,19 @@ func TestChainScopedConfig_FeeHistory(t *testing.T) {
 	assert.Equal(t, 10*time.Second, u.CacheTimeout())
 }
 
+func TestChainScopedConfig_FeeOracle(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	o := cfg.EVM().GasEstimator().FeeOracle()
+	assert.Empty(t, o.URLs())
+	assert.Equal(t, "", o.PricePath())
+	assert.Equal(t, "gwei", o.Unit())
+	assert.Equal(t, 10*time.Second, o.CacheTimeout())
+	assert.Equal(t, 5*time.Second, o.RequestTimeout())
+	assert.Equal(t, "BlockHistory", o.FallbackMode())
+}
+
 func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	t.Parallel()
 	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
@@ -344,6 +361,57 @@ func TestChainScopedConfig_HeadTracker(t *testing.T) {
 	assert.Equal(t, true, ht.FinalityTagBypass())
 	assert.Equal(t, uint32(10000), ht.MaxAllowedFinalityDepth())
 	assert.Equal(t, true, ht.PersistenceEnabled())
+
+	gr := ht.GapRepair()
+	assert.False(t, gr.Enabled())
+	assert.Equal(t, uint32(100), gr.BatchSize())
+	assert.Equal(t, time.Minute, gr.Interval())
+	assert.Nil(t, gr.TrustedCheckpointNumber())
+	assert.Nil(t, gr.TrustedCheckpointHash())
+}
+
+func TestChainScopedConfig_Forwarders(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	fwd := cfg.EVM().Transactions().Forwarders()
+	assert.False(t, fwd.DiscoveryEnabled())
+	assert.Empty(t, fwd.DiscoveryAddresses())
+	assert.Zero(t, fwd.DiscoveryStartBlock())
+	assert.Equal(t, time.Minute, fwd.DiscoveryInterval())
+	assert.Equal(t, 5*time.Minute, fwd.VerificationInterval())
+
+	addr := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	cfg = testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		enabled := true
+		c.Transactions.Forwarders.DiscoveryEnabled = &enabled
+		c.Transactions.Forwarders.DiscoveryAddresses = &[]types.EIP55Address{addr}
+		c.Transactions.Forwarders.DiscoveryStartBlock = ptr[int64](100)
+	})
+	fwd = cfg.EVM().Transactions().Forwarders()
+	assert.True(t, fwd.DiscoveryEnabled())
+	assert.Equal(t, []common.Address{addr.Address()}, fwd.DiscoveryAddresses())
+	assert.Equal(t, int64(100), fwd.DiscoveryStartBlock())
+}
+
+func TestChainScopedConfig_TxPriority(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	p := cfg.EVM().Transactions().Priority()
+	assert.Equal(t, 5*time.Minute, p.StarvationTimeout())
+	assert.Zero(t, p.MaxQueued(txmgrtypes.TxPriorityHigh))
+
+	cfg = testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.Transactions.Priority.StarvationTimeout = commonconfig.MustNewDuration(0)
+		c.Transactions.Priority.MaxQueuedHigh = ptr[uint32](100)
+		c.Transactions.Priority.MaxQueuedLow = ptr[uint32](10)
+	})
+	p = cfg.EVM().Transactions().Priority()
+	assert.Zero(t, p.StarvationTimeout())
+	assert.Equal(t, uint64(100), p.MaxQueued(txmgrtypes.TxPriorityHigh))
+	assert.Equal(t, uint64(0), p.MaxQueued(txmgrtypes.TxPriorityNormal))
+	assert.Equal(t, uint64(10), p.MaxQueued(txmgrtypes.TxPriorityLow))
 }
 
 func TestNodePoolConfig(t *testing.T) {
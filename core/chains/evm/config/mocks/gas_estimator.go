@@ -484,6 +484,53 @@ func (_c *GasEstimator_FeeHistory_Call) RunAndReturn(run func() config.FeeHistor
 	return _c
 }
 
+// FeeOracle provides a mock function with given fields:
+func (_m *GasEstimator) FeeOracle() config.FeeOracle {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeOracle")
+	}
+
+	var r0 config.FeeOracle
+	if rf, ok := ret.Get(0).(func() config.FeeOracle); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.FeeOracle)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_FeeOracle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeOracle'
+type GasEstimator_FeeOracle_Call struct {
+	*mock.Call
+}
+
+// FeeOracle is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) FeeOracle() *GasEstimator_FeeOracle_Call {
+	return &GasEstimator_FeeOracle_Call{Call: _e.mock.On("FeeOracle")}
+}
+
+func (_c *GasEstimator_FeeOracle_Call) Run(run func()) *GasEstimator_FeeOracle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_FeeOracle_Call) Return(_a0 config.FeeOracle) *GasEstimator_FeeOracle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_FeeOracle_Call) RunAndReturn(run func() config.FeeOracle) *GasEstimator_FeeOracle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LimitDefault provides a mock function with given fields:
 func (_m *GasEstimator) LimitDefault() uint64 {
 	ret := _m.Called()
@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/shopspring/decimal"
@@ -17,6 +18,7 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -479,7 +481,9 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge  AutoPurgeConfig  `toml:",omitempty"`
+	Forwarders ForwardersConfig `toml:",omitempty"`
+	Priority   PriorityConfig   `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -502,6 +506,72 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.Forwarders.setFrom(&f.Forwarders)
+	t.Priority.setFrom(&f.Priority)
+}
+
+type PriorityConfig struct {
+	StarvationTimeout *commonconfig.Duration
+	MaxQueuedHigh     *uint32
+	MaxQueuedNormal   *uint32
+	MaxQueuedLow      *uint32
+}
+
+func (c *PriorityConfig) setFrom(f *PriorityConfig) {
+	if v := f.StarvationTimeout; v != nil {
+		c.StarvationTimeout = v
+	}
+	if v := f.MaxQueuedHigh; v != nil {
+		c.MaxQueuedHigh = v
+	}
+	if v := f.MaxQueuedNormal; v != nil {
+		c.MaxQueuedNormal = v
+	}
+	if v := f.MaxQueuedLow; v != nil {
+		c.MaxQueuedLow = v
+	}
+}
+
+type ForwardersConfig struct {
+	DiscoveryEnabled     *bool
+	DiscoveryAddresses   *[]types.EIP55Address
+	DiscoveryStartBlock  *int64
+	DiscoveryInterval    *commonconfig.Duration
+	VerificationInterval *commonconfig.Duration
+}
+
+func (c *ForwardersConfig) setFrom(f *ForwardersConfig) {
+	if v := f.DiscoveryEnabled; v != nil {
+		c.DiscoveryEnabled = v
+	}
+	if v := f.DiscoveryAddresses; v != nil {
+		c.DiscoveryAddresses = v
+	}
+	if v := f.DiscoveryStartBlock; v != nil {
+		c.DiscoveryStartBlock = v
+	}
+	if v := f.DiscoveryInterval; v != nil {
+		c.DiscoveryInterval = v
+	}
+	if v := f.VerificationInterval; v != nil {
+		c.VerificationInterval = v
+	}
+}
+
+func (c *ForwardersConfig) ValidateConfig() (err error) {
+	if c.DiscoveryEnabled != nil && *c.DiscoveryEnabled && (c.DiscoveryAddresses == nil || len(*c.DiscoveryAddresses) == 0) {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "DiscoveryAddresses", Msg: "must be set if discovery is enabled"})
+	}
+	if c.DiscoveryStartBlock != nil && *c.DiscoveryStartBlock <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "DiscoveryStartBlock", Value: *c.DiscoveryStartBlock, Msg: "must be greater than 0"})
+	}
+	if c.DiscoveryInterval != nil && c.DiscoveryInterval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "DiscoveryInterval", Value: c.DiscoveryInterval, Msg: "must be greater than 0"})
+	}
+	if c.VerificationInterval != nil && c.VerificationInterval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "VerificationInterval", Value: c.VerificationInterval, Msg: "must be greater than 0"})
+	}
+	return
 }
 
 type AutoPurgeConfig struct {
@@ -564,13 +634,65 @@ func (m *Workflow) setFrom(f *Workflow) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled             *bool
+	LowBalanceThreshold *assets.Wei
+	WebhookURL          *commonconfig.URL
+
+	TopUp BalanceMonitorTopUp `toml:",omitempty"`
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.LowBalanceThreshold; v != nil {
+		m.LowBalanceThreshold = v
+	}
+	if v := f.WebhookURL; v != nil {
+		m.WebhookURL = v
+	}
+	m.TopUp.setFrom(&f.TopUp)
+}
+
+func (m *BalanceMonitor) ValidateConfig() (err error) {
+	if m.WebhookURL != nil {
+		switch m.WebhookURL.Scheme {
+		case "http", "https":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "WebhookURL", Value: m.WebhookURL.Scheme, Msg: "must be http or https"})
+		}
+	}
+	if m.TopUp.Enabled != nil && *m.TopUp.Enabled {
+		if m.TopUp.TreasuryAddress == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "TopUp.TreasuryAddress", Msg: "must be set if top-up is enabled"})
+		}
+		if m.TopUp.Amount == nil || m.TopUp.Amount.Cmp(assets.NewWeiI(0)) <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TopUp.Amount", Value: m.TopUp.Amount, Msg: "must be greater than zero if top-up is enabled"})
+		}
+	}
+	return
+}
+
+type BalanceMonitorTopUp struct {
+	Enabled         *bool
+	TreasuryAddress *types.EIP55Address
+	Amount          *assets.Wei
+	MinInterval     *commonconfig.Duration
+}
+
+func (t *BalanceMonitorTopUp) setFrom(f *BalanceMonitorTopUp) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.TreasuryAddress; v != nil {
+		t.TreasuryAddress = v
+	}
+	if v := f.Amount; v != nil {
+		t.Amount = v
+	}
+	if v := f.MinInterval; v != nil {
+		t.MinInterval = v
+	}
 }
 
 type GasEstimator struct {
@@ -600,6 +722,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	FeeOracle    FeeOracleEstimator    `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -637,6 +760,9 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeOracle" {
+		err = multierr.Append(err, e.FeeOracle.validateConfig(*e.EIP1559DynamicFees))
+	}
 
 	return
 }
@@ -696,6 +822,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.FeeOracle.setFrom(&f.FeeOracle)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -769,6 +896,87 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type FeeOracleEstimator struct {
+	URLs           *[]*commonconfig.URL
+	PricePath      *string
+	TipCapPath     *string
+	FeeCapPath     *string
+	Unit           *string
+	CacheTimeout   *commonconfig.Duration
+	RequestTimeout *commonconfig.Duration
+	FallbackMode   *string
+}
+
+func (o *FeeOracleEstimator) setFrom(f *FeeOracleEstimator) {
+	if v := f.URLs; v != nil {
+		o.URLs = v
+	}
+	if v := f.PricePath; v != nil {
+		o.PricePath = v
+	}
+	if v := f.TipCapPath; v != nil {
+		o.TipCapPath = v
+	}
+	if v := f.FeeCapPath; v != nil {
+		o.FeeCapPath = v
+	}
+	if v := f.Unit; v != nil {
+		o.Unit = v
+	}
+	if v := f.CacheTimeout; v != nil {
+		o.CacheTimeout = v
+	}
+	if v := f.RequestTimeout; v != nil {
+		o.RequestTimeout = v
+	}
+	if v := f.FallbackMode; v != nil {
+		o.FallbackMode = v
+	}
+}
+
+func (o *FeeOracleEstimator) validateConfig(eip1559 bool) (err error) {
+	if o.URLs == nil || len(*o.URLs) == 0 {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "FeeOracle.URLs", Msg: "must be set with FeeOracle Mode"})
+	} else {
+		for i, u := range *o.URLs {
+			if u == nil {
+				err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("FeeOracle.URLs[%d]", i), Msg: "must be a URL"})
+			} else if u.Scheme != "http" && u.Scheme != "https" {
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("FeeOracle.URLs[%d]", i), Value: u.Scheme, Msg: "must be http or https"})
+			}
+		}
+	}
+	if eip1559 {
+		if o.TipCapPath == nil || *o.TipCapPath == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "FeeOracle.TipCapPath", Msg: "must be set with FeeOracle Mode and EIP1559DynamicFees"})
+		}
+		if o.FeeCapPath == nil || *o.FeeCapPath == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "FeeOracle.FeeCapPath", Msg: "must be set with FeeOracle Mode and EIP1559DynamicFees"})
+		}
+	} else if o.PricePath == nil || *o.PricePath == "" {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "FeeOracle.PricePath", Msg: "must be set with FeeOracle Mode"})
+	}
+	if o.Unit != nil {
+		switch *o.Unit {
+		case "wei", "gwei":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FeeOracle.Unit", Value: *o.Unit, Msg: "must be wei or gwei"})
+		}
+	}
+	if o.RequestTimeout != nil && o.RequestTimeout.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FeeOracle.RequestTimeout", Value: o.RequestTimeout, Msg: "must be greater than zero"})
+	}
+	if o.FallbackMode != nil {
+		switch *o.FallbackMode {
+		case "BlockHistory", "FixedPrice", "SuggestedPrice", "FeeHistory":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FeeOracle.FallbackMode", Value: *o.FallbackMode,
+				Msg: "must be one of BlockHistory, FixedPrice, SuggestedPrice or FeeHistory"})
+		}
+	}
+	return
+}
+
 type DAOracle struct {
 	OracleType             *DAOracleType
 	OracleAddress          *types.EIP55Address
@@ -840,8 +1048,9 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 }
 
 type KeySpecific struct {
-	Key          *types.EIP55Address
-	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	Key            *types.EIP55Address
+	GasEstimator   KeySpecificGasEstimator   `toml:",omitempty"`
+	BalanceMonitor KeySpecificBalanceMonitor `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -854,6 +1063,16 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+type KeySpecificBalanceMonitor struct {
+	LowBalanceThreshold *assets.Wei
+}
+
+func (m *KeySpecificBalanceMonitor) setFrom(f *KeySpecificBalanceMonitor) {
+	if v := f.LowBalanceThreshold; v != nil {
+		m.LowBalanceThreshold = v
+	}
+}
+
 type HeadTracker struct {
 	HistoryDepth            *uint32
 	MaxBufferSize           *uint32
@@ -861,6 +1080,8 @@ type HeadTracker struct {
 	MaxAllowedFinalityDepth *uint32
 	FinalityTagBypass       *bool
 	PersistenceEnabled      *bool
+
+	GapRepair HeadTrackerGapRepair `toml:",omitempty"`
 }
 
 func (t *HeadTracker) setFrom(f *HeadTracker) {
@@ -882,6 +1103,7 @@ func (t *HeadTracker) setFrom(f *HeadTracker) {
 	if v := f.PersistenceEnabled; v != nil {
 		t.PersistenceEnabled = v
 	}
+	t.GapRepair.setFrom(&f.GapRepair)
 }
 
 func (t *HeadTracker) ValidateConfig() (err error) {
@@ -890,6 +1112,54 @@ func (t *HeadTracker) ValidateConfig() (err error) {
 			Msg: "must be greater than or equal to 1"})
 	}
 
+	if t.GapRepair.Enabled != nil && *t.GapRepair.Enabled && t.PersistenceEnabled != nil && !*t.PersistenceEnabled {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "GapRepair.Enabled", Value: true,
+			Msg: "requires PersistenceEnabled"})
+	}
+
+	return
+}
+
+type HeadTrackerGapRepair struct {
+	Enabled                 *bool
+	BatchSize               *uint32
+	Interval                *commonconfig.Duration
+	TrustedCheckpointNumber *int64
+	TrustedCheckpointHash   *common.Hash
+}
+
+func (r *HeadTrackerGapRepair) setFrom(f *HeadTrackerGapRepair) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.BatchSize; v != nil {
+		r.BatchSize = v
+	}
+	if v := f.Interval; v != nil {
+		r.Interval = v
+	}
+	if v := f.TrustedCheckpointNumber; v != nil {
+		r.TrustedCheckpointNumber = v
+	}
+	if v := f.TrustedCheckpointHash; v != nil {
+		r.TrustedCheckpointHash = v
+	}
+}
+
+func (r *HeadTrackerGapRepair) ValidateConfig() (err error) {
+	if r.BatchSize != nil && *r.BatchSize == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BatchSize", Value: *r.BatchSize, Msg: "must be greater than 0"})
+	}
+	if r.Interval != nil && r.Interval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Interval", Value: r.Interval, Msg: "must be greater than 0"})
+	}
+	if (r.TrustedCheckpointNumber == nil) != (r.TrustedCheckpointHash == nil) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TrustedCheckpointHash", Value: r.TrustedCheckpointHash,
+			Msg: "TrustedCheckpointNumber and TrustedCheckpointHash must be set together"})
+	}
+	if r.TrustedCheckpointNumber != nil && *r.TrustedCheckpointNumber < 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TrustedCheckpointNumber", Value: *r.TrustedCheckpointNumber, Msg: "must not be negative"})
+	}
 	return
 }
 
@@ -966,6 +1236,7 @@ type NodePool struct {
 	SelectionMode              *string
 	SyncThreshold              *uint32
 	LeaseDuration              *commonconfig.Duration
+	SwitchMarginPercent        *uint32
 	NodeIsSyncingEnabled       *bool
 	FinalizedBlockPollInterval *commonconfig.Duration
 	Errors                     ClientErrors `toml:",omitempty"`
@@ -990,6 +1261,9 @@ func (p *NodePool) setFrom(f *NodePool) {
 	if v := f.LeaseDuration; v != nil {
 		p.LeaseDuration = v
 	}
+	if v := f.SwitchMarginPercent; v != nil {
+		p.SwitchMarginPercent = v
+	}
 	if v := f.NodeIsSyncingEnabled; v != nil {
 		p.NodeIsSyncingEnabled = v
 	}
@@ -1013,6 +1287,11 @@ func (p *NodePool) setFrom(f *NodePool) {
 }
 
 func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
+	if p.SelectionMode != nil && *p.SelectionMode == commonclient.NodeSelectionModeLatencyWeighted &&
+		(p.LeaseDuration == nil || p.LeaseDuration.Duration() <= 0) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LeaseDuration", Value: p.LeaseDuration,
+			Msg: "must be greater than 0 when SelectionMode is LatencyWeighted, as the best node is only selected again on lease checks"})
+	}
 	if finalityTagEnabled != nil && *finalityTagEnabled {
 		if p.FinalizedBlockPollInterval == nil {
 			err = multierr.Append(err, commonconfig.ErrMissing{Name: "FinalizedBlockPollInterval", Msg: "required when FinalityTagEnabled is true"})
@@ -1062,6 +1341,7 @@ type Node struct {
 	HTTPURL  *commonconfig.URL
 	SendOnly *bool
 	Order    *int32
+	Weight   *uint32
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -1099,6 +1379,13 @@ func (n *Node) ValidateConfig() (err error) {
 		n.Order = &z
 	}
 
+	if n.Weight != nil && (*n.Weight < 1 || *n.Weight > 100) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Weight", Value: *n.Weight, Msg: "must be between 1 and 100"})
+	} else if n.Weight == nil {
+		w := uint32(1)
+		n.Weight = &w
+	}
+
 	return
 }
 
@@ -1118,6 +1405,9 @@ func (n *Node) SetFrom(f *Node) {
 	if f.Order != nil {
 		n.Order = f.Order
 	}
+	if f.Weight != nil {
+		n.Weight = f.Weight
+	}
 }
 
 func ChainIDInt64(cid string) (int64, error) {
@@ -3,12 +3,15 @@ package toml_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 func TestEVMConfig_ValidateConfig(t *testing.T) {
@@ -29,3 +32,146 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGasEstimator_ValidateConfig_FeeOracle(t *testing.T) {
+	newGasEstimator := func(eip1559 bool, fn func(o *toml.FeeOracleEstimator)) toml.GasEstimator {
+		ge := toml.Defaults(nil).GasEstimator
+		mode := "FeeOracle"
+		ge.Mode = &mode
+		ge.EIP1559DynamicFees = &eip1559
+		fn(&ge.FeeOracle)
+		return ge
+	}
+	ptr := func(s string) *string { return &s }
+
+	t.Run("valid", func(t *testing.T) {
+		ge := newGasEstimator(false, func(o *toml.FeeOracleEstimator) {
+			o.URLs = &[]*config.URL{config.MustParseURL("https://oracle.test"), config.MustParseURL("https://backup.oracle.test")}
+			o.PricePath = ptr("fast")
+		})
+		assert.NoError(t, ge.ValidateConfig())
+
+		ge = newGasEstimator(true, func(o *toml.FeeOracleEstimator) {
+			o.URLs = &[]*config.URL{config.MustParseURL("https://oracle.test")}
+			o.TipCapPath = ptr("tip")
+			o.FeeCapPath = ptr("fee")
+		})
+		assert.NoError(t, ge.ValidateConfig())
+	})
+
+	t.Run("missing URL and path", func(t *testing.T) {
+		ge := newGasEstimator(false, func(*toml.FeeOracleEstimator) {})
+		err := ge.ValidateConfig()
+		assert.ErrorContains(t, err, "FeeOracle.URLs: missing")
+		assert.ErrorContains(t, err, "FeeOracle.PricePath: missing")
+	})
+
+	t.Run("missing dynamic fee paths", func(t *testing.T) {
+		ge := newGasEstimator(true, func(o *toml.FeeOracleEstimator) {
+			o.URLs = &[]*config.URL{config.MustParseURL("https://oracle.test")}
+			o.PricePath = ptr("fast")
+		})
+		err := ge.ValidateConfig()
+		assert.ErrorContains(t, err, "FeeOracle.TipCapPath: missing")
+		assert.ErrorContains(t, err, "FeeOracle.FeeCapPath: missing")
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		ge := newGasEstimator(false, func(o *toml.FeeOracleEstimator) {
+			o.URLs = &[]*config.URL{config.MustParseURL("https://oracle.test"), config.MustParseURL("ftp://oracle.test")}
+			o.PricePath = ptr("fast")
+			o.Unit = ptr("ether")
+			o.FallbackMode = ptr("FeeOracle")
+		})
+		err := ge.ValidateConfig()
+		assert.ErrorContains(t, err, "FeeOracle.URLs[1]: invalid value (ftp)")
+		assert.ErrorContains(t, err, "FeeOracle.Unit: invalid value (ether)")
+		assert.ErrorContains(t, err, "FeeOracle.FallbackMode: invalid value (FeeOracle)")
+	})
+}
+
+func TestHeadTracker_ValidateConfig_GapRepair(t *testing.T) {
+	newHeadTracker := func(fn func(ht *toml.HeadTracker)) toml.HeadTracker {
+		ht := toml.Defaults(nil).HeadTracker
+		fn(&ht)
+		return ht
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		ht := newHeadTracker(func(ht *toml.HeadTracker) {
+			enabled := true
+			number := int64(100)
+			hash := common.HexToHash("0x01")
+			ht.GapRepair.Enabled = &enabled
+			ht.GapRepair.TrustedCheckpointNumber = &number
+			ht.GapRepair.TrustedCheckpointHash = &hash
+		})
+		assert.NoError(t, ht.ValidateConfig())
+		assert.NoError(t, ht.GapRepair.ValidateConfig())
+	})
+
+	t.Run("requires persistence", func(t *testing.T) {
+		ht := newHeadTracker(func(ht *toml.HeadTracker) {
+			enabled, persistence := true, false
+			ht.GapRepair.Enabled = &enabled
+			ht.PersistenceEnabled = &persistence
+		})
+		assert.ErrorContains(t, ht.ValidateConfig(), "GapRepair.Enabled: invalid value (true): requires PersistenceEnabled")
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		ht := newHeadTracker(func(ht *toml.HeadTracker) {
+			batchSize := uint32(0)
+			number := int64(-1)
+			ht.GapRepair.BatchSize = &batchSize
+			ht.GapRepair.Interval = config.MustNewDuration(0)
+			ht.GapRepair.TrustedCheckpointNumber = &number
+		})
+		err := ht.GapRepair.ValidateConfig()
+		assert.ErrorContains(t, err, "BatchSize: invalid value (0): must be greater than 0")
+		assert.ErrorContains(t, err, "Interval: invalid value")
+		assert.ErrorContains(t, err, "TrustedCheckpointHash: invalid value")
+		assert.ErrorContains(t, err, "TrustedCheckpointNumber: invalid value (-1): must not be negative")
+	})
+}
+
+func TestForwardersConfig_ValidateConfig(t *testing.T) {
+	enabled := true
+	t.Run("valid", func(t *testing.T) {
+		c := toml.Defaults(nil).Transactions.Forwarders
+		c.DiscoveryEnabled = &enabled
+		c.DiscoveryAddresses = &[]types.EIP55Address{types.EIP55AddressFromAddress(common.HexToAddress("0x01"))}
+		assert.NoError(t, c.ValidateConfig())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		c := toml.Defaults(nil).Transactions.Forwarders
+		c.DiscoveryEnabled = &enabled
+		startBlock := int64(0)
+		c.DiscoveryStartBlock = &startBlock
+		c.DiscoveryInterval = config.MustNewDuration(0)
+		c.VerificationInterval = config.MustNewDuration(0)
+		err := c.ValidateConfig()
+		assert.ErrorContains(t, err, "DiscoveryAddresses: missing: must be set if discovery is enabled")
+		assert.ErrorContains(t, err, "DiscoveryStartBlock: invalid value (0): must be greater than 0")
+		assert.ErrorContains(t, err, "DiscoveryInterval: invalid value (0s): must be greater than 0")
+		assert.ErrorContains(t, err, "VerificationInterval: invalid value (0s): must be greater than 0")
+	})
+}
+
+func TestNodePool_ValidateConfig_LatencyWeighted(t *testing.T) {
+	mode := "LatencyWeighted"
+	t.Run("valid", func(t *testing.T) {
+		p := toml.Defaults(nil).NodePool
+		p.SelectionMode = &mode
+		p.LeaseDuration = config.MustNewDuration(time.Minute)
+		assert.NoError(t, p.ValidateConfig(nil))
+	})
+
+	t.Run("lease disabled", func(t *testing.T) {
+		p := toml.Defaults(nil).NodePool
+		p.SelectionMode = &mode
+		p.LeaseDuration = config.MustNewDuration(0)
+		assert.ErrorContains(t, p.ValidateConfig(nil), "LeaseDuration: invalid value (0s): must be greater than 0 when SelectionMode is LatencyWeighted")
+	})
+}
@@ -2,7 +2,10 @@ package forwarders
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	stdbig "math/big"
 	"slices"
 	"sync"
 	"time"
@@ -23,15 +26,30 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_receiver"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/offchain_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/operator_factory"
 )
 
 var forwardABI = evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["forward"]
 var authChangedTopic = authorized_receiver.AuthorizedReceiverAuthorizedSendersChanged{}.Topic()
+var forwarderCreatedTopic = operator_factory.OperatorFactoryAuthorizedForwarderCreated{}.Topic()
 
 type Config interface {
 	FinalityDepth() uint32
 }
 
+type ForwardersConfig interface {
+	DiscoveryEnabled() bool
+	DiscoveryAddresses() []common.Address
+	DiscoveryStartBlock() int64
+	DiscoveryInterval() time.Duration
+	VerificationInterval() time.Duration
+}
+
+// KeyStore is the subset of the eth key store used to discover and verify forwarders for the node's keys.
+type KeyStore interface {
+	EnabledAddressesForChain(ctx context.Context, chainID *stdbig.Int) ([]common.Address, error)
+}
+
 type FwdMgr struct {
 	services.Service
 	eng *services.Engine
@@ -39,6 +57,8 @@ type FwdMgr struct {
 	ORM       ORM
 	evmClient evmclient.Client
 	cfg       Config
+	fwdCfg    ForwardersConfig
+	ks        KeyStore
 	logger    logger.SugaredLogger
 	logpoller evmlogpoller.LogPoller
 
@@ -49,17 +69,32 @@ type FwdMgr struct {
 
 	authRcvr    authorized_receiver.AuthorizedReceiverInterface
 	offchainAgg offchain_aggregator_wrapper.OffchainAggregatorInterface
+	factory     operator_factory.OperatorFactoryInterface
+
+	// discoveryMu serializes discovery runs with replays, which move discoveryBlock back. It is not held while the
+	// log poller replays.
+	discoveryMu sync.Mutex
+	// discoveryBlock is the last block scanned for forwarders, persisted as the discovery cursor, -1 until
+	// discovery is initialized
+	discoveryBlock int64
+	// unauthorized are the forwarders which authorized none of the node's keys on the last verification,
+	// only accessed by the verification loop
+	unauthorized map[common.Address]struct{}
 
 	cacheMu sync.RWMutex
 }
 
-func NewFwdMgr(ds sqlutil.DataSource, client evmclient.Client, logpoller evmlogpoller.LogPoller, lggr logger.Logger, cfg Config) *FwdMgr {
+func NewFwdMgr(ds sqlutil.DataSource, client evmclient.Client, logpoller evmlogpoller.LogPoller, ks KeyStore, lggr logger.Logger, cfg Config, fwdCfg ForwardersConfig) *FwdMgr {
 	fm := FwdMgr{
-		cfg:          cfg,
-		evmClient:    client,
-		ORM:          NewORM(ds),
-		logpoller:    logpoller,
-		sendersCache: make(map[common.Address][]common.Address),
+		cfg:            cfg,
+		fwdCfg:         fwdCfg,
+		ks:             ks,
+		evmClient:      client,
+		ORM:            NewORM(ds),
+		logpoller:      logpoller,
+		sendersCache:   make(map[common.Address][]common.Address),
+		discoveryBlock: -1,
+		unauthorized:   make(map[common.Address]struct{}),
 	}
 	fm.Service, fm.eng = services.Config{
 		Name:  "ForwarderManager",
@@ -93,7 +128,24 @@ func (f *FwdMgr) start(ctx context.Context) error {
 		return pkgerrors.Wrap(err, "Failed to init OffchainAggregator")
 	}
 
+	f.factory, err = operator_factory.NewOperatorFactory(common.Address{}, f.evmClient)
+	if err != nil {
+		return pkgerrors.Wrap(err, "Failed to init OperatorFactory")
+	}
+
 	f.eng.Go(f.runLoop)
+	if f.fwdCfg.DiscoveryEnabled() {
+		f.eng.GoTick(services.NewTicker(f.fwdCfg.DiscoveryInterval()), func(ctx context.Context) {
+			if err := f.DiscoverForwarders(ctx); err != nil && ctx.Err() == nil {
+				f.logger.Warnw("Failed to discover forwarders", "err", err)
+			}
+		})
+	}
+	f.eng.GoTick(services.NewTicker(f.fwdCfg.VerificationInterval()), func(ctx context.Context) {
+		if err := f.VerifyForwarders(ctx); err != nil && ctx.Err() == nil {
+			f.logger.Warnw("Failed to verify forwarders", "err", err)
+		}
+	})
 	return nil
 }
 
@@ -101,6 +153,10 @@ func FilterName(addr common.Address) string {
 	return evmlogpoller.FilterName("ForwarderManager AuthorizedSendersChanged", addr.String())
 }
 
+func DiscoveryFilterName(addrs []common.Address) string {
+	return evmlogpoller.FilterName("ForwarderManager Discovery", addrs)
+}
+
 func (f *FwdMgr) ForwarderFor(ctx context.Context, addr common.Address) (forwarder common.Address, err error) {
 	// Gets forwarders for current chain.
 	fwdrs, err := f.ORM.FindForwardersByChain(ctx, big.Big(*f.evmClient.ConfiguredChainID()))
@@ -174,6 +230,13 @@ func (f *FwdMgr) ConvertPayload(dest common.Address, origPayload []byte) ([]byte
 	return databytes, nil
 }
 
+// Revoked returns true if the latest known authorized senders of forwarder do not include eoa.
+// Forwarders whose senders have not been fetched yet are assumed to still authorize eoa.
+func (f *FwdMgr) Revoked(forwarder, eoa common.Address) bool {
+	senders, ok := f.getCachedSenders(forwarder)
+	return ok && !slices.Contains(senders, eoa)
+}
+
 func (f *FwdMgr) getForwardedPayload(dest common.Address, origPayload []byte) ([]byte, error) {
 	callArgs, err := forwardABI.Inputs.Pack(dest, origPayload)
 	if err != nil {
@@ -341,3 +404,208 @@ func (f *FwdMgr) collectAddresses() (addrs []common.Address) {
 	}
 	return
 }
+
+// DiscoverForwarders scans the finalized AuthorizedForwarderCreated logs of the configured discovery addresses since
+// the last scan, and tracks the forwarders owned or created by one of the node's enabled keys. Forwarders which merely
+// authorize one of the node's keys are not tracked, since anyone can authorize any sender; they still need to be added
+// with `chainlink forwarders track`.
+// Discovery resumes from its persisted cursor: only the first run on the chain replays the log poller from
+// DiscoveryStartBlock, if set.
+func (f *FwdMgr) DiscoverForwarders(ctx context.Context) error {
+	if err := f.logpoller.Ready(); err != nil {
+		return pkgerrors.Wrap(err, "log poller not ready")
+	}
+
+	addrs := f.fwdCfg.DiscoveryAddresses()
+	if err := f.registerDiscoveryFilter(ctx, addrs); err != nil {
+		return err
+	}
+
+	latest, err := f.logpoller.LatestBlock(ctx)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to get latest log poller block")
+	}
+	if err = f.initDiscovery(ctx, addrs, latest.BlockNumber); err != nil {
+		return err
+	}
+
+	f.discoveryMu.Lock()
+	defer f.discoveryMu.Unlock()
+	if latest.FinalizedBlockNumber <= f.discoveryBlock {
+		return nil
+	}
+
+	chainID := f.evmClient.ConfiguredChainID()
+	keys, err := f.ks.EnabledAddressesForChain(ctx, chainID)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to get enabled keys")
+	}
+
+	var candidates []common.Address
+	for _, addr := range addrs {
+		logs, err := f.logpoller.Logs(ctx, f.discoveryBlock+1, latest.FinalizedBlockNumber, forwarderCreatedTopic, addr)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to get forwarder created logs of %s", addr)
+		}
+		for _, log := range logs {
+			event, err := f.factory.ParseAuthorizedForwarderCreated(types.Log{Address: log.Address, Data: log.Data, Topics: log.GetTopics(), TxHash: log.TxHash, BlockHash: log.BlockHash})
+			if err != nil {
+				f.logger.Warnw("Failed to parse forwarder created log", "TxHash", log.TxHash, "err", err)
+				continue
+			}
+			if slices.Contains(keys, event.Owner) || slices.Contains(keys, event.Sender) {
+				candidates = append(candidates, event.Forwarder)
+			}
+		}
+	}
+
+	if len(candidates) > 0 {
+		if err = f.trackForwarders(ctx, candidates); err != nil {
+			return err
+		}
+	}
+	return f.setDiscoveryBlock(ctx, addrs, latest.FinalizedBlockNumber)
+}
+
+// initDiscovery loads the discovery cursor on the first run. Without a cursor, the log poller is replayed from
+// DiscoveryStartBlock instead. The replay blocks until it is done, so discoveryMu is not held meanwhile.
+func (f *FwdMgr) initDiscovery(ctx context.Context, addrs []common.Address, latestBlock int64) error {
+	f.discoveryMu.Lock()
+	initialized := f.discoveryBlock >= 0
+	f.discoveryMu.Unlock()
+	if initialized {
+		return nil
+	}
+
+	chainID := big.Big(*f.evmClient.ConfiguredChainID())
+	cursor, err := f.ORM.FindDiscoveryCursor(ctx, chainID, DiscoveryFilterName(addrs))
+	if errors.Is(err, sql.ErrNoRows) {
+		startBlock := f.fwdCfg.DiscoveryStartBlock()
+		if startBlock > 0 && startBlock <= latestBlock {
+			if err = f.logpoller.Replay(ctx, startBlock); err != nil {
+				return pkgerrors.Wrapf(err, "failed to replay discovery logs from block %d", startBlock)
+			}
+		}
+		cursor = max(startBlock-1, 0)
+		if err = f.ORM.UpsertDiscoveryCursor(ctx, chainID, DiscoveryFilterName(addrs), cursor); err != nil {
+			return pkgerrors.Wrap(err, "failed to save discovery cursor")
+		}
+	} else if err != nil {
+		return pkgerrors.Wrap(err, "failed to load discovery cursor")
+	}
+
+	f.discoveryMu.Lock()
+	defer f.discoveryMu.Unlock()
+	// a replay may have moved discovery back in the meantime
+	if f.discoveryBlock < 0 {
+		f.discoveryBlock = cursor
+	}
+	return nil
+}
+
+// setDiscoveryBlock persists, and moves discovery to, the last block scanned for forwarders.
+func (f *FwdMgr) setDiscoveryBlock(ctx context.Context, addrs []common.Address, block int64) error {
+	chainID := big.Big(*f.evmClient.ConfiguredChainID())
+	if err := f.ORM.UpsertDiscoveryCursor(ctx, chainID, DiscoveryFilterName(addrs), block); err != nil {
+		return pkgerrors.Wrap(err, "failed to save discovery cursor")
+	}
+	f.discoveryBlock = block
+	return nil
+}
+
+// registerDiscoveryFilter registers the filter of the discovery addresses.
+func (f *FwdMgr) registerDiscoveryFilter(ctx context.Context, addrs []common.Address) error {
+	err := f.logpoller.RegisterFilter(ctx, evmlogpoller.Filter{
+		Name:      DiscoveryFilterName(addrs),
+		EventSigs: []common.Hash{forwarderCreatedTopic},
+		Addresses: addrs,
+	})
+	return pkgerrors.Wrap(err, "failed to register discovery filter")
+}
+
+// ReplayDiscovery replays the log poller from fromBlock, and then moves discovery back to rescan the replayed blocks
+// on its next run. Without discovery, only the log poller is replayed.
+func (f *FwdMgr) ReplayDiscovery(fromBlock int64) {
+	f.eng.Go(func(ctx context.Context) {
+		if err := f.logpoller.Replay(ctx, fromBlock); err != nil {
+			f.logger.Errorw("Failed to replay discovery logs", "fromBlock", fromBlock, "err", err)
+			return
+		}
+		f.discoveryMu.Lock()
+		defer f.discoveryMu.Unlock()
+		if f.discoveryBlock >= fromBlock {
+			if err := f.setDiscoveryBlock(ctx, f.fwdCfg.DiscoveryAddresses(), fromBlock-1); err != nil {
+				f.logger.Errorw("Failed to move discovery back to the replayed blocks", "fromBlock", fromBlock, "err", err)
+			}
+		}
+	})
+}
+
+func (f *FwdMgr) trackForwarders(ctx context.Context, addrs []common.Address) error {
+	chainID := big.Big(*f.evmClient.ConfiguredChainID())
+	tracked, err := f.ORM.FindForwardersInListByChain(ctx, chainID, addrs)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to find tracked forwarders")
+	}
+	for _, addr := range addrs {
+		if slices.ContainsFunc(tracked, func(fwdr Forwarder) bool { return fwdr.Address == addr }) {
+			continue
+		}
+		fwdr, err := f.ORM.CreateForwarder(ctx, addr, chainID)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to track forwarder %s", addr)
+		}
+		tracked = append(tracked, fwdr)
+		f.logger.Infow("Discovered forwarder", "forwarder", addr)
+		if _, err = f.getContractSenders(ctx, addr); err != nil {
+			f.logger.Warnw("Failed to get discovered forwarder senders", "forwarder", addr, "err", err)
+		}
+	}
+	return nil
+}
+
+// VerifyForwarders refreshes the authorized senders of every tracked forwarder, and reports unhealthy for each
+// forwarder which authorizes none of the node's enabled keys, until it does again.
+func (f *FwdMgr) VerifyForwarders(ctx context.Context) error {
+	chainID := f.evmClient.ConfiguredChainID()
+	fwdrs, err := f.ORM.FindForwardersByChain(ctx, big.Big(*chainID))
+	if err != nil {
+		return pkgerrors.Wrapf(err, "failed to retrieve forwarders for chain %d", chainID)
+	}
+	keys, err := f.ks.EnabledAddressesForChain(ctx, chainID)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to get enabled keys")
+	}
+
+	unauthorized := make(map[common.Address]struct{})
+	for _, fwdr := range fwdrs {
+		senders, err := f.getAuthorizedSenders(ctx, fwdr.Address)
+		if err != nil {
+			f.logger.Warnw("Failed to call getAuthorizedSenders on forwarder", "forwarder", fwdr.Address, "err", err)
+			if _, ok := f.unauthorized[fwdr.Address]; ok {
+				unauthorized[fwdr.Address] = struct{}{}
+			}
+			continue
+		}
+		f.setCachedSenders(fwdr.Address, senders)
+		if len(keys) > 0 && !slices.ContainsFunc(senders, func(sender common.Address) bool { return slices.Contains(keys, sender) }) {
+			unauthorized[fwdr.Address] = struct{}{}
+			if _, ok := f.unauthorized[fwdr.Address]; !ok {
+				err = fmt.Errorf("forwarder %s does not authorize any of the node's enabled keys, transactions through it are refused", fwdr.Address)
+				f.logger.Errorw("Forwarder authorization revoked", "forwarder", fwdr.Address, "err", err)
+				f.eng.SetHealthCond(unauthorizedCond(fwdr.Address), err)
+			}
+		}
+	}
+	for addr := range f.unauthorized {
+		if _, ok := unauthorized[addr]; !ok {
+			f.eng.ClearHealthCond(unauthorizedCond(addr))
+		}
+	}
+	f.unauthorized = unauthorized
+	return nil
+}
+
+func unauthorizedCond(addr common.Address) string {
+	return "Forwarder " + addr.String()
+}
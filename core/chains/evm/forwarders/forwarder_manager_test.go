@@ -1,6 +1,7 @@
 package forwarders_test
 
 import (
+	"context"
 	"math/big"
 	"slices"
 	"testing"
@@ -16,7 +17,9 @@ import (
 
 	"github.com/smartcontractkit/libocr/gethwrappers2/testocr2aggregator"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
@@ -28,11 +31,13 @@ import (
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_receiver"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/operator_factory"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/operator_wrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/testhelpers"
 )
 
@@ -46,6 +51,7 @@ func TestFwdMgr_MaybeForwardTransaction(t *testing.T) {
 	cfg := configtest.NewTestGeneralConfig(t)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 	owner := testutils.MustNewSimTransactor(t)
+	ks := keyStore{owner.From}
 	ctx := testutils.Context(t)
 
 	b := simulated.NewBackend(types.GenesisAlloc{
@@ -78,7 +84,7 @@ func TestFwdMgr_MaybeForwardTransaction(t *testing.T) {
 	}
 	ht := headtracker.NewSimulatedHeadTracker(evmClient, lpOpts.UseFinalityTag, lpOpts.FinalityDepth)
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr), evmClient, lggr, ht, lpOpts)
-	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, lggr, evmcfg.EVM())
+	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, ks, lggr, evmcfg.EVM(), evmcfg.EVM().Transactions().Forwarders())
 	fwdMgr.ORM = forwarders.NewORM(db)
 
 	fwd, err := fwdMgr.ORM.CreateForwarder(ctx, forwarderAddr, ubig.Big(*testutils.FixtureChainID))
@@ -116,6 +122,7 @@ func TestFwdMgr_AccountUnauthorizedToForward_SkipsForwarding(t *testing.T) {
 	cfg := configtest.NewTestGeneralConfig(t)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 	owner := testutils.MustNewSimTransactor(t)
+	ks := keyStore{owner.From}
 	b := simulated.NewBackend(types.GenesisAlloc{
 		owner.From: {
 			Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)),
@@ -140,7 +147,7 @@ func TestFwdMgr_AccountUnauthorizedToForward_SkipsForwarding(t *testing.T) {
 	}
 	ht := headtracker.NewSimulatedHeadTracker(evmClient, lpOpts.UseFinalityTag, lpOpts.FinalityDepth)
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr), evmClient, lggr, ht, lpOpts)
-	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, lggr, evmcfg.EVM())
+	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, ks, lggr, evmcfg.EVM(), evmcfg.EVM().Transactions().Forwarders())
 	fwdMgr.ORM = forwarders.NewORM(db)
 
 	_, err = fwdMgr.ORM.CreateForwarder(ctx, forwarderAddr, ubig.Big(*testutils.FixtureChainID))
@@ -166,6 +173,7 @@ func TestFwdMgr_InvalidForwarderForOCR2FeedsStates(t *testing.T) {
 	cfg := configtest.NewTestGeneralConfig(t)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
 	owner := testutils.MustNewSimTransactor(t)
+	ks := keyStore{owner.From}
 	ec := simulated.NewBackend(types.GenesisAlloc{
 		owner.From: {
 			Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)),
@@ -206,7 +214,7 @@ func TestFwdMgr_InvalidForwarderForOCR2FeedsStates(t *testing.T) {
 	}
 	ht := headtracker.NewSimulatedHeadTracker(evmClient, lpOpts.UseFinalityTag, lpOpts.FinalityDepth)
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr), evmClient, lggr, ht, lpOpts)
-	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, lggr, evmcfg.EVM())
+	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, ks, lggr, evmcfg.EVM(), evmcfg.EVM().Transactions().Forwarders())
 	fwdMgr.ORM = forwarders.NewORM(db)
 
 	_, err = fwdMgr.ORM.CreateForwarder(ctx, forwarderAddr, ubig.Big(*testutils.FixtureChainID))
@@ -216,7 +224,7 @@ func TestFwdMgr_InvalidForwarderForOCR2FeedsStates(t *testing.T) {
 	require.Equal(t, len(lst), 1)
 	require.Equal(t, lst[0].Address, forwarderAddr)
 
-	fwdMgr = forwarders.NewFwdMgr(db, evmClient, lp, lggr, evmcfg.EVM())
+	fwdMgr = forwarders.NewFwdMgr(db, evmClient, lp, ks, lggr, evmcfg.EVM(), evmcfg.EVM().Transactions().Forwarders())
 	require.NoError(t, fwdMgr.Start(testutils.Context(t)))
 	// cannot find forwarder because it isn't authorized nor added as a transmitter
 	addr, err := fwdMgr.ForwarderForOCR2Feeds(ctx, owner.From, ocr2Address)
@@ -254,10 +262,204 @@ func TestFwdMgr_InvalidForwarderForOCR2FeedsStates(t *testing.T) {
 	require.True(t, slices.Contains(transmitters, forwarderAddr))
 
 	// create new fwd to have an empty cache that has to fetch authorized forwarders from log poller
-	fwdMgr = forwarders.NewFwdMgr(db, evmClient, lp, lggr, evmcfg.EVM())
+	fwdMgr = forwarders.NewFwdMgr(db, evmClient, lp, ks, lggr, evmcfg.EVM(), evmcfg.EVM().Transactions().Forwarders())
 	require.NoError(t, fwdMgr.Start(testutils.Context(t)))
 	addr, err = fwdMgr.ForwarderForOCR2Feeds(ctx, owner.From, ocr2Address)
 	require.NoError(t, err, "forwarder should be valid and found because it is both authorized and set as a transmitter")
 	require.Equal(t, forwarderAddr, addr)
 	require.NoError(t, fwdMgr.Close())
 }
+
+func TestFwdMgr_DiscoverAndVerifyForwarders(t *testing.T) {
+	lggr := logger.Test(t)
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	owner := testutils.MustNewSimTransactor(t)
+	ks := keyStore{owner.From}
+	b := simulated.NewBackend(types.GenesisAlloc{
+		owner.From: {
+			Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)),
+		},
+	}, simulated.WithBlockGasLimit(10e6))
+	t.Cleanup(func() { b.Close() })
+	linkAddr := common.HexToAddress("0x01BE23585060835E02B77ef475b0Cc51aA1e0709")
+	factoryAddr, _, factory, err := operator_factory.DeployOperatorFactory(owner, b.Client(), linkAddr)
+	require.NoError(t, err)
+	b.Commit()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.Forwarders.DiscoveryEnabled = testutils.Ptr(true)
+		c.EVM[0].Transactions.Forwarders.DiscoveryAddresses = &[]evmtypes.EIP55Address{evmtypes.EIP55AddressFromAddress(factoryAddr)}
+		c.EVM[0].Transactions.Forwarders.VerificationInterval = commonconfig.MustNewDuration(time.Hour)
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	evmClient := client.NewSimulatedBackendClient(t, b, testutils.FixtureChainID)
+	lpOpts := logpoller.Opts{
+		PollPeriod:               100 * time.Millisecond,
+		FinalityDepth:            2,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	}
+	ht := headtracker.NewSimulatedHeadTracker(evmClient, lpOpts.UseFinalityTag, lpOpts.FinalityDepth)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr), evmClient, lggr, ht, lpOpts)
+	servicetest.Run(t, lp)
+	fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, ks, lggr, evmcfg.EVM(), evmcfg.EVM().Transactions().Forwarders())
+	servicetest.Run(t, fwdMgr)
+
+	// registers the discovery filter before the forwarder is created
+	require.NoError(t, fwdMgr.DiscoverForwarders(ctx))
+	_, err = factory.DeployNewForwarder(owner)
+	require.NoError(t, err)
+	b.Commit()
+
+	var fwdrs []forwarders.Forwarder
+	require.Eventually(t, func() bool {
+		b.Commit()
+		require.NoError(t, fwdMgr.DiscoverForwarders(ctx))
+		fwdrs, err = fwdMgr.ORM.FindForwardersByChain(ctx, ubig.Big(*testutils.FixtureChainID))
+		require.NoError(t, err)
+		return len(fwdrs) == 1
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	forwarderAddr := fwdrs[0].Address
+
+	// the discovered forwarder is owned by the node's key, but does not authorize it yet
+	require.NoError(t, fwdMgr.VerifyForwarders(ctx))
+	assert.ErrorContains(t, fwdMgr.HealthReport()[fwdMgr.Name()], "does not authorize any of the node's enabled keys")
+	assert.True(t, fwdMgr.Revoked(forwarderAddr, owner.From))
+
+	forwarder, err := authorized_forwarder.NewAuthorizedForwarder(forwarderAddr, b.Client())
+	require.NoError(t, err)
+	_, err = forwarder.SetAuthorizedSenders(owner, []common.Address{owner.From})
+	require.NoError(t, err)
+	b.Commit()
+
+	require.NoError(t, fwdMgr.VerifyForwarders(ctx))
+	assert.NoError(t, fwdMgr.HealthReport()[fwdMgr.Name()])
+	assert.False(t, fwdMgr.Revoked(forwarderAddr, owner.From))
+	addr, err := fwdMgr.ForwarderFor(ctx, owner.From)
+	require.NoError(t, err)
+	assert.Equal(t, forwarderAddr, addr)
+}
+
+func TestFwdMgr_DiscoverForwarders_Replay(t *testing.T) {
+	// setup creates a forwarder which is owned by the node's key, and one which is owned by another key and only
+	// authorizes the node's key, before the log poller starts. It returns the forwarder manager, the forwarder owned by
+	// the node's key and the operator factory which created them.
+	setup := func(t *testing.T, startBlock *int64) (*forwarders.FwdMgr, common.Address, common.Address) {
+		lggr := logger.Test(t)
+		db := pgtest.NewSqlxDB(t)
+		node := testutils.MustNewSimTransactor(t)
+		other := testutils.MustNewSimTransactor(t)
+		b := simulated.NewBackend(types.GenesisAlloc{
+			node.From: {
+				Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)),
+			},
+			other.From: {
+				Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)),
+			},
+		}, simulated.WithBlockGasLimit(10e6))
+		t.Cleanup(func() { b.Close() })
+		linkAddr := common.HexToAddress("0x01BE23585060835E02B77ef475b0Cc51aA1e0709")
+		factoryAddr, _, factory, err := operator_factory.DeployOperatorFactory(other, b.Client(), linkAddr)
+		require.NoError(t, err)
+		b.Commit()
+		deployForwarder := func(owner *bind.TransactOpts) common.Address {
+			tx, err := factory.DeployNewForwarder(owner)
+			require.NoError(t, err)
+			b.Commit()
+			receipt, err := b.Client().TransactionReceipt(testutils.Context(t), tx.Hash())
+			require.NoError(t, err)
+			for _, log := range receipt.Logs {
+				if event, err := factory.ParseAuthorizedForwarderCreated(*log); err == nil {
+					return event.Forwarder
+				}
+			}
+			t.Fatal("no forwarder created")
+			return common.Address{}
+		}
+		forwarderAddr := deployForwarder(node)
+		authorizingAddr := deployForwarder(other)
+		forwarder, err := authorized_forwarder.NewAuthorizedForwarder(authorizingAddr, b.Client())
+		require.NoError(t, err)
+		_, err = forwarder.SetAuthorizedSenders(other, []common.Address{node.From})
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			b.Commit()
+		}
+
+		cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].Transactions.Forwarders.DiscoveryEnabled = testutils.Ptr(true)
+			c.EVM[0].Transactions.Forwarders.DiscoveryAddresses = &[]evmtypes.EIP55Address{evmtypes.EIP55AddressFromAddress(factoryAddr)}
+			c.EVM[0].Transactions.Forwarders.DiscoveryStartBlock = startBlock
+			c.EVM[0].Transactions.Forwarders.VerificationInterval = commonconfig.MustNewDuration(time.Hour)
+		})
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+		evmClient := client.NewSimulatedBackendClient(t, b, testutils.FixtureChainID)
+		lpOpts := logpoller.Opts{
+			PollPeriod:               100 * time.Millisecond,
+			FinalityDepth:            2,
+			BackfillBatchSize:        3,
+			RpcBatchSize:             2,
+			KeepFinalizedBlocksDepth: 1000,
+		}
+		ht := headtracker.NewSimulatedHeadTracker(evmClient, lpOpts.UseFinalityTag, lpOpts.FinalityDepth)
+		lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr), evmClient, lggr, ht, lpOpts)
+		servicetest.Run(t, lp)
+		require.Eventually(t, func() bool {
+			b.Commit()
+			latest, err := lp.LatestBlock(testutils.Context(t))
+			return err == nil && latest.FinalizedBlockNumber > 0
+		}, testutils.WaitTimeout(t), 100*time.Millisecond)
+
+		fwdMgr := forwarders.NewFwdMgr(db, evmClient, lp, keyStore{node.From}, lggr, evmcfg.EVM(), evmcfg.EVM().Transactions().Forwarders())
+		servicetest.Run(t, fwdMgr)
+		return fwdMgr, forwarderAddr, factoryAddr
+	}
+
+	trackedForwarders := func(t *testing.T, fwdMgr *forwarders.FwdMgr) (addrs []common.Address) {
+		fwdrs, err := fwdMgr.ORM.FindForwardersByChain(testutils.Context(t), ubig.Big(*testutils.FixtureChainID))
+		require.NoError(t, err)
+		for _, fwdr := range fwdrs {
+			addrs = append(addrs, fwdr.Address)
+		}
+		return
+	}
+
+	t.Run("replays from the start block", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		fwdMgr, forwarderAddr, factoryAddr := setup(t, testutils.Ptr[int64](1))
+
+		require.NoError(t, fwdMgr.DiscoverForwarders(ctx))
+		assert.Equal(t, []common.Address{forwarderAddr}, trackedForwarders(t, fwdMgr))
+
+		// later runs, including after a restart, resume from the persisted cursor
+		cursor, err := fwdMgr.ORM.FindDiscoveryCursor(ctx, ubig.Big(*testutils.FixtureChainID), forwarders.DiscoveryFilterName([]common.Address{factoryAddr}))
+		require.NoError(t, err)
+		assert.Positive(t, cursor)
+	})
+
+	t.Run("rescans replayed blocks", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		fwdMgr, forwarderAddr, _ := setup(t, nil)
+
+		// the logs were emitted before the discovery filter was registered
+		require.NoError(t, fwdMgr.DiscoverForwarders(ctx))
+		assert.Empty(t, trackedForwarders(t, fwdMgr))
+
+		fwdMgr.ReplayDiscovery(1)
+		require.Eventually(t, func() bool {
+			require.NoError(t, fwdMgr.DiscoverForwarders(ctx))
+			return slices.Equal([]common.Address{forwarderAddr}, trackedForwarders(t, fwdMgr))
+		}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	})
+}
+
+// keyStore is a forwarders.KeyStore with a fixed set of enabled keys
+type keyStore []common.Address
+
+func (ks keyStore) EnabledAddressesForChain(context.Context, *big.Int) ([]common.Address, error) {
+	return ks, nil
+}
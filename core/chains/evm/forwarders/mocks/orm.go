@@ -134,6 +134,64 @@ func (_c *ORM_DeleteForwarder_Call) RunAndReturn(run func(context.Context, int64
 	return _c
 }
 
+// FindDiscoveryCursor provides a mock function with given fields: ctx, evmChainId, name
+func (_m *ORM) FindDiscoveryCursor(ctx context.Context, evmChainId big.Big, name string) (int64, error) {
+	ret := _m.Called(ctx, evmChainId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDiscoveryCursor")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, big.Big, string) (int64, error)); ok {
+		return rf(ctx, evmChainId, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, big.Big, string) int64); ok {
+		r0 = rf(ctx, evmChainId, name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, big.Big, string) error); ok {
+		r1 = rf(ctx, evmChainId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindDiscoveryCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDiscoveryCursor'
+type ORM_FindDiscoveryCursor_Call struct {
+	*mock.Call
+}
+
+// FindDiscoveryCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - evmChainId big.Big
+//   - name string
+func (_e *ORM_Expecter) FindDiscoveryCursor(ctx interface{}, evmChainId interface{}, name interface{}) *ORM_FindDiscoveryCursor_Call {
+	return &ORM_FindDiscoveryCursor_Call{Call: _e.mock.On("FindDiscoveryCursor", ctx, evmChainId, name)}
+}
+
+func (_c *ORM_FindDiscoveryCursor_Call) Run(run func(ctx context.Context, evmChainId big.Big, name string)) *ORM_FindDiscoveryCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(big.Big), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_FindDiscoveryCursor_Call) Return(_a0 int64, _a1 error) *ORM_FindDiscoveryCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindDiscoveryCursor_Call) RunAndReturn(run func(context.Context, big.Big, string) (int64, error)) *ORM_FindDiscoveryCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindForwarders provides a mock function with given fields: ctx, offset, limit
 func (_m *ORM) FindForwarders(ctx context.Context, offset int, limit int) ([]forwarders.Forwarder, int, error) {
 	ret := _m.Called(ctx, offset, limit)
@@ -320,6 +378,55 @@ func (_c *ORM_FindForwardersInListByChain_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpsertDiscoveryCursor provides a mock function with given fields: ctx, evmChainId, name, blockNumber
+func (_m *ORM) UpsertDiscoveryCursor(ctx context.Context, evmChainId big.Big, name string, blockNumber int64) error {
+	ret := _m.Called(ctx, evmChainId, name, blockNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertDiscoveryCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, big.Big, string, int64) error); ok {
+		r0 = rf(ctx, evmChainId, name, blockNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpsertDiscoveryCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertDiscoveryCursor'
+type ORM_UpsertDiscoveryCursor_Call struct {
+	*mock.Call
+}
+
+// UpsertDiscoveryCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - evmChainId big.Big
+//   - name string
+//   - blockNumber int64
+func (_e *ORM_Expecter) UpsertDiscoveryCursor(ctx interface{}, evmChainId interface{}, name interface{}, blockNumber interface{}) *ORM_UpsertDiscoveryCursor_Call {
+	return &ORM_UpsertDiscoveryCursor_Call{Call: _e.mock.On("UpsertDiscoveryCursor", ctx, evmChainId, name, blockNumber)}
+}
+
+func (_c *ORM_UpsertDiscoveryCursor_Call) Run(run func(ctx context.Context, evmChainId big.Big, name string, blockNumber int64)) *ORM_UpsertDiscoveryCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(big.Big), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertDiscoveryCursor_Call) Return(_a0 error) *ORM_UpsertDiscoveryCursor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpsertDiscoveryCursor_Call) RunAndReturn(run func(context.Context, big.Big, string, int64) error) *ORM_UpsertDiscoveryCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewORM(t interface {
@@ -19,6 +19,8 @@ type ORM interface {
 	FindForwardersByChain(ctx context.Context, evmChainId big.Big) ([]Forwarder, error)
 	DeleteForwarder(ctx context.Context, id int64, cleanup func(tx sqlutil.DataSource, evmChainId int64, addr common.Address) error) error
 	FindForwardersInListByChain(ctx context.Context, evmChainId big.Big, addrs []common.Address) ([]Forwarder, error)
+	FindDiscoveryCursor(ctx context.Context, evmChainId big.Big, name string) (int64, error)
+	UpsertDiscoveryCursor(ctx context.Context, evmChainId big.Big, name string, blockNumber int64) error
 }
 
 type DSORM struct {
@@ -134,3 +136,19 @@ func (o *DSORM) FindForwardersInListByChain(ctx context.Context, evmChainId big.
 
 	return fwdrs, nil
 }
+
+// FindDiscoveryCursor returns the last block scanned by the forwarder discovery with the given name, or sql.ErrNoRows
+// if it never ran on the chain.
+func (o *DSORM) FindDiscoveryCursor(ctx context.Context, evmChainId big.Big, name string) (blockNumber int64, err error) {
+	sql := `SELECT block_number FROM evm.forwarder_discovery_cursors WHERE evm_chain_id = $1 AND name = $2`
+	err = o.ds.GetContext(ctx, &blockNumber, sql, evmChainId, name)
+	return
+}
+
+// UpsertDiscoveryCursor saves the last block scanned by the forwarder discovery with the given name.
+func (o *DSORM) UpsertDiscoveryCursor(ctx context.Context, evmChainId big.Big, name string, blockNumber int64) error {
+	sql := `INSERT INTO evm.forwarder_discovery_cursors (evm_chain_id, name, block_number, updated_at) VALUES ($1, $2, $3, now())
+		ON CONFLICT (evm_chain_id, name) DO UPDATE SET block_number = EXCLUDED.block_number, updated_at = EXCLUDED.updated_at`
+	_, err := o.ds.ExecContext(ctx, sql, evmChainId, name, blockNumber)
+	return err
+}
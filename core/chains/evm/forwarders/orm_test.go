@@ -50,3 +50,21 @@ func Test_DeleteForwarder(t *testing.T) {
 	}
 	assert.Equal(t, 2, cleanupCalled)
 }
+
+func Test_DiscoveryCursor(t *testing.T) {
+	t.Parallel()
+	orm := NewORM(pgtest.NewSqlxDB(t))
+	chainID := *big.New(testutils.FixtureChainID)
+	ctx := testutils.Context(t)
+
+	_, err := orm.FindDiscoveryCursor(ctx, chainID, "discovery")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, orm.UpsertDiscoveryCursor(ctx, chainID, "discovery", 10))
+	require.NoError(t, orm.UpsertDiscoveryCursor(ctx, chainID, "discovery", 20))
+	require.NoError(t, orm.UpsertDiscoveryCursor(ctx, chainID, "other", 5))
+
+	cursor, err := orm.FindDiscoveryCursor(ctx, chainID, "discovery")
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), cursor)
+}
@@ -0,0 +1,365 @@
+package gas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	_ EvmEstimator = (*FeeOracleEstimator)(nil)
+
+	promFeeOracleEstimatorFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_fee_oracle_fallbacks",
+		Help: "The number of fee estimations served by the fallback estimator because the fee oracle failed",
+	}, []string{"evmChainID"})
+)
+
+// feeOracleMaxResponseSize bounds the size of the oracle responses which are decoded.
+const feeOracleMaxResponseSize = 1 << 20
+
+type FeeOracleEstimatorConfig struct {
+	// URLs are the oracles queried, in order, until one returns all the configured prices. They must all return the
+	// same response format.
+	URLs []*url.URL
+	// PricePath, TipCapPath and FeeCapPath are dot separated paths to the prices in the oracle's JSON response.
+	PricePath  string
+	TipCapPath string
+	FeeCapPath string
+	// Unit is the denomination of the prices returned by the oracle, either wei or gwei.
+	Unit           string
+	CacheTimeout   time.Duration
+	RequestTimeout time.Duration
+}
+
+type feeOracleEstimatorGasConfig interface {
+	PriceMin() *assets.Wei
+	TipCapMin() *assets.Wei
+	bumpConfig
+}
+
+type feeOraclePrices struct {
+	gasPrice *assets.Wei
+	tipCap   *assets.Wei
+	feeCap   *assets.Wei
+}
+
+// FeeOracleEstimator is an Estimator which uses the prices returned by external HTTP fee oracles, clamped to the
+// configured minimum and maximum prices. The oracles are queried in order, until one succeeds, and its response is
+// cached for CacheTimeout. When all the oracles fail, or are missing a price, estimations are delegated to the fallback
+// estimator until the oracles are retried after CacheTimeout.
+type FeeOracleEstimator struct {
+	services.StateMachine
+
+	cfg      FeeOracleEstimatorConfig
+	geCfg    feeOracleEstimatorGasConfig
+	fallback EvmEstimator
+	client   *http.Client
+	chainID  *big.Int
+	lggr     logger.SugaredLogger
+
+	// fetches deduplicates concurrent queries to the oracles, which are made without holding mu.
+	fetches singleflight.Group
+
+	mu        sync.Mutex
+	prices    feeOraclePrices
+	fetchedAt time.Time
+	err       error
+}
+
+// NewFeeOracleEstimator returns a new "FeeOracle" estimator, which falls back to fallback.
+func NewFeeOracleEstimator(lggr logger.Logger, cfg FeeOracleEstimatorConfig, geCfg feeOracleEstimatorGasConfig, chainID *big.Int, fallback EvmEstimator) *FeeOracleEstimator {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	return &FeeOracleEstimator{
+		cfg:      cfg,
+		geCfg:    geCfg,
+		fallback: fallback,
+		client:   &http.Client{Transport: t},
+		chainID:  chainID,
+		lggr:     logger.Sugared(logger.Named(lggr, "FeeOracleEstimator")),
+	}
+}
+
+func (o *FeeOracleEstimator) Name() string {
+	return o.lggr.Name()
+}
+
+func (o *FeeOracleEstimator) L1Oracle() rollups.L1Oracle {
+	return o.fallback.L1Oracle()
+}
+
+func (o *FeeOracleEstimator) Start(ctx context.Context) error {
+	return o.StartOnce("FeeOracleEstimator", func() error {
+		return o.fallback.Start(ctx)
+	})
+}
+
+func (o *FeeOracleEstimator) Close() error {
+	return o.StopOnce("FeeOracleEstimator", func() error {
+		return o.fallback.Close()
+	})
+}
+
+func (o *FeeOracleEstimator) HealthReport() map[string]error {
+	report := map[string]error{o.Name(): o.Healthy()}
+	services.CopyHealth(report, o.fallback.HealthReport())
+	return report
+}
+
+func (o *FeeOracleEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	o.fallback.OnNewLongestChain(ctx, head)
+}
+
+func (o *FeeOracleEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...feetypes.Opt) (*assets.Wei, uint64, error) {
+	prices, err := o.getPrices(ctx, slices.Contains(opts, feetypes.OptForceRefetch))
+	if err == nil && prices.gasPrice == nil {
+		err = errors.New("oracle response has no gas price")
+	}
+	if err != nil {
+		o.logFallback("GetLegacyGas", err)
+		return o.fallback.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+	}
+	gasPrice := o.clampGasPrice(prices.gasPrice, maxGasPriceWei)
+	o.lggr.Debugw("GetLegacyGas", "oracleGasPrice", prices.gasPrice, "gasPrice", gasPrice, "gasLimit", gasLimit)
+	return gasPrice, gasLimit, nil
+}
+
+func (o *FeeOracleEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	prices, err := o.getPrices(ctx, false)
+	if err == nil && prices.gasPrice == nil {
+		err = errors.New("oracle response has no gas price")
+	}
+	if err != nil {
+		o.logFallback("BumpLegacyGas", err)
+		return o.fallback.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+	}
+	bumped, err := BumpLegacyGasPriceOnly(o.geCfg, o.lggr, o.clampGasPrice(prices.gasPrice, maxGasPriceWei), originalGasPrice, maxGasPriceWei)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bumped, gasLimit, nil
+}
+
+func (o *FeeOracleEstimator) GetDynamicFee(ctx context.Context, maxGasPriceWei *assets.Wei) (DynamicFee, error) {
+	prices, err := o.getPrices(ctx, false)
+	if err == nil && (prices.tipCap == nil || prices.feeCap == nil) {
+		err = errors.New("oracle response has no tip cap or fee cap")
+	}
+	if err != nil {
+		o.logFallback("GetDynamicFee", err)
+		return o.fallback.GetDynamicFee(ctx, maxGasPriceWei)
+	}
+	fee := o.clampDynamicFee(prices, maxGasPriceWei)
+	o.lggr.Debugw("GetDynamicFee", "oracleTipCap", prices.tipCap, "oracleFeeCap", prices.feeCap, "tipCap", fee.GasTipCap, "feeCap", fee.GasFeeCap)
+	return fee, nil
+}
+
+func (o *FeeOracleEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (DynamicFee, error) {
+	prices, err := o.getPrices(ctx, false)
+	if err == nil && (prices.tipCap == nil || prices.feeCap == nil) {
+		err = errors.New("oracle response has no tip cap or fee cap")
+	}
+	if err != nil {
+		o.logFallback("BumpDynamicFee", err)
+		return o.fallback.BumpDynamicFee(ctx, original, maxGasPriceWei, attempts)
+	}
+	current := o.clampDynamicFee(prices, maxGasPriceWei)
+	return BumpDynamicFeeOnly(o.geCfg, 0, o.lggr, current.GasTipCap, nil, original, maxGasPriceWei)
+}
+
+func (o *FeeOracleEstimator) logFallback(method string, err error) {
+	promFeeOracleEstimatorFallbacks.WithLabelValues(o.chainID.String()).Inc()
+	o.lggr.Warnw("Fee oracle failed, using fallback estimator", "method", method, "err", err)
+}
+
+// clampGasPrice returns the gas price bounded by PriceMin, and the lower of PriceMax and maxGasPriceWei.
+func (o *FeeOracleEstimator) clampGasPrice(gasPrice, maxGasPriceWei *assets.Wei) *assets.Wei {
+	return capGasPrice(assets.WeiMax(gasPrice, o.geCfg.PriceMin()), o.geCfg.PriceMax(), maxGasPriceWei)
+}
+
+// clampDynamicFee returns the fee with the tip cap at least TipCapMin, and both caps bounded by the lower of PriceMax
+// and maxGasPriceWei.
+func (o *FeeOracleEstimator) clampDynamicFee(prices feeOraclePrices, maxGasPriceWei *assets.Wei) DynamicFee {
+	feeCap := capGasPrice(prices.feeCap, o.geCfg.PriceMax(), maxGasPriceWei)
+	tipCap := assets.WeiMin(assets.WeiMax(prices.tipCap, o.geCfg.TipCapMin()), feeCap)
+	return DynamicFee{GasFeeCap: feeCap, GasTipCap: tipCap}
+}
+
+// getPrices returns the cached prices, or queries the oracles if they are older than CacheTimeout. Failures are cached
+// too, so that failing oracles are not queried on every estimation, except for context errors. Concurrent callers share
+// a single query, which is detached from their contexts and only bounded by RequestTimeout, so one caller giving up
+// doesn't fail the others.
+func (o *FeeOracleEstimator) getPrices(ctx context.Context, forceRefetch bool) (feeOraclePrices, error) {
+	o.mu.Lock()
+	if !forceRefetch && !o.fetchedAt.IsZero() && time.Since(o.fetchedAt) < o.cfg.CacheTimeout {
+		prices, err := o.prices, o.err
+		o.mu.Unlock()
+		return prices, err
+	}
+	o.mu.Unlock()
+
+	ch := o.fetches.DoChan("prices", func() (any, error) {
+		prices, err := o.fetchPrices(context.WithoutCancel(ctx))
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return prices, err
+		}
+		o.mu.Lock()
+		o.prices, o.err, o.fetchedAt = prices, err, time.Now()
+		o.mu.Unlock()
+		if err == nil {
+			o.lggr.Debugw("Fetched prices from fee oracle", "gasPrice", prices.gasPrice, "tipCap", prices.tipCap, "feeCap", prices.feeCap)
+		}
+		return prices, err
+	})
+	select {
+	case res := <-ch:
+		return res.Val.(feeOraclePrices), res.Err
+	case <-ctx.Done():
+		return feeOraclePrices{}, ctx.Err()
+	}
+}
+
+// fetchPrices queries the oracles in order, and returns the prices of the first one which succeeds.
+func (o *FeeOracleEstimator) fetchPrices(ctx context.Context) (feeOraclePrices, error) {
+	var errs error
+	for _, u := range o.cfg.URLs {
+		prices, err := o.fetchPricesFrom(ctx, u)
+		if err == nil {
+			return prices, nil
+		}
+		o.lggr.Debugw("Fee oracle failed", "url", u.Redacted(), "err", err)
+		errs = errors.Join(errs, fmt.Errorf("%s: %w", u.Redacted(), err))
+	}
+	if errs == nil {
+		errs = errors.New("no fee oracle URLs configured")
+	}
+	return feeOraclePrices{}, errs
+}
+
+func (o *FeeOracleEstimator) fetchPricesFrom(ctx context.Context, u *url.URL) (prices feeOraclePrices, err error) {
+	ctx, cancel := context.WithTimeout(ctx, o.cfg.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return prices, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return prices, fmt.Errorf("request to fee oracle failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return prices, fmt.Errorf("fee oracle returned status %d", resp.StatusCode)
+	}
+
+	dec := json.NewDecoder(io.LimitReader(resp.Body, feeOracleMaxResponseSize))
+	dec.UseNumber()
+	var body any
+	if err = dec.Decode(&body); err != nil {
+		return prices, fmt.Errorf("failed to decode fee oracle response: %w", err)
+	}
+
+	for _, p := range []struct {
+		path  string
+		price **assets.Wei
+	}{
+		{o.cfg.PricePath, &prices.gasPrice},
+		{o.cfg.TipCapPath, &prices.tipCap},
+		{o.cfg.FeeCapPath, &prices.feeCap},
+	} {
+		if p.path == "" {
+			continue
+		}
+		if *p.price, err = feeOraclePrice(body, p.path, o.cfg.Unit); err != nil {
+			return feeOraclePrices{}, err
+		}
+	}
+	return prices, nil
+}
+
+// feeOraclePrice returns the price at path in body, converted to wei.
+func feeOraclePrice(body any, path, unit string) (*assets.Wei, error) {
+	v := body
+	for _, seg := range strings.Split(path, ".") {
+		switch t := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = t[seg]; !ok {
+				return nil, fmt.Errorf("fee oracle response has no %q at %s", seg, path)
+			}
+		case []any:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(t) {
+				return nil, fmt.Errorf("fee oracle response has no index %q at %s", seg, path)
+			}
+			v = t[i]
+		default:
+			return nil, fmt.Errorf("fee oracle response has no %q at %s", seg, path)
+		}
+	}
+
+	// Hex strings are quantities in wei, as returned by RPC nodes.
+	var d decimal.Decimal
+	isWei := false
+	switch t := v.(type) {
+	case json.Number:
+		var err error
+		if d, err = decimal.NewFromString(t.String()); err != nil {
+			return nil, fmt.Errorf("invalid price at %s: %w", path, err)
+		}
+	case string:
+		if strings.HasPrefix(t, "0x") {
+			i, err := hexutil.DecodeBig(t)
+			if err != nil {
+				return nil, fmt.Errorf("invalid price at %s: %w", path, err)
+			}
+			d = decimal.NewFromBigInt(i, 0)
+			isWei = true
+		} else {
+			var err error
+			if d, err = decimal.NewFromString(t); err != nil {
+				return nil, fmt.Errorf("invalid price at %s: %w", path, err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid price at %s: expected a number or string but got %T", path, v)
+	}
+	if d.IsNegative() {
+		return nil, fmt.Errorf("invalid price at %s: %s is negative", path, d)
+	}
+
+	switch {
+	case isWei, unit == "wei":
+	case unit == "gwei":
+		d = d.Shift(9)
+	default:
+		return nil, fmt.Errorf("unsupported fee oracle unit: %s", unit)
+	}
+	return assets.NewWei(d.BigInt()), nil
+}
@@ -0,0 +1,353 @@
+package gas_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+type testFeeOracle struct {
+	*httptest.Server
+	response atomic.Value
+	status   atomic.Int32
+	requests atomic.Int32
+}
+
+func newTestFeeOracle(t *testing.T, response string) *testFeeOracle {
+	o := &testFeeOracle{}
+	o.response.Store(response)
+	o.status.Store(http.StatusOK)
+	o.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		o.requests.Add(1)
+		w.WriteHeader(int(o.status.Load()))
+		fmt.Fprint(w, o.response.Load().(string))
+	}))
+	t.Cleanup(o.Close)
+	return o
+}
+
+func (o *testFeeOracle) config(t *testing.T) gas.FeeOracleEstimatorConfig {
+	u, err := url.Parse(o.URL)
+	require.NoError(t, err)
+	return gas.FeeOracleEstimatorConfig{
+		URLs:           []*url.URL{u},
+		PricePath:      "fast.gasPrice",
+		Unit:           "gwei",
+		CacheTimeout:   time.Hour,
+		RequestTimeout: time.Second,
+	}
+}
+
+// dynamicConfig returns the estimator config for an oracle serving testFeeOracleResponse.
+func (o *testFeeOracle) dynamicConfig(t *testing.T) gas.FeeOracleEstimatorConfig {
+	cfg := o.config(t)
+	cfg.TipCapPath = "blockPrices.0.maxPriorityFeePerGas"
+	cfg.FeeCapPath = "blockPrices.0.maxFeePerGas"
+	return cfg
+}
+
+func newFallbackEstimator(t *testing.T) *mocks.EvmEstimator {
+	fallback := mocks.NewEvmEstimator(t)
+	fallback.On("Start", mock.Anything).Return(nil).Maybe()
+	fallback.On("Close").Return(nil).Maybe()
+	fallback.On("HealthReport").Return(map[string]error{}).Maybe()
+	return fallback
+}
+
+const testFeeOracleResponse = `{"fast": {"gasPrice": 30.5}, "blockPrices": [{"maxPriorityFeePerGas": "2", "maxFeePerGas": "0xba43b7400"}]}`
+
+func TestFeeOracleEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.GWei(100)
+	const gasLimit uint64 = 80000
+	cfg := &gas.MockGasEstimatorConfig{
+		BumpPercentF:   20,
+		BumpMinF:       assets.GWei(1),
+		PriceMinF:      assets.GWei(1),
+		PriceMaxF:      assets.GWei(200),
+		TipCapMinF:     assets.GWei(1),
+		TipCapDefaultF: assets.GWei(1),
+	}
+
+	t.Run("returns prices from the oracle", func(t *testing.T) {
+		oracle := newTestFeeOracle(t, testFeeOracleResponse)
+		o := gas.NewFeeOracleEstimator(logger.Test(t), oracle.dynamicConfig(t), cfg, big.NewInt(1), newFallbackEstimator(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, limit, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30_500_000_000), gasPrice)
+		assert.Equal(t, gasLimit, limit)
+
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(2), fee.GasTipCap)
+		assert.Equal(t, assets.GWei(50), fee.GasFeeCap)
+
+		assert.Equal(t, int32(1), oracle.requests.Load(), "expected the response to be cached")
+	})
+
+	t.Run("refetches when the cache expires", func(t *testing.T) {
+		oracle := newTestFeeOracle(t, `{"fast": {"gasPrice": 30}}`)
+		ocfg := oracle.config(t)
+		ocfg.CacheTimeout = 0
+		o := gas.NewFeeOracleEstimator(logger.Test(t), ocfg, cfg, big.NewInt(1), newFallbackEstimator(t))
+		servicetest.RunHealthy(t, o)
+
+		_, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		oracle.response.Store(`{"fast": {"gasPrice": 40}}`)
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(40), gasPrice)
+		assert.Equal(t, int32(2), oracle.requests.Load())
+	})
+
+	t.Run("clamps prices to PriceMin and PriceMax", func(t *testing.T) {
+		oracle := newTestFeeOracle(t, `{"fast": {"gasPrice": 0.1}}`)
+		ocfg := oracle.config(t)
+		ocfg.CacheTimeout = 0
+		o := gas.NewFeeOracleEstimator(logger.Test(t), ocfg, cfg, big.NewInt(1), newFallbackEstimator(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(1), gasPrice)
+
+		oracle.response.Store(`{"fast": {"gasPrice": 500}}`)
+		gasPrice, _, err = o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, maxGasPrice, gasPrice, "expected the lower of PriceMax and the max gas price")
+		gasPrice, _, err = o.GetLegacyGas(tests.Context(t), nil, gasLimit, assets.GWei(1000))
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(200), gasPrice)
+	})
+
+	t.Run("uses the fallback estimator when the oracle fails", func(t *testing.T) {
+		oracle := newTestFeeOracle(t, `{}`)
+		oracle.status.Store(http.StatusInternalServerError)
+		fallback := newFallbackEstimator(t)
+		fallback.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.GWei(7), gasLimit, nil).Twice()
+		o := gas.NewFeeOracleEstimator(logger.Test(t), oracle.config(t), cfg, big.NewInt(1), fallback)
+		servicetest.RunHealthy(t, o)
+
+		for i := 0; i < 2; i++ {
+			gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.GWei(7), gasPrice)
+		}
+		assert.Equal(t, int32(1), oracle.requests.Load(), "expected the failure to be cached")
+	})
+
+	t.Run("queries the next oracle when one fails", func(t *testing.T) {
+		failing := newTestFeeOracle(t, `{}`)
+		failing.status.Store(http.StatusBadGateway)
+		missing := newTestFeeOracle(t, `{"slow": {"gasPrice": 10}}`)
+		oracle := newTestFeeOracle(t, `{"fast": {"gasPrice": 30}}`)
+		ocfg := oracle.config(t)
+		ocfg.URLs = append(append(failing.config(t).URLs, missing.config(t).URLs...), ocfg.URLs...)
+		o := gas.NewFeeOracleEstimator(logger.Test(t), ocfg, cfg, big.NewInt(1), newFallbackEstimator(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(30), gasPrice)
+		assert.Equal(t, int32(1), failing.requests.Load())
+		assert.Equal(t, int32(1), missing.requests.Load())
+		assert.Equal(t, int32(1), oracle.requests.Load())
+	})
+
+	t.Run("shares a query between concurrent estimations", func(t *testing.T) {
+		release := make(chan struct{})
+		var requests atomic.Int32
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			<-release
+			fmt.Fprint(w, `{"fast": {"gasPrice": 30}}`)
+		}))
+		t.Cleanup(slow.Close)
+		u, err := url.Parse(slow.URL)
+		require.NoError(t, err)
+		o := gas.NewFeeOracleEstimator(logger.Test(t), gas.FeeOracleEstimatorConfig{
+			URLs:           []*url.URL{u},
+			PricePath:      "fast.gasPrice",
+			Unit:           "gwei",
+			CacheTimeout:   time.Hour,
+			RequestTimeout: tests.WaitTimeout(t),
+		}, cfg, big.NewInt(1), newFallbackEstimator(t))
+		servicetest.RunHealthy(t, o)
+
+		const n = 5
+		prices := make(chan *assets.Wei, n)
+		for i := 0; i < n; i++ {
+			go func() {
+				gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+				assert.NoError(t, err)
+				prices <- gasPrice
+			}()
+		}
+		require.Eventually(t, func() bool { return requests.Load() == 1 }, tests.WaitTimeout(t), 10*time.Millisecond)
+		close(release)
+		for i := 0; i < n; i++ {
+			assert.Equal(t, assets.GWei(30), <-prices)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("doesn't fail a shared query when an estimation is cancelled", func(t *testing.T) {
+		release := make(chan struct{})
+		var requests atomic.Int32
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			<-release
+			fmt.Fprint(w, `{"fast": {"gasPrice": 30}}`)
+		}))
+		t.Cleanup(slow.Close)
+		u, err := url.Parse(slow.URL)
+		require.NoError(t, err)
+		fallback := newFallbackEstimator(t)
+		fallback.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.GWei(7), gasLimit, nil).Once()
+		o := gas.NewFeeOracleEstimator(logger.Test(t), gas.FeeOracleEstimatorConfig{
+			URLs:           []*url.URL{u},
+			PricePath:      "fast.gasPrice",
+			Unit:           "gwei",
+			CacheTimeout:   time.Hour,
+			RequestTimeout: tests.WaitTimeout(t),
+		}, cfg, big.NewInt(1), fallback)
+		servicetest.RunHealthy(t, o)
+
+		ctx, cancel := context.WithCancel(tests.Context(t))
+		cancelled := make(chan *assets.Wei, 1)
+		go func() {
+			gasPrice, _, err := o.GetLegacyGas(ctx, nil, gasLimit, maxGasPrice)
+			assert.NoError(t, err)
+			cancelled <- gasPrice
+		}()
+		require.Eventually(t, func() bool { return requests.Load() == 1 }, tests.WaitTimeout(t), 10*time.Millisecond)
+		cancel()
+		assert.Equal(t, assets.GWei(7), <-cancelled, "expected the cancelled estimation to use the fallback")
+
+		close(release)
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(30), gasPrice)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("uses the fallback estimator when the oracle times out", func(t *testing.T) {
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		t.Cleanup(slow.Close)
+		u, err := url.Parse(slow.URL)
+		require.NoError(t, err)
+		fallback := newFallbackEstimator(t)
+		fallback.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxGasPrice).Return(assets.GWei(7), gasLimit, nil).Once()
+		o := gas.NewFeeOracleEstimator(logger.Test(t), gas.FeeOracleEstimatorConfig{
+			URLs:           []*url.URL{u},
+			PricePath:      "fast",
+			Unit:           "gwei",
+			RequestTimeout: 10 * time.Millisecond,
+		}, cfg, big.NewInt(1), fallback)
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(7), gasPrice)
+	})
+
+	t.Run("uses the fallback estimator when a price is missing", func(t *testing.T) {
+		oracle := newTestFeeOracle(t, `{"fast": {"gasPrice": 30}}`)
+		ocfg := oracle.config(t)
+		fallback := newFallbackEstimator(t)
+		expected := gas.DynamicFee{GasTipCap: assets.GWei(3), GasFeeCap: assets.GWei(60)}
+		fallback.On("GetDynamicFee", mock.Anything, maxGasPrice).Return(expected, nil).Once()
+		o := gas.NewFeeOracleEstimator(logger.Test(t), ocfg, cfg, big.NewInt(1), fallback)
+		servicetest.RunHealthy(t, o)
+
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, expected, fee)
+	})
+
+	t.Run("bumps from the higher of the original and oracle prices", func(t *testing.T) {
+		oracle := newTestFeeOracle(t, testFeeOracleResponse)
+		o := gas.NewFeeOracleEstimator(logger.Test(t), oracle.dynamicConfig(t), cfg, big.NewInt(1), newFallbackEstimator(t))
+		servicetest.RunHealthy(t, o)
+
+		bumped, _, err := o.BumpLegacyGas(tests.Context(t), assets.GWei(10), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30_500_000_000), bumped)
+
+		bumped, _, err = o.BumpLegacyGas(tests.Context(t), assets.GWei(40), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(48), bumped)
+
+		bumpedFee, err := o.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasTipCap: assets.GWei(5), GasFeeCap: assets.GWei(50)}, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(6), bumpedFee.GasTipCap)
+		assert.Equal(t, assets.GWei(60), bumpedFee.GasFeeCap)
+	})
+}
+
+func TestFeeOracleEstimator_ResponseFormats(t *testing.T) {
+	t.Parallel()
+
+	cfg := &gas.MockGasEstimatorConfig{PriceMinF: assets.NewWeiI(0), PriceMaxF: assets.GWei(1000), TipCapMinF: assets.NewWeiI(0)}
+	maxGasPrice := assets.GWei(1000)
+
+	for _, tc := range []struct {
+		name     string
+		response string
+		unit     string
+		expected *assets.Wei
+		err      bool
+	}{
+		{"number in gwei", `{"fast": {"gasPrice": 12.25}}`, "gwei", assets.NewWeiI(12_250_000_000), false},
+		{"decimal string in wei", `{"fast": {"gasPrice": "12000000000"}}`, "wei", assets.GWei(12), false},
+		{"hex string in wei", `{"fast": {"gasPrice": "0x2cb417800"}}`, "gwei", assets.GWei(12), false},
+		{"missing path", `{"slow": {"gasPrice": 12}}`, "gwei", nil, true},
+		{"negative", `{"fast": {"gasPrice": -1}}`, "gwei", nil, true},
+		{"not a number", `{"fast": {"gasPrice": true}}`, "gwei", nil, true},
+		{"not json", `<html></html>`, "gwei", nil, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			oracle := newTestFeeOracle(t, tc.response)
+			ocfg := oracle.config(t)
+			ocfg.Unit = tc.unit
+			fallback := newFallbackEstimator(t)
+			if tc.err {
+				fallback.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, maxGasPrice).Return(assets.GWei(7), uint64(0), nil).Once()
+			}
+			o := gas.NewFeeOracleEstimator(logger.Test(t), ocfg, cfg, big.NewInt(1), fallback)
+			servicetest.RunHealthy(t, o)
+
+			gasPrice, _, err := o.GetLegacyGas(tests.Context(t), nil, 21000, maxGasPrice)
+			require.NoError(t, err)
+			if tc.err {
+				assert.Equal(t, assets.GWei(7), gasPrice, "expected the fallback price")
+			} else {
+				assert.Equal(t, tc.expected, gasPrice)
+			}
+		})
+	}
+}
@@ -85,8 +85,18 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 		return nil, fmt.Errorf("failed to initialize L1 oracle: %w", err)
 	}
 
+	newEstimator, err := newEvmEstimator(lggr, s, ethClient, chaintype, chainID, geCfg, l1Oracle)
+	if err != nil {
+		return nil, err
+	}
+	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+}
+
+// newEvmEstimator returns a constructor for the EvmEstimator of the given mode.
+func newEvmEstimator(lggr logger.Logger, mode string, ethClient feeEstimatorClient, chaintype chaintype.ChainType, chainID *big.Int, geCfg evmconfig.GasEstimator, l1Oracle rollups.L1Oracle) (func(logger.Logger) EvmEstimator, error) {
+	bh := geCfg.BlockHistory()
 	var newEstimator func(logger.Logger) EvmEstimator
-	switch s {
+	switch mode {
 	case "Arbitrum":
 		arbOracle, err := rollups.NewArbitrumL1GasOracle(lggr, ethClient)
 		if err != nil {
@@ -118,14 +128,36 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 			}
 			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, chainID, l1Oracle)
 		}
+	case "FeeOracle":
+		fallbackMode := geCfg.FeeOracle().FallbackMode()
+		if fallbackMode == "FeeOracle" {
+			return nil, pkgerrors.New("GasEstimator: FeeOracle.FallbackMode may not be FeeOracle")
+		}
+		newFallback, err := newEvmEstimator(lggr, fallbackMode, ethClient, chaintype, chainID, geCfg, l1Oracle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize FeeOracle fallback estimator: %w", err)
+		}
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			fo := geCfg.FeeOracle()
+			ocfg := FeeOracleEstimatorConfig{
+				URLs:           fo.URLs(),
+				PricePath:      fo.PricePath(),
+				TipCapPath:     fo.TipCapPath(),
+				FeeCapPath:     fo.FeeCapPath(),
+				Unit:           fo.Unit(),
+				CacheTimeout:   fo.CacheTimeout(),
+				RequestTimeout: fo.RequestTimeout(),
+			}
+			return NewFeeOracleEstimator(lggr, ocfg, geCfg, chainID, newFallback(l))
+		}
 
 	default:
-		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
+		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", mode)
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
 		}
 	}
-	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+	return newEstimator, nil
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
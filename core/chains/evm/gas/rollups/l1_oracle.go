@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
@@ -28,6 +29,12 @@ type L1Oracle interface {
 	GasPrice(ctx context.Context) (*assets.Wei, error)
 }
 
+// L1GasCostOracle is implemented by L1 oracles which can compute the L1 data fee of a transaction, with the
+// fee scalars and overheads of the chain applied.
+type L1GasCostOracle interface {
+	GetGasCost(ctx context.Context, tx *gethtypes.Transaction, blockNum *big.Int) (*assets.Wei, error)
+}
+
 type l1OracleClient interface {
 	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
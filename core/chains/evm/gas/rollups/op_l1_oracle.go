@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -352,6 +353,30 @@ func (o *optimismL1Oracle) checkForUpgrade(ctx context.Context) error {
 	return nil
 }
 
+// GetGasCost returns the L1 data fee the GasPriceOracle charges for tx at blockNum.
+func (o *optimismL1Oracle) GetGasCost(ctx context.Context, tx *gethtypes.Transaction, blockNum *big.Int) (*assets.Wei, error) {
+	encodedTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tx for L1 gas cost estimation: %w", err)
+	}
+	callData, err := o.getL1FeeMethodAbi.Pack(getL1FeeMethod, encodedTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack calldata for %s: %w", getL1FeeMethod, err)
+	}
+	b, err := o.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &o.daOracleAddress,
+		Data: callData,
+	}, blockNum)
+	if err != nil {
+		return nil, fmt.Errorf("getL1Fee() call failed: %w", err)
+	}
+
+	if len(b) != 32 {
+		return nil, fmt.Errorf("getL1Fee() return data length (%d) different than expected (%d)", len(b), 32)
+	}
+	return assets.NewWei(new(big.Int).SetBytes(b)), nil
+}
+
 func (o *optimismL1Oracle) getV1GasPrice(ctx context.Context) (*big.Int, error) {
 	b, err := o.client.CallContract(ctx, ethereum.CallMsg{
 		To:   &o.daOracleAddress,
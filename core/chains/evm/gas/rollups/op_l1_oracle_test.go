@@ -1,6 +1,7 @@
 package rollups
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -18,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups/mocks"
@@ -348,3 +351,50 @@ func TestOPL1Oracle_CalculateFjordGasPrice(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestOPL1Oracle_GetGasCost(t *testing.T) {
+	oracleAddress := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 42, Gas: 21000, GasPrice: big.NewInt(1), Data: []byte{1, 0, 1}})
+	encodedTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	getL1FeeMethodAbi, err := abi.JSON(strings.NewReader(GetL1FeeAbiString))
+	require.NoError(t, err)
+	getL1FeeCalldata, err := getL1FeeMethodAbi.Pack(getL1FeeMethod, encodedTx)
+	require.NoError(t, err)
+	blockNum := big.NewInt(1000)
+
+	newOracle := func(t *testing.T, result []byte, err error) *optimismL1Oracle {
+		ethClient := mocks.NewL1OracleClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.IsType(ethereum.CallMsg{}), blockNum).Run(func(args mock.Arguments) {
+			callMsg := args.Get(1).(ethereum.CallMsg)
+			require.Equal(t, oracleAddress, *callMsg.To)
+			require.Equal(t, getL1FeeCalldata, callMsg.Data)
+		}).Return(result, err).Once()
+		daOracle := CreateTestDAOracle(t, toml.DAOracleOPStack, oracleAddress.String(), "")
+		oracle, err := NewOpStackL1GasOracle(logger.Test(t), ethClient, chaintype.ChainOptimismBedrock, daOracle)
+		require.NoError(t, err)
+		return oracle
+	}
+
+	t.Run("returns the L1 fee", func(t *testing.T) {
+		oracle := newOracle(t, common.BigToHash(big.NewInt(1234)).Bytes(), nil)
+
+		fee, err := oracle.GetGasCost(tests.Context(t), tx, blockNum)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(1234), fee)
+	})
+
+	t.Run("errors on unexpected return data", func(t *testing.T) {
+		oracle := newOracle(t, []byte{1}, nil)
+
+		_, err := oracle.GetGasCost(tests.Context(t), tx, blockNum)
+		require.ErrorContains(t, err, "getL1Fee() return data length (1) different than expected (32)")
+	})
+
+	t.Run("errors if the call fails", func(t *testing.T) {
+		oracle := newOracle(t, nil, errors.New("execution reverted"))
+
+		_, err := oracle.GetGasCost(tests.Context(t), tx, blockNum)
+		require.ErrorContains(t, err, "getL1Fee() call failed: execution reverted")
+	})
+}
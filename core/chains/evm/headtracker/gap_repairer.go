@@ -0,0 +1,247 @@
+package headtracker
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+var promGapRepairedHeads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "head_tracker_gap_repaired_heads",
+	Help: "The total number of missing heads refilled by the head tracker gap repairer",
+}, []string{"evmChainID"})
+
+const (
+	trustedCheckpointCond = "TrustedCheckpoint"
+	// maxGapsPerRun bounds the gaps looked up, and repaired, by a single run
+	maxGapsPerRun = 100
+)
+
+// GapRepairClient is the subset of the EVM client used to refill gaps.
+type GapRepairClient interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// GapRepairer periodically detects holes in the chain of heads persisted by the HeadSaver, which are left behind
+// when the node was down for longer than backfill reaches, and refills them in batches.
+// A refilled head is only persisted if it links by parent hash to its persisted child. If a trusted checkpoint is
+// configured, the chain served by the RPC must contain it, and it is persisted and kept by the HeadSaver along with the
+// HistoryDepth window, so that the persisted chain is anchored to the checkpoint.
+type GapRepairer struct {
+	services.Service
+	eng *services.Engine
+
+	chainID  *big.Int
+	orm      ORM
+	client   GapRepairClient
+	tracker  httypes.HeadTracker
+	htConfig config.HeadTracker
+}
+
+func NewGapRepairer(lggr logger.Logger, chainID *big.Int, orm ORM, client GapRepairClient, tracker httypes.HeadTracker, htConfig config.HeadTracker) *GapRepairer {
+	r := &GapRepairer{
+		chainID:  chainID,
+		orm:      orm,
+		client:   client,
+		tracker:  tracker,
+		htConfig: htConfig,
+	}
+	r.Service, r.eng = services.Config{
+		Name:  "HeadTrackerGapRepairer",
+		Start: r.start,
+	}.NewServiceEngine(lggr)
+	return r
+}
+
+func (r *GapRepairer) start(context.Context) error {
+	r.eng.GoTick(services.NewTicker(r.htConfig.GapRepair().Interval()), r.run)
+	return nil
+}
+
+func (r *GapRepairer) run(ctx context.Context) {
+	repaired, err := r.RepairGaps(ctx)
+	if repaired > 0 {
+		r.eng.Infow("Repaired gaps in persisted heads", "repaired", repaired)
+	}
+	if err != nil && ctx.Err() == nil {
+		r.eng.Warnw("Failed to repair gaps in persisted heads", "repaired", repaired, "err", err)
+	}
+}
+
+// RepairGaps refills the holes in the persisted chain of heads between the latest head and the lowest block kept by
+// the HeadSaver. At most maxGapsPerRun gaps are repaired per run. It returns the number of heads refilled.
+func (r *GapRepairer) RepairGaps(ctx context.Context) (repaired int, err error) {
+	latest := r.tracker.LatestChain()
+	if latest == nil {
+		return 0, nil
+	}
+	finalized := latest.LatestFinalizedHead()
+	if finalized == nil {
+		// nothing is trimmed until a block is finalized, so there is no floor to repair to yet
+		return 0, nil
+	}
+	floor := max(finalized.BlockNumber()-int64(r.htConfig.HistoryDepth()), 0)
+	if r.htConfig.GapRepair().TrustedCheckpointNumber() != nil {
+		if err = r.verifyCheckpoint(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	// ordered by number descending
+	gaps, err := r.orm.HeadGaps(ctx, floor, maxGapsPerRun)
+	if err != nil {
+		return 0, err
+	}
+	for _, gap := range gaps {
+		bottom := floor
+		if gap.LowerNumber.Valid {
+			// a sibling of the missing parent may be persisted, in which case only the parent itself is missing
+			bottom = max(min(gap.LowerNumber.Int64+1, gap.Number-1), floor)
+		}
+		var n int
+		n, err = r.repairGap(ctx, gap, bottom)
+		repaired += n
+		if err != nil {
+			return repaired, fmt.Errorf("failed to repair gap below block %d: %w", gap.Number, err)
+		}
+	}
+	return repaired, nil
+}
+
+// repairGap walks down from the head of gap, refilling its missing ancestors until one is found persisted, or bottom
+// is reached. Anything left over is picked up as a new gap on the next run.
+func (r *GapRepairer) repairGap(ctx context.Context, gap HeadGap, bottom int64) (repaired int, err error) {
+	batchSize := int64(r.htConfig.GapRepair().BatchSize())
+	expected := gap.ParentHash
+	for to := gap.Number - 1; to >= bottom; to -= batchSize {
+		var heads []*evmtypes.Head
+		heads, err = r.fetchHeads(ctx, max(to-batchSize+1, bottom), to)
+		if err != nil {
+			return repaired, err
+		}
+		for _, h := range heads {
+			if h.Hash != expected {
+				return repaired, fmt.Errorf("block %d has hash %s, but its child expects parent hash %s, the chain may have re-orged", h.Number, h.Hash, expected)
+			}
+			if err = r.checkCheckpoint(h); err != nil {
+				return repaired, err
+			}
+			if err = r.orm.IdempotentInsertHead(ctx, h); err != nil {
+				return repaired, err
+			}
+			repaired++
+			promGapRepairedHeads.WithLabelValues(r.chainID.String()).Inc()
+
+			expected = h.ParentHash
+			// only a head at or below the one persisted below the gap can be the persisted parent
+			if gap.LowerNumber.Valid && h.Number-1 <= gap.LowerNumber.Int64 {
+				parent, err := r.orm.HeadByHash(ctx, expected)
+				if err != nil {
+					return repaired, err
+				}
+				if parent != nil {
+					return repaired, nil
+				}
+			}
+		}
+	}
+	return repaired, nil
+}
+
+// verifyCheckpoint checks that the chain served by the RPC contains the trusted checkpoint, and persists it. It is
+// called on every run, as the RPC may switch to another fork. While it does not, the repairer reports unhealthy and
+// does not persist anything.
+func (r *GapRepairer) verifyCheckpoint(ctx context.Context) error {
+	number := *r.htConfig.GapRepair().TrustedCheckpointNumber()
+	heads, err := r.fetchHeads(ctx, number, number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch trusted checkpoint: %w", err)
+	}
+	if err = r.checkCheckpoint(heads[0]); err != nil {
+		return err
+	}
+	r.eng.ClearHealthCond(trustedCheckpointCond)
+	return r.orm.IdempotentInsertHead(ctx, heads[0])
+}
+
+func (r *GapRepairer) checkCheckpoint(h *evmtypes.Head) error {
+	number, hash := r.htConfig.GapRepair().TrustedCheckpointNumber(), r.htConfig.GapRepair().TrustedCheckpointHash()
+	if number == nil || h.Number != *number || h.Hash == *hash {
+		return nil
+	}
+	err := TrustedCheckpointMismatchError{Number: *number, Trusted: *hash, Actual: h.Hash}
+	r.eng.Criticalw("RPC chain does not contain the trusted checkpoint. Either the RPC node is on a different fork, or the checkpoint is misconfigured.", "err", err)
+	r.eng.SetHealthCond(trustedCheckpointCond, err)
+	return err
+}
+
+// fetchHeads fetches the heads in [from, to] in a single batch call, ordered by number descending.
+func (r *GapRepairer) fetchHeads(ctx context.Context, from, to int64) ([]*evmtypes.Head, error) {
+	reqs := make([]rpc.BatchElem, 0, to-from+1)
+	for n := to; n >= from; n-- {
+		reqs = append(reqs, rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeBig(big.NewInt(n)), false},
+			Result: &evmtypes.Head{},
+		})
+	}
+	if err := r.client.BatchCallContext(ctx, reqs); err != nil {
+		return nil, fmt.Errorf("failed to fetch blocks %d to %d: %w", from, to, err)
+	}
+	heads := make([]*evmtypes.Head, len(reqs))
+	for i, req := range reqs {
+		n := to - int64(i)
+		if req.Error != nil {
+			return nil, fmt.Errorf("failed to fetch block %d: %w", n, req.Error)
+		}
+		h, ok := req.Result.(*evmtypes.Head)
+		if !ok || h.Hash == (common.Hash{}) || h.Number != n {
+			return nil, fmt.Errorf("invalid block returned for block number %d", n)
+		}
+		h.EVMChainID = ubig.New(r.chainID)
+		heads[i] = h
+	}
+	return heads, nil
+}
+
+type checkpointORM struct {
+	ORM
+	checkpoint common.Hash
+}
+
+// NewCheckpointORM returns an ORM which does not trim the trusted checkpoint head persisted by the GapRepairer, so
+// that it is kept alongside the HistoryDepth window. It returns orm if no checkpoint is configured.
+func NewCheckpointORM(orm ORM, htConfig config.HeadTracker) ORM {
+	cp := htConfig.GapRepair().TrustedCheckpointHash()
+	if cp == nil {
+		return orm
+	}
+	return &checkpointORM{ORM: orm, checkpoint: *cp}
+}
+
+func (o *checkpointORM) TrimOldHeads(ctx context.Context, minBlockNumber int64) error {
+	return o.ORM.TrimOldHeadsExcept(ctx, minBlockNumber, o.checkpoint)
+}
+
+type TrustedCheckpointMismatchError struct {
+	Number          int64
+	Trusted, Actual common.Hash
+}
+
+func (e TrustedCheckpointMismatchError) Error() string {
+	return fmt.Sprintf("block %d has hash %s, but the trusted checkpoint is %s", e.Number, e.Actual, e.Trusted)
+}
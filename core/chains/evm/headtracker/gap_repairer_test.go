@@ -0,0 +1,308 @@
+package headtracker_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	htmocks "github.com/smartcontractkit/chainlink/v2/common/headtracker/mocks"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+)
+
+func TestGapRepairer_RepairGaps(t *testing.T) {
+	t.Parallel()
+
+	// canonical[i] is block i
+	canonical := make([]*evmtypes.Head, 30)
+	for i := range canonical {
+		canonical[i] = testutils.Head(i)
+		if i > 0 {
+			canonical[i].ParentHash = canonical[i-1].Hash
+		}
+	}
+
+	newRepairer := func(t *testing.T, served []*evmtypes.Head, overrideFn func(c *toml.HeadTrackerGapRepair)) (*headtracker.GapRepairer, *memoryHeadsORM, *evmclimocks.Client) {
+		// blocks 0 to 4 are trimmed, and 9 to 14 were missed during downtime
+		orm := &memoryHeadsORM{}
+		for _, h := range slices.Concat(canonical[5:9], canonical[15:]) {
+			require.NoError(t, orm.IdempotentInsertHead(tests.Context(t), h))
+		}
+
+		// the latest chain 25-29 in memory, with 25 finalized
+		latest := &evmtypes.Head{Number: 29, Hash: canonical[29].Hash}
+		for cur, i := latest, 28; i >= 25; i-- {
+			parent := &evmtypes.Head{Number: int64(i), Hash: canonical[i].Hash}
+			parent.IsFinalized.Store(i == 25)
+			cur.Parent.Store(parent)
+			cur = parent
+		}
+		tracker := htmocks.NewHeadTracker[*evmtypes.Head, common.Hash](t)
+		tracker.On("LatestChain").Return(latest).Maybe()
+
+		client := evmclimocks.NewClient(t)
+		client.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			for _, req := range args.Get(1).([]rpc.BatchElem) {
+				n, err := hexutil.DecodeBig(req.Args[0].(string))
+				require.NoError(t, err)
+				h := served[n.Int64()]
+				*req.Result.(*evmtypes.Head) = evmtypes.Head{Number: h.Number, Hash: h.Hash, ParentHash: h.ParentHash}
+			}
+		}).Maybe()
+
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			historyDepth, batchSize := uint32(20), uint32(4)
+			c.HeadTracker.HistoryDepth = &historyDepth
+			c.HeadTracker.GapRepair.BatchSize = &batchSize
+			if overrideFn != nil {
+				overrideFn(&c.HeadTracker.GapRepair)
+			}
+		})
+		r := headtracker.NewGapRepairer(logger.Test(t), testutils.FixtureChainID, orm, client, tracker, cfg.EVM().HeadTracker())
+		servicetest.Run(t, r)
+		return r, orm, client
+	}
+
+	t.Run("refills gaps in batches", func(t *testing.T) {
+		r, orm, client := newRepairer(t, canonical, nil)
+
+		repaired, err := r.RepairGaps(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, 6, repaired)
+		assert.Equal(t, headNumbers(canonical[5:]), orm.numbers())
+		// blocks 11-14 and 9-10
+		client.AssertNumberOfCalls(t, "BatchCallContext", 2)
+
+		repaired, err = r.RepairGaps(tests.Context(t))
+		require.NoError(t, err)
+		assert.Zero(t, repaired)
+		client.AssertNumberOfCalls(t, "BatchCallContext", 2)
+	})
+
+	t.Run("does not persist heads which do not link to their child", func(t *testing.T) {
+		fork := slices.Clone(canonical)
+		fork[12] = testutils.Head(12)
+		r, orm, _ := newRepairer(t, fork, nil)
+
+		repaired, err := r.RepairGaps(tests.Context(t))
+		require.ErrorContains(t, err, "the chain may have re-orged")
+		// 14 and 13 link to the persisted chain, but the fork block 12 does not link to 13
+		assert.Equal(t, 2, repaired)
+		assert.NotContains(t, orm.numbers(), int64(12))
+	})
+
+	t.Run("keeps only a trusted checkpoint deeper than HistoryDepth", func(t *testing.T) {
+		r, orm, _ := newRepairer(t, canonical, func(c *toml.HeadTrackerGapRepair) {
+			number := int64(2)
+			c.TrustedCheckpointNumber = &number
+			c.TrustedCheckpointHash = &canonical[2].Hash
+		})
+
+		repaired, err := r.RepairGaps(tests.Context(t))
+		require.NoError(t, err)
+		// 9-14, while 3-4 below HistoryDepth are not refilled
+		assert.Equal(t, 6, repaired)
+		assert.Equal(t, headNumbers(slices.Concat(canonical[2:3], canonical[5:])), orm.numbers())
+		assert.NoError(t, r.HealthReport()[r.Name()])
+	})
+
+	t.Run("verifies a trusted checkpoint within HistoryDepth", func(t *testing.T) {
+		r, orm, _ := newRepairer(t, canonical, func(c *toml.HeadTrackerGapRepair) {
+			number := int64(10)
+			c.TrustedCheckpointNumber = &number
+			c.TrustedCheckpointHash = &canonical[10].Hash
+		})
+
+		repaired, err := r.RepairGaps(tests.Context(t))
+		require.NoError(t, err)
+		// the checkpoint 10 is persisted once verified, splitting the gap into 11-14 and 9
+		assert.Equal(t, 5, repaired)
+		assert.Equal(t, headNumbers(canonical[5:]), orm.numbers())
+		assert.NoError(t, r.HealthReport()[r.Name()])
+	})
+
+	t.Run("checks the trusted checkpoint on every run", func(t *testing.T) {
+		served := slices.Clone(canonical)
+		r, _, _ := newRepairer(t, served, func(c *toml.HeadTrackerGapRepair) {
+			number := int64(10)
+			c.TrustedCheckpointNumber = &number
+			c.TrustedCheckpointHash = &canonical[10].Hash
+		})
+
+		_, err := r.RepairGaps(tests.Context(t))
+		require.NoError(t, err)
+
+		// the RPC switches to another fork
+		served[10] = testutils.Head(10)
+		_, err = r.RepairGaps(tests.Context(t))
+		var mismatch headtracker.TrustedCheckpointMismatchError
+		require.True(t, errors.As(err, &mismatch))
+		assert.ErrorContains(t, r.HealthReport()[r.Name()], "TrustedCheckpoint")
+
+		served[10] = canonical[10]
+		_, err = r.RepairGaps(tests.Context(t))
+		require.NoError(t, err)
+		assert.NoError(t, r.HealthReport()[r.Name()])
+	})
+
+	t.Run("stops when the chain does not contain the trusted checkpoint", func(t *testing.T) {
+		r, orm, _ := newRepairer(t, canonical, func(c *toml.HeadTrackerGapRepair) {
+			number, hash := int64(10), utils.NewHash()
+			c.TrustedCheckpointNumber = &number
+			c.TrustedCheckpointHash = &hash
+		})
+
+		repaired, err := r.RepairGaps(tests.Context(t))
+		var mismatch headtracker.TrustedCheckpointMismatchError
+		require.True(t, errors.As(err, &mismatch))
+		assert.Equal(t, canonical[10].Hash, mismatch.Actual)
+		assert.Zero(t, repaired)
+		assert.Len(t, orm.numbers(), 4+15)
+		assert.ErrorContains(t, r.HealthReport()[r.Name()], "TrustedCheckpoint")
+	})
+}
+
+func TestCheckpointORM_TrimOldHeads(t *testing.T) {
+	t.Parallel()
+
+	newORM := func(t *testing.T, checkpoint *int64) *memoryHeadsORM {
+		orm := &memoryHeadsORM{}
+		heads := make([]*evmtypes.Head, 10)
+		for i := range heads {
+			heads[i] = testutils.Head(i)
+			require.NoError(t, orm.IdempotentInsertHead(tests.Context(t), heads[i]))
+		}
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.HeadTracker.GapRepair.TrustedCheckpointNumber = checkpoint
+			if checkpoint != nil {
+				c.HeadTracker.GapRepair.TrustedCheckpointHash = &heads[*checkpoint].Hash
+			}
+		})
+		require.NoError(t, headtracker.NewCheckpointORM(orm, cfg.EVM().HeadTracker()).TrimOldHeads(tests.Context(t), 6))
+		return orm
+	}
+
+	t.Run("keeps the trusted checkpoint head", func(t *testing.T) {
+		checkpoint := int64(3)
+		assert.Equal(t, []int64{3, 6, 7, 8, 9}, newORM(t, &checkpoint).numbers())
+	})
+
+	t.Run("trims as usual without a trusted checkpoint", func(t *testing.T) {
+		assert.Equal(t, []int64{6, 7, 8, 9}, newORM(t, nil).numbers())
+	})
+}
+
+func headNumbers(heads []*evmtypes.Head) (numbers []int64) {
+	for _, h := range heads {
+		numbers = append(numbers, h.Number)
+	}
+	return
+}
+
+// memoryHeadsORM is an in-memory headtracker.ORM
+type memoryHeadsORM struct {
+	mu    sync.Mutex
+	heads []*evmtypes.Head
+}
+
+var _ headtracker.ORM = (*memoryHeadsORM)(nil)
+
+func (o *memoryHeadsORM) IdempotentInsertHead(_ context.Context, head *evmtypes.Head) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !slices.ContainsFunc(o.heads, func(h *evmtypes.Head) bool { return h.Hash == head.Hash }) {
+		o.heads = append(o.heads, head)
+	}
+	return nil
+}
+
+func (o *memoryHeadsORM) TrimOldHeads(_ context.Context, minBlockNumber int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.heads = slices.DeleteFunc(o.heads, func(h *evmtypes.Head) bool { return h.Number < minBlockNumber })
+	return nil
+}
+
+func (o *memoryHeadsORM) TrimOldHeadsExcept(_ context.Context, minBlockNumber int64, keep common.Hash) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.heads = slices.DeleteFunc(o.heads, func(h *evmtypes.Head) bool { return h.Number < minBlockNumber && h.Hash != keep })
+	return nil
+}
+
+func (o *memoryHeadsORM) LatestHead(ctx context.Context) (*evmtypes.Head, error) {
+	heads, err := o.LatestHeads(ctx, 0)
+	if len(heads) == 0 {
+		return nil, err
+	}
+	return heads[0], err
+}
+
+func (o *memoryHeadsORM) LatestHeads(_ context.Context, minBlockNumber int64) (heads []*evmtypes.Head, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, h := range o.heads {
+		if h.Number >= minBlockNumber {
+			heads = append(heads, h)
+		}
+	}
+	slices.SortStableFunc(heads, func(a, b *evmtypes.Head) int { return int(b.Number - a.Number) })
+	return heads, nil
+}
+
+func (o *memoryHeadsORM) HeadByHash(_ context.Context, hash common.Hash) (*evmtypes.Head, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, h := range o.heads {
+		if h.Hash == hash {
+			return h, nil
+		}
+	}
+	return nil, nil
+}
+
+func (o *memoryHeadsORM) HeadGaps(ctx context.Context, minBlockNumber int64, limit int) (gaps []headtracker.HeadGap, err error) {
+	heads, _ := o.LatestHeads(ctx, minBlockNumber)
+	for i, h := range heads {
+		if h.Number == minBlockNumber || len(gaps) == limit {
+			break
+		}
+		if slices.ContainsFunc(heads, func(p *evmtypes.Head) bool { return p.Hash == h.ParentHash }) {
+			continue
+		}
+		gap := headtracker.HeadGap{Number: h.Number, ParentHash: h.ParentHash}
+		for _, lower := range heads[i+1:] {
+			if lower.Number < h.Number {
+				gap.LowerNumber = sql.NullInt64{Int64: lower.Number, Valid: true}
+				break
+			}
+		}
+		gaps = append(gaps, gap)
+	}
+	return gaps, nil
+}
+
+func (o *memoryHeadsORM) numbers() []int64 {
+	heads, _ := o.LatestHeads(context.Background(), 0)
+	numbers := headNumbers(heads)
+	slices.Sort(numbers)
+	return numbers
+}
@@ -20,12 +20,26 @@ type ORM interface {
 	IdempotentInsertHead(ctx context.Context, head *evmtypes.Head) error
 	// TrimOldHeads deletes heads such that only blocks >= minBlockNumber remain
 	TrimOldHeads(ctx context.Context, minBlockNumber int64) (err error)
+	// TrimOldHeadsExcept deletes heads such that only blocks >= minBlockNumber, and the head with hash keep, remain
+	TrimOldHeadsExcept(ctx context.Context, minBlockNumber int64, keep common.Hash) (err error)
 	// LatestHead returns the highest seen head
 	LatestHead(ctx context.Context) (head *evmtypes.Head, err error)
 	// LatestHeads returns the latest heads with blockNumbers >= minBlockNumber
 	LatestHeads(ctx context.Context, minBlockNumber int64) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// HeadGaps returns up to limit of the latest heads with blockNumbers > minBlockNumber whose parent is missing,
+	// ordered by number descending
+	HeadGaps(ctx context.Context, minBlockNumber int64, limit int) (gaps []HeadGap, err error)
+}
+
+// HeadGap is a persisted head whose parent is missing.
+type HeadGap struct {
+	Number     int64       `db:"number"`
+	ParentHash common.Hash `db:"parent_hash"`
+	// LowerNumber is the number of the highest head persisted below the gap, with a blockNumber >= the minBlockNumber
+	// the gaps were looked up with, if any
+	LowerNumber sql.NullInt64 `db:"lower_number"`
 }
 
 var _ ORM = &DbORM{}
@@ -59,6 +73,12 @@ func (orm *DbORM) TrimOldHeads(ctx context.Context, minBlockNumber int64) (err e
 	return err
 }
 
+func (orm *DbORM) TrimOldHeadsExcept(ctx context.Context, minBlockNumber int64, keep common.Hash) (err error) {
+	query := `DELETE FROM evm.heads WHERE evm_chain_id = $1 AND number < $2 AND hash <> $3`
+	_, err = orm.ds.ExecContext(ctx, query, orm.chainID, minBlockNumber, keep)
+	return err
+}
+
 func (orm *DbORM) LatestHead(ctx context.Context) (head *evmtypes.Head, err error) {
 	head = new(evmtypes.Head)
 	err = orm.ds.GetContext(ctx, head, `SELECT * FROM evm.heads WHERE evm_chain_id = $1 ORDER BY number DESC, created_at DESC, id DESC LIMIT 1`, orm.chainID)
@@ -84,6 +104,20 @@ func (orm *DbORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmty
 	return head, err
 }
 
+func (orm *DbORM) HeadGaps(ctx context.Context, minBlockNumber int64, limit int) (gaps []HeadGap, err error) {
+	query := `SELECT h.number, h.parent_hash, (
+		SELECT max(l.number) FROM evm.heads l WHERE l.evm_chain_id = h.evm_chain_id AND l.number < h.number AND l.number >= $2
+	) AS lower_number
+	FROM evm.heads h
+	WHERE h.evm_chain_id = $1 AND h.number > $2
+	AND NOT EXISTS (SELECT 1 FROM evm.heads p WHERE p.evm_chain_id = h.evm_chain_id AND p.hash = h.parent_hash)
+	ORDER BY h.number DESC, h.created_at DESC, h.id DESC
+	LIMIT $3`
+	err = orm.ds.SelectContext(ctx, &gaps, query, orm.chainID, minBlockNumber, limit)
+	err = pkgerrors.Wrap(err, "HeadGaps failed")
+	return
+}
+
 type nullORM struct{}
 
 func NewNullORM() ORM {
@@ -98,6 +132,10 @@ func (orm *nullORM) TrimOldHeads(ctx context.Context, minBlockNumber int64) (err
 	return nil
 }
 
+func (orm *nullORM) TrimOldHeadsExcept(ctx context.Context, minBlockNumber int64, keep common.Hash) (err error) {
+	return nil
+}
+
 func (orm *nullORM) LatestHead(ctx context.Context) (head *evmtypes.Head, err error) {
 	return nil, nil
 }
@@ -109,3 +147,7 @@ func (orm *nullORM) LatestHeads(ctx context.Context, minBlockNumer int64) (heads
 func (orm *nullORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error) {
 	return nil, nil
 }
+
+func (orm *nullORM) HeadGaps(ctx context.Context, minBlockNumber int64, limit int) (gaps []HeadGap, err error) {
+	return nil, nil
+}
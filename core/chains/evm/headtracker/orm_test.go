@@ -1,6 +1,7 @@
 package headtracker_test
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 )
 
@@ -65,6 +67,62 @@ func TestORM_TrimOldHeads(t *testing.T) {
 	}
 }
 
+func TestORM_TrimOldHeadsExcept(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
+
+	var keep common.Hash
+	for i := 0; i < 10; i++ {
+		head := testutils.Head(i)
+		if i == 2 {
+			keep = head.Hash
+		}
+		require.NoError(t, orm.IdempotentInsertHead(tests.Context(t), head))
+	}
+
+	require.NoError(t, orm.TrimOldHeadsExcept(tests.Context(t), 5, keep))
+
+	heads, err := orm.LatestHeads(tests.Context(t), 0)
+	require.NoError(t, err)
+	require.Len(t, heads, 6)
+	assert.Equal(t, keep, heads[5].Hash)
+}
+
+func TestORM_HeadGaps(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
+
+	// blocks 0-3 and 7-9 are persisted, with a gap between 3 and 7
+	heads := make([]*evmtypes.Head, 10)
+	for i := range heads {
+		heads[i] = testutils.Head(i)
+		if i > 0 {
+			heads[i].ParentHash = heads[i-1].Hash
+		}
+		if i < 4 || i > 6 {
+			require.NoError(t, orm.IdempotentInsertHead(tests.Context(t), heads[i]))
+		}
+	}
+
+	gaps, err := orm.HeadGaps(tests.Context(t), 1, 10)
+	require.NoError(t, err)
+	require.Len(t, gaps, 1)
+	assert.Equal(t, int64(7), gaps[0].Number)
+	assert.Equal(t, heads[6].Hash, gaps[0].ParentHash)
+	assert.Equal(t, sql.NullInt64{Int64: 3, Valid: true}, gaps[0].LowerNumber)
+
+	// heads below minBlockNumber are not looked up
+	gaps, err = orm.HeadGaps(tests.Context(t), 4, 10)
+	require.NoError(t, err)
+	require.Len(t, gaps, 1)
+	assert.Equal(t, int64(7), gaps[0].Number)
+	assert.False(t, gaps[0].LowerNumber.Valid)
+}
+
 func TestORM_HeadByHash(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,7 @@ package logpoller
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -42,6 +43,10 @@ func (disabled) RegisterFilter(ctx context.Context, filter Filter) error { retur
 
 func (disabled) UnregisterFilter(ctx context.Context, name string) error { return ErrDisabled }
 
+func (disabled) Subscribe(ctx context.Context, filter Filter, confs evmtypes.Confirmations) (*Subscription, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) HasFilter(name string) bool { return false }
 
 func (disabled) GetFilters() map[string]Filter { return nil }
@@ -129,3 +134,11 @@ func (d disabled) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
 func (d disabled) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	return ErrDisabled
 }
+
+func (d disabled) ExportSnapshot(ctx context.Context, w io.Writer, from, to int64) (*SnapshotInfo, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) ImportSnapshot(ctx context.Context, r io.ReadSeeker, importFilters bool) (*SnapshotInfo, error) {
+	return nil, ErrDisabled
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"sort"
@@ -42,12 +43,15 @@ type LogPoller interface {
 	ReplayAsync(fromBlock int64)
 	RegisterFilter(ctx context.Context, filter Filter) error
 	UnregisterFilter(ctx context.Context, name string) error
+	Subscribe(ctx context.Context, filter Filter, confs evmtypes.Confirmations) (*Subscription, error)
 	HasFilter(name string) bool
 	GetFilters() map[string]Filter
 	LatestBlock(ctx context.Context) (LogPollerBlock, error)
 	GetBlocksRange(ctx context.Context, numbers []uint64) ([]LogPollerBlock, error)
 	FindLCA(ctx context.Context) (*LogPollerBlock, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
+	ExportSnapshot(ctx context.Context, w io.Writer, from, to int64) (*SnapshotInfo, error)
+	ImportSnapshot(ctx context.Context, r io.ReadSeeker, importFilters bool) (*SnapshotInfo, error)
 
 	// General querying
 	Logs(ctx context.Context, start, end int64, eventSig common.Hash, address common.Address) ([]Log, error)
@@ -125,6 +129,12 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subsMu sync.Mutex
+	subs   map[string]*Subscription
+	// deliverMu serializes the persisting of subscription cursors by delivery with their deletion by unsubscribe, so
+	// that subsMu is not held during the DB queries of delivery.
+	deliverMu sync.Mutex
+
 	replayStart    chan int64
 	replayComplete chan error
 	stopCh         services.StopChan
@@ -180,6 +190,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		clientErrors:             opts.ClientErrors,
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
+		subs:                     make(map[string]*Subscription),
 	}
 }
 
@@ -318,6 +329,9 @@ func (lp *logPoller) UnregisterFilter(ctx context.Context, name string) error {
 	if err := lp.orm.DeleteFilter(ctx, name); err != nil {
 		return pkgerrors.Wrap(err, "error deleting filter")
 	}
+	if err := lp.unsubscribe(ctx, name); err != nil {
+		return pkgerrors.Wrap(err, "error deleting subscription cursor")
+	}
 	delete(lp.filters, name)
 	lp.filterDirty = true
 	return nil
@@ -519,6 +533,7 @@ func (lp *logPoller) Close() error {
 		}
 		close(lp.stopCh)
 		lp.wg.Wait()
+		lp.closeSubscriptions()
 		return nil
 	})
 }
@@ -976,12 +991,19 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 		// the canonical set per read. Typically, if an application took action on a log
 		// it would be saved elsewhere e.g. evm.txes, so it seems better to just support the fast reads.
 		// Its also nicely analogous to reading from the chain itself.
+		// Subscribers which already received the removed logs are notified instead.
+		removals, err2 := lp.subscriptionRemovals(ctx, blockAfterLCA.Number)
+		if err2 != nil {
+			lp.lggr.Warnw("Unable to find logs delivered to subscriptions after LCA, retrying", "err", err2)
+			return nil, err2
+		}
 		err2 = lp.orm.DeleteLogsAndBlocksAfter(ctx, blockAfterLCA.Number)
 		if err2 != nil {
 			// If we error on db commit, we can't know if the tx went through or not.
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.rewindSubscriptions(blockAfterLCA.Number, removals)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -993,6 +1015,8 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 // conditions this would be equal to lastProcessed.BlockNumber + 1.
 func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int64) {
 	lp.lggr.Debugw("Polling for logs", "currentBlockNumber", currentBlockNumber)
+	// Deliver whatever was saved, even if polling stops early.
+	defer lp.deliverSubscriptions(ctx)
 	// Intentionally not using logPoller.finalityDepth directly but the latestFinalizedBlockNumber returned from lp.latestBlocks()
 	// latestBlocks knows how to pick a proper latestFinalizedBlockNumber based on the logPoller's configuration
 	latestBlock, latestFinalizedBlockNumber, err := lp.latestBlocks(ctx)
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	io "io"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	logpoller "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
@@ -123,6 +125,67 @@ func (_c *LogPoller_DeleteLogsAndBlocksAfter_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ExportSnapshot provides a mock function with given fields: ctx, w, from, to
+func (_m *LogPoller) ExportSnapshot(ctx context.Context, w io.Writer, from int64, to int64) (*logpoller.SnapshotInfo, error) {
+	ret := _m.Called(ctx, w, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSnapshot")
+	}
+
+	var r0 *logpoller.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, int64, int64) (*logpoller.SnapshotInfo, error)); ok {
+		return rf(ctx, w, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, int64, int64) *logpoller.SnapshotInfo); ok {
+		r0 = rf(ctx, w, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.SnapshotInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Writer, int64, int64) error); ok {
+		r1 = rf(ctx, w, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_ExportSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSnapshot'
+type LogPoller_ExportSnapshot_Call struct {
+	*mock.Call
+}
+
+// ExportSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - w io.Writer
+//   - from int64
+//   - to int64
+func (_e *LogPoller_Expecter) ExportSnapshot(ctx interface{}, w interface{}, from interface{}, to interface{}) *LogPoller_ExportSnapshot_Call {
+	return &LogPoller_ExportSnapshot_Call{Call: _e.mock.On("ExportSnapshot", ctx, w, from, to)}
+}
+
+func (_c *LogPoller_ExportSnapshot_Call) Run(run func(ctx context.Context, w io.Writer, from int64, to int64)) *LogPoller_ExportSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Writer), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ExportSnapshot_Call) Return(_a0 *logpoller.SnapshotInfo, _a1 error) *LogPoller_ExportSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_ExportSnapshot_Call) RunAndReturn(run func(context.Context, io.Writer, int64, int64) (*logpoller.SnapshotInfo, error)) *LogPoller_ExportSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FilteredLogs provides a mock function with given fields: ctx, filter, limitAndSort, queryName
 func (_m *LogPoller) FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, filter, limitAndSort, queryName)
@@ -486,6 +549,66 @@ func (_c *LogPoller_Healthy_Call) RunAndReturn(run func() error) *LogPoller_Heal
 	return _c
 }
 
+// ImportSnapshot provides a mock function with given fields: ctx, r, importFilters
+func (_m *LogPoller) ImportSnapshot(ctx context.Context, r io.ReadSeeker, importFilters bool) (*logpoller.SnapshotInfo, error) {
+	ret := _m.Called(ctx, r, importFilters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSnapshot")
+	}
+
+	var r0 *logpoller.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.ReadSeeker, bool) (*logpoller.SnapshotInfo, error)); ok {
+		return rf(ctx, r, importFilters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.ReadSeeker, bool) *logpoller.SnapshotInfo); ok {
+		r0 = rf(ctx, r, importFilters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.SnapshotInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.ReadSeeker, bool) error); ok {
+		r1 = rf(ctx, r, importFilters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_ImportSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSnapshot'
+type LogPoller_ImportSnapshot_Call struct {
+	*mock.Call
+}
+
+// ImportSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.ReadSeeker
+//   - importFilters bool
+func (_e *LogPoller_Expecter) ImportSnapshot(ctx interface{}, r interface{}, importFilters interface{}) *LogPoller_ImportSnapshot_Call {
+	return &LogPoller_ImportSnapshot_Call{Call: _e.mock.On("ImportSnapshot", ctx, r, importFilters)}
+}
+
+func (_c *LogPoller_ImportSnapshot_Call) Run(run func(ctx context.Context, r io.ReadSeeker, importFilters bool)) *LogPoller_ImportSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.ReadSeeker), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ImportSnapshot_Call) Return(_a0 *logpoller.SnapshotInfo, _a1 error) *LogPoller_ImportSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_ImportSnapshot_Call) RunAndReturn(run func(context.Context, io.ReadSeeker, bool) (*logpoller.SnapshotInfo, error)) *LogPoller_ImportSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexedLogs provides a mock function with given fields: ctx, eventSig, address, topicIndex, topicValues, confs
 func (_m *LogPoller) IndexedLogs(ctx context.Context, eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs types.Confirmations) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, eventSig, address, topicIndex, topicValues, confs)
@@ -1809,6 +1932,66 @@ func (_c *LogPoller_Start_Call) RunAndReturn(run func(context.Context) error) *L
 	return _c
 }
 
+// Subscribe provides a mock function with given fields: ctx, filter, confs
+func (_m *LogPoller) Subscribe(ctx context.Context, filter logpoller.Filter, confs types.Confirmations) (*logpoller.Subscription, error) {
+	ret := _m.Called(ctx, filter, confs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *logpoller.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, logpoller.Filter, types.Confirmations) (*logpoller.Subscription, error)); ok {
+		return rf(ctx, filter, confs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, logpoller.Filter, types.Confirmations) *logpoller.Subscription); ok {
+		r0 = rf(ctx, filter, confs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, logpoller.Filter, types.Confirmations) error); ok {
+		r1 = rf(ctx, filter, confs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type LogPoller_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter logpoller.Filter
+//   - confs types.Confirmations
+func (_e *LogPoller_Expecter) Subscribe(ctx interface{}, filter interface{}, confs interface{}) *LogPoller_Subscribe_Call {
+	return &LogPoller_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, filter, confs)}
+}
+
+func (_c *LogPoller_Subscribe_Call) Run(run func(ctx context.Context, filter logpoller.Filter, confs types.Confirmations)) *LogPoller_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(logpoller.Filter), args[2].(types.Confirmations))
+	})
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) Return(_a0 *logpoller.Subscription, _a1 error) *LogPoller_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) RunAndReturn(run func(context.Context, logpoller.Filter, types.Confirmations) (*logpoller.Subscription, error)) *LogPoller_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterFilter provides a mock function with given fields: ctx, name
 func (_m *LogPoller) UnregisterFilter(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
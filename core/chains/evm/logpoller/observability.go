@@ -190,6 +190,12 @@ func (o *ObservedORM) SelectLogsWithSigs(ctx context.Context, start, end int64,
 	})
 }
 
+func (o *ObservedORM) SelectLogsWithSigsAddrs(ctx context.Context, start, end int64, addresses []common.Address, eventSigs []common.Hash) ([]Log, error) {
+	return withObservedQueryAndResults(o, "SelectLogsWithSigsAddrs", func() ([]Log, error) {
+		return o.ORM.SelectLogsWithSigsAddrs(ctx, start, end, addresses, eventSigs)
+	})
+}
+
 func (o *ObservedORM) SelectLogsCreatedAfter(ctx context.Context, address common.Address, eventSig common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error) {
 	return withObservedQueryAndResults(o, "SelectLogsCreatedAfter", func() ([]Log, error) {
 		return o.ORM.SelectLogsCreatedAfter(ctx, address, eventSig, after, confs)
@@ -286,6 +292,24 @@ func (o *ObservedORM) FilteredLogs(ctx context.Context, filter []query.Expressio
 	})
 }
 
+func (o *ObservedORM) SelectSubscriptionCursor(ctx context.Context, name string) (int64, error) {
+	return withObservedQuery(o, "SelectSubscriptionCursor", func() (int64, error) {
+		return o.ORM.SelectSubscriptionCursor(ctx, name)
+	})
+}
+
+func (o *ObservedORM) UpsertSubscriptionCursor(ctx context.Context, name string, blockNumber int64) error {
+	return withObservedExec(o, "UpsertSubscriptionCursor", create, func() error {
+		return o.ORM.UpsertSubscriptionCursor(ctx, name, blockNumber)
+	})
+}
+
+func (o *ObservedORM) DeleteSubscriptionCursor(ctx context.Context, name string) error {
+	return withObservedExec(o, "DeleteSubscriptionCursor", del, func() error {
+		return o.ORM.DeleteSubscriptionCursor(ctx, name)
+	})
+}
+
 func withObservedQueryAndResults[T any](o *ObservedORM, queryName string, query func() ([]T, error)) ([]T, error) {
 	results, err := withObservedQuery(o, queryName, query)
 	if err == nil {
@@ -50,6 +50,7 @@ type ORM interface {
 
 	SelectLogs(ctx context.Context, start, end int64, address common.Address, eventSig common.Hash) ([]Log, error)
 	SelectLogsWithSigs(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash) ([]Log, error)
+	SelectLogsWithSigsAddrs(ctx context.Context, start, end int64, addresses []common.Address, eventSigs []common.Hash) ([]Log, error)
 	SelectLogsCreatedAfter(ctx context.Context, address common.Address, eventSig common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error)
 	SelectLatestLogByEventSigWithConfs(ctx context.Context, eventSig common.Hash, address common.Address, confs evmtypes.Confirmations) (*Log, error)
 	SelectLatestLogEventSigsAddrsWithConfs(ctx context.Context, fromBlock int64, addresses []common.Address, eventSigs []common.Hash, confs evmtypes.Confirmations) ([]Log, error)
@@ -69,6 +70,11 @@ type ORM interface {
 
 	// FilteredLogs accepts chainlink-common filtering DSL.
 	FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]Log, error)
+
+	// Subscription cursors
+	SelectSubscriptionCursor(ctx context.Context, name string) (int64, error)
+	UpsertSubscriptionCursor(ctx context.Context, name string, blockNumber int64) error
+	DeleteSubscriptionCursor(ctx context.Context, name string) error
 }
 
 type DSORM struct {
@@ -405,10 +411,48 @@ func (o *DSORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
 			o.lggr.Warnw("Unable to clear reorged logs, retrying", "err", err)
 			return err
 		}
+
+		// Subscribers must receive the logs of the new chain, so their cursors are rewound to before the deleted blocks.
+		_, err = o.ds.ExecContext(ctx, `UPDATE evm.log_poller_subscriptions
+							SET block_number = $2 - 1, updated_at = NOW()
+							WHERE evm_chain_id = $1
+							AND block_number >= $2`,
+			ubig.New(o.chainID), start)
+		if err != nil {
+			o.lggr.Warnw("Unable to rewind subscription cursors, retrying", "err", err)
+			return err
+		}
 		return nil
 	})
 }
 
+// SelectSubscriptionCursor returns the last block delivered to the named subscription, or sql.ErrNoRows if it has
+// no cursor.
+func (o *DSORM) SelectSubscriptionCursor(ctx context.Context, name string) (int64, error) {
+	var blockNumber int64
+	err := o.ds.GetContext(ctx, &blockNumber,
+		`SELECT block_number FROM evm.log_poller_subscriptions WHERE evm_chain_id = $1 AND name = $2`,
+		ubig.New(o.chainID), name)
+	return blockNumber, err
+}
+
+// UpsertSubscriptionCursor records the last block delivered to the named subscription.
+func (o *DSORM) UpsertSubscriptionCursor(ctx context.Context, name string, blockNumber int64) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO evm.log_poller_subscriptions (evm_chain_id, name, block_number, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (evm_chain_id, name) DO UPDATE SET block_number = EXCLUDED.block_number, updated_at = NOW()`,
+		ubig.New(o.chainID), name, blockNumber)
+	return err
+}
+
+// DeleteSubscriptionCursor removes the cursor of the named subscription.
+func (o *DSORM) DeleteSubscriptionCursor(ctx context.Context, name string) error {
+	_, err := o.ds.ExecContext(ctx,
+		`DELETE FROM evm.log_poller_subscriptions WHERE evm_chain_id = $1 AND name = $2`,
+		ubig.New(o.chainID), name)
+	return err
+}
+
 type Exp struct {
 	Address      common.Address
 	EventSig     common.Hash
@@ -709,6 +753,38 @@ func (o *DSORM) SelectLogsWithSigs(ctx context.Context, start, end int64, addres
 	return logs, err
 }
 
+// SelectLogsWithSigsAddrs finds the logs in the given block range with any of the given event signatures, emitted
+// from any of the given addresses.
+func (o *DSORM) SelectLogsWithSigsAddrs(ctx context.Context, start, end int64, addresses []common.Address, eventSigs []common.Hash) (logs []Log, err error) {
+	args, err := newQueryArgs(o.chainID).
+		withAddressArray(addresses).
+		withEventSigArray(eventSigs).
+		withStartBlock(start).
+		withEndBlock(end).
+		toArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	query := logsQuery(`
+		WHERE evm_chain_id = :evm_chain_id
+		AND address = ANY(:address_array)
+		AND event_sig = ANY(:event_sig_array)
+		AND block_number BETWEEN :start_block AND :end_block
+		ORDER BY block_number, log_index`)
+
+	query, sqlArgs, err := o.ds.BindNamed(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.ds.SelectContext(ctx, &logs, query, sqlArgs...)
+	if pkgerrors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return logs, err
+}
+
 func (o *DSORM) GetBlocksRange(ctx context.Context, start int64, end int64) ([]LogPollerBlock, error) {
 	args, err := newQueryArgs(o.chainID).
 		withStartBlock(start).
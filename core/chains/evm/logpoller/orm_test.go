@@ -1312,6 +1312,45 @@ func TestORM_SelectLogsWithSigsByBlockRangeFilter(t *testing.T) {
 	assertion(t, logs, err, startBlock, endBlock)
 }
 
+func TestORM_SelectLogsWithSigsAddrs(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	ctx := testutils.Context(t)
+
+	sigA, sigB := common.HexToHash("0x1599"), common.HexToHash("0x1600")
+	addrA, addrB := common.HexToAddress("0x12345"), common.HexToAddress("0x23456")
+	newLog := func(idx int64, block int64, addr common.Address, sig common.Hash) logpoller.Log {
+		return logpoller.Log{
+			EvmChainId:  ubig.New(th.ChainID),
+			LogIndex:    idx,
+			BlockHash:   common.HexToHash("0x1234"),
+			BlockNumber: block,
+			EventSig:    sig,
+			Topics:      [][]byte{sig[:]},
+			Address:     addr,
+			TxHash:      common.HexToHash("0x1888"),
+			Data:        []byte("hello"),
+		}
+	}
+	require.NoError(t, th.ORM.InsertLogs(ctx, []logpoller.Log{
+		newLog(1, 10, addrA, sigA),
+		newLog(2, 10, addrB, sigB),
+		newLog(3, 11, addrB, sigA),
+		newLog(4, 12, addrA, sigB),
+		newLog(5, 20, addrA, sigA),
+	}))
+
+	logs, err := th.ORM.SelectLogsWithSigsAddrs(ctx, 10, 15, []common.Address{addrA, addrB}, []common.Hash{sigA})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, int64(1), logs[0].LogIndex)
+	assert.Equal(t, int64(3), logs[1].LogIndex)
+
+	logs, err = th.ORM.SelectLogsWithSigsAddrs(ctx, 10, 20, []common.Address{addrA}, []common.Hash{sigA, sigB})
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	assert.Equal(t, []int64{1, 4, 5}, []int64{logs[0].LogIndex, logs[1].LogIndex, logs[2].LogIndex})
+}
+
 type mockQueryExecutor struct {
 	mock.Mock
 }
@@ -0,0 +1,628 @@
+package logpoller
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lib/pq"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// SnapshotVersion is the version of the snapshot format written by ExportSnapshot.
+//
+// A snapshot is a gzip compressed stream of JSON lines: a header, followed by the filters, blocks and logs of the
+// exported range, and a trailer holding the SHA-256 checksum of all the preceding lines.
+const SnapshotVersion = 1
+
+const (
+	// snapshotBatchSize is the number of blocks exported, and records imported, at once.
+	snapshotBatchSize = 1000
+	// snapshotMaxLineSize bounds the size of a single record, to guard against corrupted snapshots.
+	snapshotMaxLineSize = 16 * 1024 * 1024
+)
+
+var (
+	ErrSnapshotChecksum = errors.New("snapshot checksum mismatch")
+	ErrSnapshotVersion  = errors.New("unsupported snapshot version")
+
+	errSnapshotChanged = errors.New("snapshot changed while importing")
+)
+
+// SnapshotInfo describes the contents of a snapshot.
+type SnapshotInfo struct {
+	Version    int       `json:"version"`
+	EVMChainID *ubig.Big `json:"evmChainID"`
+	FromBlock  int64     `json:"fromBlock"`
+	ToBlock    int64     `json:"toBlock"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Filters    int       `json:"filters"`
+	Blocks     int       `json:"blocks"`
+	Logs       int       `json:"logs"`
+}
+
+// snapshotRecord is a line of a snapshot. Exactly one field is set.
+type snapshotRecord struct {
+	Header   *SnapshotInfo   `json:"h,omitempty"`
+	Filter   *snapshotFilter `json:"f,omitempty"`
+	Block    *snapshotBlock  `json:"b,omitempty"`
+	Log      *snapshotLog    `json:"l,omitempty"`
+	Checksum string          `json:"c,omitempty"`
+}
+
+type snapshotFilter struct {
+	Name         string           `json:"n"`
+	Addresses    []common.Address `json:"a"`
+	EventSigs    []common.Hash    `json:"e"`
+	Topic2       []common.Hash    `json:"t2,omitempty"`
+	Topic3       []common.Hash    `json:"t3,omitempty"`
+	Topic4       []common.Hash    `json:"t4,omitempty"`
+	Retention    time.Duration    `json:"r,omitempty"`
+	MaxLogsKept  uint64           `json:"m,omitempty"`
+	LogsPerBlock uint64           `json:"p,omitempty"`
+}
+
+type snapshotBlock struct {
+	Hash      common.Hash `json:"h"`
+	Number    int64       `json:"n"`
+	Timestamp int64       `json:"t"`
+	Finalized int64       `json:"f"`
+}
+
+type snapshotLog struct {
+	BlockHash   common.Hash     `json:"bh"`
+	BlockNumber int64           `json:"bn"`
+	Timestamp   int64           `json:"t"`
+	LogIndex    int64           `json:"i"`
+	Address     common.Address  `json:"a"`
+	EventSig    common.Hash     `json:"e"`
+	Topics      []hexutil.Bytes `json:"tp"`
+	TxHash      common.Hash     `json:"tx"`
+	Data        hexutil.Bytes   `json:"d"`
+}
+
+func newSnapshotFilter(f Filter) *snapshotFilter {
+	return &snapshotFilter{
+		Name:         f.Name,
+		Addresses:    f.Addresses,
+		EventSigs:    f.EventSigs,
+		Topic2:       f.Topic2,
+		Topic3:       f.Topic3,
+		Topic4:       f.Topic4,
+		Retention:    f.Retention,
+		MaxLogsKept:  f.MaxLogsKept,
+		LogsPerBlock: f.LogsPerBlock,
+	}
+}
+
+func (f *snapshotFilter) filter() Filter {
+	return Filter{
+		Name:         f.Name,
+		Addresses:    f.Addresses,
+		EventSigs:    f.EventSigs,
+		Topic2:       f.Topic2,
+		Topic3:       f.Topic3,
+		Topic4:       f.Topic4,
+		Retention:    f.Retention,
+		MaxLogsKept:  f.MaxLogsKept,
+		LogsPerBlock: f.LogsPerBlock,
+	}
+}
+
+func newSnapshotLog(l Log) *snapshotLog {
+	topics := make([]hexutil.Bytes, len(l.Topics))
+	for i, t := range l.Topics {
+		topics[i] = t
+	}
+	return &snapshotLog{
+		BlockHash:   l.BlockHash,
+		BlockNumber: l.BlockNumber,
+		Timestamp:   l.BlockTimestamp.Unix(),
+		LogIndex:    l.LogIndex,
+		Address:     l.Address,
+		EventSig:    l.EventSig,
+		Topics:      topics,
+		TxHash:      l.TxHash,
+		Data:        l.Data,
+	}
+}
+
+func (l *snapshotLog) log(chainID *big.Int) Log {
+	topics := make(pq.ByteaArray, len(l.Topics))
+	for i, t := range l.Topics {
+		topics[i] = t
+	}
+	return Log{
+		EvmChainId:     ubig.New(chainID),
+		LogIndex:       l.LogIndex,
+		BlockHash:      l.BlockHash,
+		BlockNumber:    l.BlockNumber,
+		BlockTimestamp: time.Unix(l.Timestamp, 0).UTC(),
+		Topics:         topics,
+		EventSig:       l.EventSig,
+		Address:        l.Address,
+		TxHash:         l.TxHash,
+		Data:           l.Data,
+	}
+}
+
+// snapshotWriter writes the records of a snapshot, keeping track of their checksum.
+type snapshotWriter struct {
+	gz  *gzip.Writer
+	sum hash.Hash
+	enc *json.Encoder
+}
+
+func newSnapshotWriter(w io.Writer) *snapshotWriter {
+	gz := gzip.NewWriter(w)
+	sum := sha256.New()
+	return &snapshotWriter{gz: gz, sum: sum, enc: json.NewEncoder(io.MultiWriter(gz, sum))}
+}
+
+func (w *snapshotWriter) write(r snapshotRecord) error {
+	return w.enc.Encode(r)
+}
+
+// close writes the trailer and flushes the snapshot.
+func (w *snapshotWriter) close() error {
+	if err := json.NewEncoder(w.gz).Encode(snapshotRecord{Checksum: hex.EncodeToString(w.sum.Sum(nil))}); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+// readSnapshot reads the snapshot from r, calling fn for each record following the header, and verifies its
+// checksum once all records have been read. The records should not be trusted before readSnapshot returns.
+func readSnapshot(r io.Reader, fn func(snapshotRecord) error) (*SnapshotInfo, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 0, 64*1024), snapshotMaxLineSize)
+	sum := sha256.New()
+	var header *SnapshotInfo
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var rec snapshotRecord
+		if err = json.Unmarshal(line, &rec); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot record: %w", err)
+		}
+		if rec.Checksum != "" {
+			if rec.Checksum != hex.EncodeToString(sum.Sum(nil)) {
+				return nil, ErrSnapshotChecksum
+			}
+			if header == nil {
+				return nil, errors.New("snapshot has no header")
+			}
+			if scanner.Scan() {
+				return nil, errors.New("unexpected data after snapshot checksum")
+			}
+			return header, scanner.Err()
+		}
+		sum.Write(line)
+		sum.Write([]byte{'\n'})
+
+		if header == nil {
+			if header, err = checkSnapshotHeader(rec); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err = fn(rec); err != nil {
+			return nil, err
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	return nil, errors.New("snapshot is truncated: missing checksum")
+}
+
+// readSnapshotHeader reads the header of the snapshot from r, without verifying its checksum.
+func readSnapshotHeader(r io.Reader) (*SnapshotInfo, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 0, 64*1024), snapshotMaxLineSize)
+	if !scanner.Scan() {
+		if err = scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		return nil, errors.New("snapshot has no header")
+	}
+	var rec snapshotRecord
+	if err = json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot record: %w", err)
+	}
+	return checkSnapshotHeader(rec)
+}
+
+func checkSnapshotHeader(rec snapshotRecord) (*SnapshotInfo, error) {
+	if rec.Header == nil {
+		return nil, errors.New("snapshot has no header")
+	}
+	if rec.Header.Version != SnapshotVersion {
+		return nil, fmt.Errorf("%w: %d", ErrSnapshotVersion, rec.Header.Version)
+	}
+	return rec.Header, nil
+}
+
+// snapshotDigest computes the SHA-256 digest of each batch of snapshotBatchSize records, so that the records read
+// again from a snapshot can be checked batch by batch, without holding them in memory.
+type snapshotDigest struct {
+	sum hash.Hash
+	n   int
+}
+
+// add adds rec to the current batch, and returns the digest of the batch once it is complete.
+func (d *snapshotDigest) add(rec snapshotRecord) ([]byte, error) {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode snapshot record: %w", err)
+	}
+	if d.sum == nil {
+		d.sum = sha256.New()
+	}
+	d.sum.Write(b)
+	if d.n++; d.n < snapshotBatchSize {
+		return nil, nil
+	}
+	return d.flush(), nil
+}
+
+// flush returns the digest of the current batch, if any, and starts a new batch.
+func (d *snapshotDigest) flush() []byte {
+	if d.n == 0 {
+		return nil
+	}
+	sum := d.sum.Sum(nil)
+	d.sum.Reset()
+	d.n = 0
+	return sum
+}
+
+// ExportSnapshot writes the filters, and the blocks and logs saved in the block range [from, to] to w. The range must
+// be finalized. If to is 0, the latest finalized block is used.
+func (lp *logPoller) ExportSnapshot(ctx context.Context, w io.Writer, from, to int64) (*SnapshotInfo, error) {
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("no blocks saved")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load latest block: %w", err)
+	}
+	if to == 0 {
+		to = latest.FinalizedBlockNumber
+	}
+	if from < 0 || from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if to > latest.FinalizedBlockNumber {
+		return nil, fmt.Errorf("block %d is not finalized, the latest finalized block is %d", to, latest.FinalizedBlockNumber)
+	}
+	// The last block marks the range as synced on import, fetch it if it was not saved.
+	var lastBlock *snapshotBlock
+	if _, err = lp.orm.SelectBlockByNumber(ctx, to); errors.Is(err, sql.ErrNoRows) {
+		heads, err2 := lp.batchFetchBlocks(ctx, []string{hexutil.EncodeBig(big.NewInt(to))}, 1)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to fetch block %d: %w", to, err2)
+		}
+		lastBlock = &snapshotBlock{Hash: heads[0].Hash, Number: to, Timestamp: heads[0].Timestamp.Unix(), Finalized: to}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load block %d: %w", to, err)
+	}
+	filters, err := lp.orm.LoadFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load filters: %w", err)
+	}
+
+	info := &SnapshotInfo{
+		Version:    SnapshotVersion,
+		EVMChainID: ubig.New(lp.ec.ConfiguredChainID()),
+		FromBlock:  from,
+		ToBlock:    to,
+		CreatedAt:  time.Now().UTC(),
+	}
+	sw := newSnapshotWriter(w)
+	if err = sw.write(snapshotRecord{Header: info}); err != nil {
+		return nil, err
+	}
+	for _, f := range filters {
+		if err = sw.write(snapshotRecord{Filter: newSnapshotFilter(f)}); err != nil {
+			return nil, err
+		}
+		info.Filters++
+	}
+	for start := from; start <= to; start += snapshotBatchSize {
+		end := min(start+snapshotBatchSize-1, to)
+		blocks, err := lp.orm.GetBlocksRange(ctx, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load blocks [%d, %d]: %w", start, end, err)
+		}
+		for _, b := range blocks {
+			if err = sw.write(snapshotRecord{Block: &snapshotBlock{Hash: b.BlockHash, Number: b.BlockNumber, Timestamp: b.BlockTimestamp.Unix(), Finalized: b.FinalizedBlockNumber}}); err != nil {
+				return nil, err
+			}
+		}
+		logs, err := lp.orm.SelectLogsByBlockRange(ctx, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load logs [%d, %d]: %w", start, end, err)
+		}
+		for _, l := range logs {
+			if err = sw.write(snapshotRecord{Log: newSnapshotLog(l)}); err != nil {
+				return nil, err
+			}
+		}
+		info.Blocks += len(blocks)
+		info.Logs += len(logs)
+	}
+	if lastBlock != nil {
+		if err = sw.write(snapshotRecord{Block: lastBlock}); err != nil {
+			return nil, err
+		}
+		info.Blocks++
+	}
+	if err = sw.close(); err != nil {
+		return nil, err
+	}
+	lp.lggr.Infow("Exported snapshot", "fromBlock", from, "toBlock", to, "filters", info.Filters, "blocks", info.Blocks, "logs", info.Logs)
+	return info, nil
+}
+
+// ImportSnapshot imports a snapshot written by ExportSnapshot. The snapshot is read three times: first to verify its
+// checksum and validate the hashes of its blocks against the RPC, then to save its logs, and finally its blocks, so
+// that the range is only considered synced once all of its logs are saved. Each read is done in batches of
+// snapshotBatchSize records, and the batches saved are checked against the digests of the first read.
+// The snapshot must start at most one block after the latest block saved, so that no blocks are skipped.
+// Only the logs matching the filters registered on this node are imported, unless importFilters is set, in which case
+// the filters of the snapshot are registered too. A failed import can safely be retried.
+func (lp *logPoller) ImportSnapshot(ctx context.Context, r io.ReadSeeker, importFilters bool) (*SnapshotInfo, error) {
+	chainID := lp.ec.ConfiguredChainID()
+	header, err := readSnapshotHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if header.EVMChainID == nil || header.EVMChainID.Cmp(ubig.New(chainID)) != 0 {
+		return nil, fmt.Errorf("snapshot is for chain %s, expected chain %s", header.EVMChainID, chainID)
+	}
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to load latest block: %w", err)
+	}
+	if latest != nil && latest.BlockNumber >= header.ToBlock {
+		return nil, fmt.Errorf("already synced up to block %d, past the end of the snapshot %d", latest.BlockNumber, header.ToBlock)
+	}
+	if latest != nil && latest.BlockNumber+1 < header.FromBlock {
+		return nil, fmt.Errorf("snapshot starts at block %d, importing it would skip the blocks after the latest block saved %d", header.FromBlock, latest.BlockNumber)
+	}
+
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind snapshot: %w", err)
+	}
+	var digest snapshotDigest
+	var digests [][]byte
+	blockHashes := make(map[int64]common.Hash, snapshotBatchSize)
+	addBlock := func(number int64, hash common.Hash) error {
+		if h, ok := blockHashes[number]; ok && h != hash {
+			return fmt.Errorf("snapshot has conflicting hashes for block %d", number)
+		}
+		blockHashes[number] = hash
+		if len(blockHashes) < snapshotBatchSize {
+			return nil
+		}
+		// Blocks are validated as they are read, so a hash conflicting with an earlier batch fails validation.
+		if err := lp.validateSnapshotBlocks(ctx, blockHashes); err != nil {
+			return err
+		}
+		clear(blockHashes)
+		return nil
+	}
+	lastBlock := int64(-1)
+	info, err := readSnapshot(r, func(rec snapshotRecord) error {
+		sum, err := digest.add(rec)
+		if err != nil {
+			return err
+		}
+		if sum != nil {
+			digests = append(digests, sum)
+		}
+		switch {
+		case rec.Block != nil:
+			if rec.Block.Number <= lastBlock {
+				return fmt.Errorf("snapshot block %d is out of order, after block %d", rec.Block.Number, lastBlock)
+			}
+			lastBlock = rec.Block.Number
+			return addBlock(rec.Block.Number, rec.Block.Hash)
+		case rec.Log != nil:
+			return addBlock(rec.Log.BlockNumber, rec.Log.BlockHash)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sum := digest.flush(); sum != nil {
+		digests = append(digests, sum)
+	}
+	if info.EVMChainID == nil || info.EVMChainID.Cmp(header.EVMChainID) != 0 || info.FromBlock != header.FromBlock || info.ToBlock != header.ToBlock {
+		return nil, errSnapshotChanged
+	}
+	if lastBlock != info.ToBlock {
+		return nil, fmt.Errorf("snapshot is missing its last block %d", info.ToBlock)
+	}
+	if err = lp.validateSnapshotBlocks(ctx, blockHashes); err != nil {
+		return nil, err
+	}
+
+	filters, err := lp.orm.LoadFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load filters: %w", err)
+	}
+	addresses, eventSigs := make(map[common.Address]struct{}), make(map[common.Hash]struct{})
+	addFilter := func(f Filter) {
+		for _, a := range f.Addresses {
+			addresses[a] = struct{}{}
+		}
+		for _, e := range f.EventSigs {
+			eventSigs[e] = struct{}{}
+		}
+	}
+	for _, f := range filters {
+		addFilter(f)
+	}
+
+	info.Filters, info.Blocks, info.Logs = 0, 0, 0
+	logs := make([]Log, 0, snapshotBatchSize)
+	err = replaySnapshot(r, digests, func(batch []snapshotRecord) error {
+		logs = logs[:0]
+		for _, rec := range batch {
+			switch {
+			case rec.Filter != nil:
+				if !importFilters {
+					continue
+				}
+				f := rec.Filter.filter()
+				if err := lp.orm.InsertFilter(ctx, f); err != nil {
+					return fmt.Errorf("failed to save filter %s: %w", f.Name, err)
+				}
+				addFilter(f)
+				info.Filters++
+			case rec.Log != nil:
+				// Like the poller, save the logs of any of the addresses with any of the event sigs.
+				_, addressOk := addresses[rec.Log.Address]
+				_, eventSigOk := eventSigs[rec.Log.EventSig]
+				if addressOk && eventSigOk {
+					logs = append(logs, rec.Log.log(chainID))
+				}
+			}
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		if err := lp.orm.InsertLogs(ctx, logs); err != nil {
+			return fmt.Errorf("failed to save logs: %w", err)
+		}
+		info.Logs += len(logs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The blocks are in ascending order, so an interrupted import leaves no gaps below the latest block saved.
+	err = replaySnapshot(r, digests, func(batch []snapshotRecord) error {
+		for _, rec := range batch {
+			if b := rec.Block; b != nil {
+				if err := lp.orm.InsertBlock(ctx, b.Hash, b.Number, time.Unix(b.Timestamp, 0).UTC(), b.Finalized); err != nil {
+					return fmt.Errorf("failed to save block %d: %w", b.Number, err)
+				}
+				info.Blocks++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	lp.lggr.Infow("Imported snapshot", "fromBlock", info.FromBlock, "toBlock", info.ToBlock, "filters", info.Filters, "blocks", info.Blocks, "logs", info.Logs)
+	return info, nil
+}
+
+// replaySnapshot reads the snapshot from r again, calling fn for each batch of records once its digest is checked
+// against the digests of the first read. The batch is reused once fn returns.
+func replaySnapshot(r io.ReadSeeker, digests [][]byte, fn func([]snapshotRecord) error) error {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind snapshot: %w", err)
+	}
+	var digest snapshotDigest
+	batch := make([]snapshotRecord, 0, snapshotBatchSize)
+	apply := func(sum []byte) error {
+		if len(digests) == 0 || !bytes.Equal(digests[0], sum) {
+			return errSnapshotChanged
+		}
+		digests = digests[1:]
+		err := fn(batch)
+		batch = batch[:0]
+		return err
+	}
+	_, err := readSnapshot(r, func(rec snapshotRecord) error {
+		sum, err := digest.add(rec)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, rec)
+		if sum == nil {
+			return nil
+		}
+		return apply(sum)
+	})
+	if err != nil {
+		return err
+	}
+	if sum := digest.flush(); sum != nil {
+		if err = apply(sum); err != nil {
+			return err
+		}
+	}
+	if len(digests) != 0 {
+		return errSnapshotChanged
+	}
+	return nil
+}
+
+// validateSnapshotBlocks checks that the blocks are finalized on chain, with the given hashes. hashes is not modified.
+func (lp *logPoller) validateSnapshotBlocks(ctx context.Context, hashes map[int64]common.Hash) error {
+	numbers := make([]int64, 0, len(hashes))
+	for n := range hashes {
+		numbers = append(numbers, n)
+	}
+	slices.Sort(numbers)
+	requested := make([]string, len(numbers))
+	for i, n := range numbers {
+		requested[i] = hexutil.EncodeBig(big.NewInt(n))
+	}
+	batchSize := lp.rpcBatchSize
+	if batchSize <= 0 {
+		batchSize = snapshotBatchSize
+	}
+	heads, err := lp.batchFetchBlocks(ctx, requested, batchSize)
+	if err != nil {
+		return fmt.Errorf("failed to fetch snapshot blocks from RPC: %w", err)
+	}
+	validated := make(map[int64]struct{}, len(hashes))
+	for _, h := range heads {
+		expected, ok := hashes[h.Number]
+		if !ok {
+			continue
+		}
+		if expected != h.Hash {
+			return fmt.Errorf("block %d hash mismatch: snapshot has %s, RPC has %s", h.Number, expected, h.Hash)
+		}
+		validated[h.Number] = struct{}{}
+	}
+	if missing := len(hashes) - len(validated); missing > 0 {
+		return fmt.Errorf("unable to validate %d snapshot blocks against RPC", missing)
+	}
+	return nil
+}
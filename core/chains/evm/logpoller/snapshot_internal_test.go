@@ -0,0 +1,244 @@
+package logpoller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func writeTestSnapshot(t *testing.T, header *SnapshotInfo, records ...snapshotRecord) []byte {
+	var buf bytes.Buffer
+	w := newSnapshotWriter(&buf)
+	require.NoError(t, w.write(snapshotRecord{Header: header}))
+	for _, r := range records {
+		require.NoError(t, w.write(r))
+	}
+	require.NoError(t, w.close())
+	return buf.Bytes()
+}
+
+func decompressSnapshot(t *testing.T, snapshot []byte) []byte {
+	gz, err := gzip.NewReader(bytes.NewReader(snapshot))
+	require.NoError(t, err)
+	b, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	return b
+}
+
+func compressSnapshot(t *testing.T, b []byte) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write(b)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestSnapshot_ReadWrite(t *testing.T) {
+	header := &SnapshotInfo{Version: SnapshotVersion, EVMChainID: ubig.NewI(1337), FromBlock: 10, ToBlock: 20}
+	records := []snapshotRecord{
+		{Filter: newSnapshotFilter(Filter{Name: "filter", Addresses: []common.Address{common.HexToAddress("0x1")}, EventSigs: []common.Hash{common.HexToHash("0x2")}})},
+		{Block: &snapshotBlock{Hash: common.HexToHash("0x3"), Number: 15, Timestamp: 1000, Finalized: 20}},
+		{Log: newSnapshotLog(Log{BlockHash: common.HexToHash("0x3"), BlockNumber: 15, LogIndex: 1, Data: []byte{1, 2, 3}})},
+		{Block: &snapshotBlock{Hash: common.HexToHash("0x4"), Number: 20, Timestamp: 1010, Finalized: 20}},
+	}
+	snapshot := writeTestSnapshot(t, header, records...)
+
+	t.Run("round trip", func(t *testing.T) {
+		var read []snapshotRecord
+		info, err := readSnapshot(bytes.NewReader(snapshot), func(r snapshotRecord) error {
+			read = append(read, r)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, header.ToBlock, info.ToBlock)
+		assert.Equal(t, header.EVMChainID, info.EVMChainID)
+		assert.Equal(t, records, read)
+	})
+
+	t.Run("tampered record", func(t *testing.T) {
+		b := bytes.Replace(decompressSnapshot(t, snapshot), []byte(`"n":15`), []byte(`"n":16`), 1)
+		_, err := readSnapshot(bytes.NewReader(compressSnapshot(t, b)), func(snapshotRecord) error { return nil })
+		require.ErrorIs(t, err, ErrSnapshotChecksum)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		b := decompressSnapshot(t, snapshot)
+		b = b[:bytes.LastIndexByte(b[:len(b)-1], '\n')+1]
+		_, err := readSnapshot(bytes.NewReader(compressSnapshot(t, b)), func(snapshotRecord) error { return nil })
+		require.ErrorContains(t, err, "missing checksum")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		snapshot := writeTestSnapshot(t, &SnapshotInfo{Version: SnapshotVersion + 1})
+		_, err := readSnapshot(bytes.NewReader(snapshot), func(snapshotRecord) error { return nil })
+		require.ErrorIs(t, err, ErrSnapshotVersion)
+	})
+
+	t.Run("not gzipped", func(t *testing.T) {
+		_, err := readSnapshot(bytes.NewReader(decompressSnapshot(t, snapshot)), func(snapshotRecord) error { return nil })
+		require.ErrorContains(t, err, "failed to decompress snapshot")
+	})
+}
+
+func TestLogPoller_ImportSnapshotWithLogs(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	chainID := testutils.FixtureChainID
+	orm := NewORM(chainID, pgtest.NewSqlxDB(t), lggr)
+
+	address, eventSig := common.HexToAddress("0x1"), common.HexToHash("0x2")
+	hashes := map[int64]common.Hash{15: common.HexToHash("0x3"), 20: common.HexToHash("0x4")}
+	snapshot := writeTestSnapshot(t, &SnapshotInfo{Version: SnapshotVersion, EVMChainID: ubig.New(chainID), FromBlock: 10, ToBlock: 20},
+		snapshotRecord{Filter: newSnapshotFilter(Filter{Name: "filter", Addresses: []common.Address{address}, EventSigs: []common.Hash{eventSig}})},
+		snapshotRecord{Block: &snapshotBlock{Hash: hashes[15], Number: 15, Timestamp: 1000, Finalized: 20}},
+		snapshotRecord{Log: newSnapshotLog(Log{BlockHash: hashes[15], BlockNumber: 15, BlockTimestamp: time.Unix(1000, 0), LogIndex: 1, Address: address, EventSig: eventSig, Topics: [][]byte{eventSig.Bytes()}, Data: []byte{1, 2, 3}})},
+		snapshotRecord{Block: &snapshotBlock{Hash: hashes[20], Number: 20, Timestamp: 1010, Finalized: 20}},
+	)
+
+	ec := evmclimocks.NewClient(t)
+	ec.On("ConfiguredChainID").Return(chainID)
+	ec.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		for _, e := range args.Get(1).([]rpc.BatchElem) {
+			num := int64(20)
+			if block := e.Args[0].(string); block != string(finalizedBlock) {
+				n, err := hexutil.DecodeUint64(block)
+				require.NoError(t, err)
+				num = int64(n)
+			}
+			*e.Result.(*evmtypes.Head) = evmtypes.Head{Number: num, Hash: hashes[num]}
+		}
+	})
+	lp := NewLogPoller(orm, ec, lggr, nil, Opts{UseFinalityTag: true, RpcBatchSize: 2, KeepFinalizedBlocksDepth: 1000})
+
+	info, err := lp.ImportSnapshot(ctx, bytes.NewReader(snapshot), true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, info.Filters)
+	assert.Equal(t, 2, info.Blocks)
+	assert.Equal(t, 1, info.Logs)
+
+	logs, err := orm.SelectLogs(ctx, 0, 20, address, eventSig)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, hashes[15], logs[0].BlockHash)
+}
+
+func TestSnapshot_Replay(t *testing.T) {
+	header := &SnapshotInfo{Version: SnapshotVersion, EVMChainID: ubig.NewI(1337), FromBlock: 0, ToBlock: 2 * snapshotBatchSize}
+	records := make([]snapshotRecord, 0, 2*snapshotBatchSize+1)
+	for n := int64(0); n <= 2*snapshotBatchSize; n++ {
+		records = append(records, snapshotRecord{Block: &snapshotBlock{Hash: common.BigToHash(big.NewInt(n + 1)), Number: n, Finalized: n}})
+	}
+	snapshot := writeTestSnapshot(t, header, records...)
+
+	var digest snapshotDigest
+	var digests [][]byte
+	_, err := readSnapshot(bytes.NewReader(snapshot), func(rec snapshotRecord) error {
+		sum, err := digest.add(rec)
+		if sum != nil {
+			digests = append(digests, sum)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	if sum := digest.flush(); sum != nil {
+		digests = append(digests, sum)
+	}
+	require.Len(t, digests, 3)
+
+	t.Run("reads in batches", func(t *testing.T) {
+		var sizes []int
+		var read []snapshotRecord
+		err := replaySnapshot(bytes.NewReader(snapshot), digests, func(batch []snapshotRecord) error {
+			sizes = append(sizes, len(batch))
+			read = append(read, batch...)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{snapshotBatchSize, snapshotBatchSize, 1}, sizes)
+		assert.Equal(t, records, read)
+	})
+
+	t.Run("changed snapshot", func(t *testing.T) {
+		changed := slices.Clone(records)
+		changed[snapshotBatchSize+1] = snapshotRecord{Block: &snapshotBlock{Hash: common.HexToHash("0x1"), Number: snapshotBatchSize + 1}}
+		var batches int
+		err := replaySnapshot(bytes.NewReader(writeTestSnapshot(t, header, changed...)), digests, func([]snapshotRecord) error {
+			batches++
+			return nil
+		})
+		require.ErrorIs(t, err, errSnapshotChanged)
+		assert.Equal(t, 1, batches, "only the batches matching their digest are applied")
+	})
+
+	t.Run("extra records", func(t *testing.T) {
+		err := replaySnapshot(bytes.NewReader(writeTestSnapshot(t, header, append(slices.Clone(records), records[0])...)), digests, func([]snapshotRecord) error { return nil })
+		require.ErrorIs(t, err, errSnapshotChanged)
+	})
+}
+
+func TestLogPoller_ImportSnapshotInBatches(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	chainID := testutils.FixtureChainID
+	orm := NewORM(chainID, pgtest.NewSqlxDB(t), lggr)
+
+	const toBlock = snapshotBatchSize + snapshotBatchSize/2
+	records := make([]snapshotRecord, 0, toBlock+1)
+	for n := int64(0); n <= toBlock; n++ {
+		records = append(records, snapshotRecord{Block: &snapshotBlock{Hash: common.BigToHash(big.NewInt(n + 1)), Number: n, Timestamp: 1000 + n, Finalized: toBlock}})
+	}
+	snapshot := writeTestSnapshot(t, &SnapshotInfo{Version: SnapshotVersion, EVMChainID: ubig.New(chainID), FromBlock: 0, ToBlock: toBlock}, records...)
+
+	const rpcBatchSize = 100
+	var requested int
+	ec := evmclimocks.NewClient(t)
+	ec.On("ConfiguredChainID").Return(chainID)
+	ec.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		// The requested blocks, and the finalized block used to validate them.
+		assert.LessOrEqual(t, len(elems), rpcBatchSize+1)
+		for _, e := range elems {
+			num := int64(toBlock)
+			if block := e.Args[0].(string); block != string(finalizedBlock) {
+				n, err := hexutil.DecodeUint64(block)
+				require.NoError(t, err)
+				num = int64(n)
+				requested++
+			}
+			*e.Result.(*evmtypes.Head) = evmtypes.Head{Number: num, Hash: common.BigToHash(big.NewInt(num + 1))}
+		}
+	})
+	lp := NewLogPoller(orm, ec, lggr, nil, Opts{UseFinalityTag: true, RpcBatchSize: rpcBatchSize, KeepFinalizedBlocksDepth: 1000})
+
+	info, err := lp.ImportSnapshot(ctx, bytes.NewReader(snapshot), false)
+	require.NoError(t, err)
+	assert.Equal(t, toBlock+1, info.Blocks)
+	assert.Equal(t, toBlock+1, requested)
+
+	latest, err := orm.SelectLatestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(toBlock), latest.BlockNumber)
+	blocks, err := orm.GetBlocksRange(ctx, 0, toBlock)
+	require.NoError(t, err)
+	assert.Len(t, blocks, toBlock+1)
+}
@@ -0,0 +1,115 @@
+package logpoller_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestLogPoller_ExportImportSnapshot(t *testing.T) {
+	ctx := testutils.Context(t)
+	th := SetupTH(t, logpoller.Opts{
+		UseFinalityTag:           true,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	})
+	filter := logpoller.Filter{
+		Name:      "Test Emitter",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, filter))
+
+	for i := 0; i < 10; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Backend.Commit()
+	}
+	th.finalizeThroughBlock(t, 12)
+	th.PollAndSaveLogs(ctx, 1)
+
+	expected, err := th.LogPoller.Logs(ctx, 0, 12, EmitterABI.Events["Log1"].ID, th.EmitterAddress1)
+	require.NoError(t, err)
+	require.Len(t, expected, 10)
+
+	latest, err := th.LogPoller.LatestBlock(ctx)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	exported, err := th.LogPoller.ExportSnapshot(ctx, &buf, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, latest.FinalizedBlockNumber, exported.ToBlock)
+	assert.Equal(t, 1, exported.Filters)
+	assert.Equal(t, 10, exported.Logs)
+
+	t.Run("refuses to import into a synced database", func(t *testing.T) {
+		_, err := th.LogPoller.ImportSnapshot(ctx, bytes.NewReader(buf.Bytes()), false)
+		require.ErrorContains(t, err, "already synced")
+	})
+
+	t.Run("refuses to import a snapshot of another chain", func(t *testing.T) {
+		th2 := SetupTH(t, logpoller.Opts{UseFinalityTag: true, RpcBatchSize: 2, KeepFinalizedBlocksDepth: 1000})
+		_, err := th2.LogPoller.ImportSnapshot(ctx, bytes.NewReader(buf.Bytes()), false)
+		require.ErrorContains(t, err, "snapshot is for chain")
+	})
+
+	t.Run("refuses to import a snapshot starting after the next block", func(t *testing.T) {
+		var partial bytes.Buffer
+		_, err := th.LogPoller.ExportSnapshot(ctx, &partial, 5, 0)
+		require.NoError(t, err)
+		require.NoError(t, th.ORM.DeleteLogsAndBlocksAfter(ctx, 3))
+
+		_, err = th.LogPoller.ImportSnapshot(ctx, bytes.NewReader(partial.Bytes()), false)
+		require.ErrorContains(t, err, "would skip the blocks after the latest block saved 2")
+	})
+
+	t.Run("imports only the logs of local filters", func(t *testing.T) {
+		require.NoError(t, th.ORM.DeleteLogsAndBlocksAfter(ctx, 0))
+		require.NoError(t, th.ORM.DeleteFilter(ctx, filter.Name))
+
+		imported, err := th.LogPoller.ImportSnapshot(ctx, bytes.NewReader(buf.Bytes()), false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, imported.Filters)
+		assert.Equal(t, exported.Blocks, imported.Blocks)
+		assert.Equal(t, 0, imported.Logs)
+
+		filters, err := th.ORM.LoadFilters(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, filters, filter.Name)
+	})
+
+	t.Run("imports logs, blocks and filters", func(t *testing.T) {
+		require.NoError(t, th.ORM.DeleteLogsAndBlocksAfter(ctx, 0))
+
+		imported, err := th.LogPoller.ImportSnapshot(ctx, bytes.NewReader(buf.Bytes()), true)
+		require.NoError(t, err)
+		assert.Equal(t, exported.Filters, imported.Filters)
+		assert.Equal(t, exported.Blocks, imported.Blocks)
+		assert.Equal(t, exported.Logs, imported.Logs)
+
+		logs, err := th.LogPoller.Logs(ctx, 0, 12, EmitterABI.Events["Log1"].ID, th.EmitterAddress1)
+		require.NoError(t, err)
+		require.Len(t, logs, len(expected))
+		for i := range expected {
+			assert.Equal(t, expected[i].BlockHash, logs[i].BlockHash)
+			assert.Equal(t, expected[i].LogIndex, logs[i].LogIndex)
+			assert.Equal(t, expected[i].Data, logs[i].Data)
+		}
+
+		latest, err := th.ORM.SelectLatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, exported.ToBlock, latest.BlockNumber)
+
+		filters, err := th.ORM.LoadFilters(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, filters, filter.Name)
+	})
+}
@@ -0,0 +1,337 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+const (
+	// subscriptionBufferSize is the number of events a subscription buffers before delivery is paused.
+	subscriptionBufferSize = 100
+	// defaultSubscriptionBatchSize is the number of blocks queried at once when delivering logs, if
+	// BackfillBatchSize is not set.
+	defaultSubscriptionBatchSize = 1000
+)
+
+var ErrSubscriptionExists = errors.New("subscription already exists")
+
+// LogEvent is delivered to a Subscription when logs matching its filter are saved, or removed by a reorg.
+type LogEvent struct {
+	// Logs ordered by block number and log index.
+	Logs []Log
+	// Removed is set if the logs were delivered before, and have since been removed by a reorg.
+	Removed bool
+}
+
+// Subscription receives the logs matching a filter, once they have the requested number of confirmations.
+//
+// The last block delivered is persisted under the filter name, so a subscription resumes where it left off after a
+// restart. Delivery pauses while the Events channel is full, and resumes without loss once the subscriber catches up.
+// Logs are delivered at least once: after a crash, the logs delivered since the cursor was last saved are delivered
+// again.
+type Subscription struct {
+	name   string
+	filter Filter
+	confs  evmtypes.Confirmations
+	events chan LogEvent
+	lp     *logPoller
+
+	// guarded by logPoller.subsMu
+	cursor  int64 // last block delivered
+	pending []LogEvent
+	closed  bool
+}
+
+// Events returns the channel on which logs are delivered. It is closed when the subscription is closed.
+func (s *Subscription) Events() <-chan LogEvent {
+	return s.events
+}
+
+// Name returns the name of the subscription, which is the name of its filter.
+func (s *Subscription) Name() string {
+	return s.name
+}
+
+// Close stops the delivery of logs. The cursor of the subscription is kept, so subscribing again with the same filter
+// resumes from the last block delivered.
+func (s *Subscription) Close() {
+	s.lp.subsMu.Lock()
+	defer s.lp.subsMu.Unlock()
+	s.lp.closeSubscription(s)
+}
+
+// Subscribe registers the filter and returns a Subscription receiving the logs which match it, as they are saved and
+// reach the given number of confirmations. A new subscription starts after the latest block saved; an existing
+// subscription, with a cursor saved under the filter name, resumes where it left off.
+// Only one subscription per filter name can be open at a time. Unregistering the filter closes the subscription and
+// deletes its cursor.
+func (lp *logPoller) Subscribe(ctx context.Context, filter Filter, confs evmtypes.Confirmations) (*Subscription, error) {
+	if confs < evmtypes.Finalized {
+		return nil, fmt.Errorf("invalid confirmations %d", confs)
+	}
+	if err := lp.RegisterFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	if _, ok := lp.subs[filter.Name]; ok {
+		return nil, fmt.Errorf("%w: %s", ErrSubscriptionExists, filter.Name)
+	}
+
+	cursor, err := lp.orm.SelectSubscriptionCursor(ctx, filter.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		cursor, err = lp.initialSubscriptionCursor(ctx, confs)
+		if err != nil {
+			return nil, err
+		}
+		if err = lp.orm.UpsertSubscriptionCursor(ctx, filter.Name, cursor); err != nil {
+			return nil, fmt.Errorf("failed to save subscription cursor: %w", err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load subscription cursor: %w", err)
+	}
+
+	sub := &Subscription{
+		name:   filter.Name,
+		filter: filter,
+		confs:  confs,
+		events: make(chan LogEvent, subscriptionBufferSize),
+		lp:     lp,
+		cursor: cursor,
+	}
+	lp.subs[filter.Name] = sub
+	lp.lggr.Debugw("Subscribed to logs", "name", filter.Name, "confs", confs, "cursor", cursor)
+	return sub, nil
+}
+
+// initialSubscriptionCursor returns the last block with enough confirmations, so that new subscriptions only receive
+// logs saved after they were created.
+func (lp *logPoller) initialSubscriptionCursor(ctx context.Context, confs evmtypes.Confirmations) (int64, error) {
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load latest block: %w", err)
+	}
+	return max(subscriptionTarget(latest, confs), 0), nil
+}
+
+// subscriptionTarget returns the last block with the given number of confirmations.
+func subscriptionTarget(latest *LogPollerBlock, confs evmtypes.Confirmations) int64 {
+	if confs == evmtypes.Finalized {
+		return latest.FinalizedBlockNumber
+	}
+	return latest.BlockNumber - int64(confs)
+}
+
+// closeSubscription must be called with subsMu held.
+func (lp *logPoller) closeSubscription(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.events)
+	delete(lp.subs, sub.name)
+}
+
+// unsubscribe closes the subscription to the named filter, if any, and deletes its cursor.
+func (lp *logPoller) unsubscribe(ctx context.Context, name string) error {
+	lp.deliverMu.Lock()
+	defer lp.deliverMu.Unlock()
+	lp.subsMu.Lock()
+	if sub, ok := lp.subs[name]; ok {
+		lp.closeSubscription(sub)
+	}
+	lp.subsMu.Unlock()
+	return lp.orm.DeleteSubscriptionCursor(ctx, name)
+}
+
+func (lp *logPoller) closeSubscriptions() {
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	for _, sub := range lp.subs {
+		lp.closeSubscription(sub)
+	}
+}
+
+// subscriptionCursor is a snapshot of an open subscription and its cursor, taken under subsMu.
+type subscriptionCursor struct {
+	sub    *Subscription
+	cursor int64
+}
+
+// openSubscriptions returns a snapshot of the open subscriptions. If flush is set, the pending removals are sent
+// first, and the subscriptions which still have pending removals are left out.
+func (lp *logPoller) openSubscriptions(flush bool) []subscriptionCursor {
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	subs := make([]subscriptionCursor, 0, len(lp.subs))
+	for _, sub := range lp.subs {
+		if flush && !sub.flushPending() {
+			continue
+		}
+		subs = append(subs, subscriptionCursor{sub: sub, cursor: sub.cursor})
+	}
+	return subs
+}
+
+// deliverSubscriptions sends the logs saved since the last delivery to each subscription, up to the last block with
+// the number of confirmations it requested. The logs are queried without holding subsMu, so that subscribing and
+// closing subscriptions is not blocked by delivery.
+func (lp *logPoller) deliverSubscriptions(ctx context.Context) {
+	lp.deliverMu.Lock()
+	defer lp.deliverMu.Unlock()
+	subs := lp.openSubscriptions(true)
+	if len(subs) == 0 {
+		return
+	}
+
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			lp.lggr.Warnw("Unable to load latest block, skipping delivery to subscriptions", "err", err)
+		}
+		return
+	}
+	batchSize := lp.backfillBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSubscriptionBatchSize
+	}
+
+	for _, s := range subs {
+		sub := s.sub
+		target := subscriptionTarget(latest, sub.confs)
+		cursor := s.cursor
+		for cursor < target {
+			to := min(cursor+batchSize, target)
+			logs, err := lp.orm.SelectLogsWithSigsAddrs(ctx, cursor+1, to, sub.filter.Addresses, sub.filter.EventSigs)
+			if err != nil {
+				lp.lggr.Warnw("Unable to load logs for subscription", "err", err, "name", sub.name, "from", cursor+1, "to", to)
+				break
+			}
+			if !lp.advanceSubscription(sub, cursor, to, sub.filter.matching(logs)) {
+				lp.lggr.Debugw("Subscription closed or buffer full, pausing delivery", "name", sub.name, "cursor", cursor)
+				break
+			}
+			cursor = to
+		}
+		if cursor == s.cursor {
+			continue
+		}
+		if err := lp.orm.UpsertSubscriptionCursor(ctx, sub.name, cursor); err != nil {
+			lp.lggr.Warnw("Unable to save subscription cursor", "err", err, "name", sub.name, "cursor", cursor)
+		}
+	}
+}
+
+// advanceSubscription sends the logs up to block to, and moves the cursor of the subscription from the block from.
+// It returns false, without sending anything, if the subscription was closed or its cursor moved since it was read,
+// or if there is no room for the logs.
+func (lp *logPoller) advanceSubscription(sub *Subscription, from, to int64, logs []Log) bool {
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	if sub.closed || sub.cursor != from {
+		return false
+	}
+	if len(logs) > 0 && !sub.send(LogEvent{Logs: logs}) {
+		return false
+	}
+	sub.cursor = to
+	return true
+}
+
+// subscriptionRemovals returns the logs which were delivered to subscriptions and will be removed by deleting the
+// blocks from start onwards. It is called from the poll loop, like rewindSubscriptions, so the cursors read here are
+// still current when the subscriptions are rewound.
+func (lp *logPoller) subscriptionRemovals(ctx context.Context, start int64) (map[string][]Log, error) {
+	removals := make(map[string][]Log)
+	for _, s := range lp.openSubscriptions(false) {
+		if s.cursor < start {
+			continue
+		}
+		logs, err := lp.orm.SelectLogsWithSigsAddrs(ctx, start, s.cursor, s.sub.filter.Addresses, s.sub.filter.EventSigs)
+		if err != nil {
+			return nil, err
+		}
+		if delivered := s.sub.filter.matching(logs); len(delivered) > 0 {
+			removals[s.sub.name] = delivered
+		}
+	}
+	return removals, nil
+}
+
+// rewindSubscriptions notifies subscriptions of the removed logs, and rewinds their cursors to before start. The
+// persisted cursors are rewound by ORM.DeleteLogsAndBlocksAfter.
+func (lp *logPoller) rewindSubscriptions(start int64, removals map[string][]Log) {
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	for name, sub := range lp.subs {
+		if sub.cursor < start {
+			continue
+		}
+		sub.cursor = start - 1
+		if removed := removals[name]; len(removed) > 0 {
+			sub.pending = append(sub.pending, LogEvent{Logs: removed, Removed: true})
+			sub.flushPending()
+		}
+	}
+}
+
+// flushPending sends the pending removals, and returns whether all of them were sent. Must be called with subsMu held.
+func (s *Subscription) flushPending() bool {
+	for len(s.pending) > 0 {
+		if !s.send(s.pending[0]) {
+			return false
+		}
+		s.pending = s.pending[1:]
+	}
+	return true
+}
+
+// send delivers the event without blocking, and returns whether there was room for it. Must be called with subsMu
+// held.
+func (s *Subscription) send(ev LogEvent) bool {
+	if s.closed {
+		return false
+	}
+	select {
+	case s.events <- ev:
+		return true
+	default:
+		return false
+	}
+}
+
+// matches returns whether the log would be saved for the filter.
+func (filter *Filter) matches(log *Log) bool {
+	if !slices.Contains(filter.Addresses, log.Address) || !slices.Contains(filter.EventSigs, log.EventSig) {
+		return false
+	}
+	topics := log.GetTopics()
+	for i, values := range []evmtypes.HashArray{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(values) == 0 {
+			continue
+		}
+		if len(topics) <= i+1 || !slices.Contains(values, topics[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (filter *Filter) matching(logs []Log) []Log {
+	var matched []Log
+	for i := range logs {
+		if filter.matches(&logs[i]) {
+			matched = append(matched, logs[i])
+		}
+	}
+	return matched
+}
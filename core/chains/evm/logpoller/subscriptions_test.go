@@ -0,0 +1,168 @@
+package logpoller_test
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func requireLogEvent(t *testing.T, sub *logpoller.Subscription) logpoller.LogEvent {
+	t.Helper()
+	select {
+	case ev, ok := <-sub.Events():
+		require.True(t, ok, "subscription closed")
+		return ev
+	default:
+		require.FailNow(t, "no log event delivered")
+		return logpoller.LogEvent{}
+	}
+}
+
+func requireNoLogEvent(t *testing.T, sub *logpoller.Subscription) {
+	t.Helper()
+	select {
+	case ev := <-sub.Events():
+		require.FailNow(t, "unexpected log event", "%v", ev)
+	default:
+	}
+}
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	th := SetupTH(t, logpoller.Opts{
+		FinalityDepth:            3,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	})
+
+	// Logs of emitter 2 are saved, but not delivered to the subscription.
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name:      "emitter 2",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress2},
+	}))
+	filter := logpoller.Filter{
+		Name:      "emitter 1 subscription",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}
+
+	newStart := th.PollAndSaveLogs(ctx, 1)
+	sub, err := th.LogPoller.Subscribe(ctx, filter, evmtypes.Unconfirmed)
+	require.NoError(t, err)
+	_, err = th.LogPoller.Subscribe(ctx, filter, evmtypes.Unconfirmed)
+	require.ErrorIs(t, err, logpoller.ErrSubscriptionExists)
+
+	// Chain gen <- 1 <- 2 (L1_1, L2)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Backend.Commit()
+
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+	ev := requireLogEvent(t, sub)
+	assert.False(t, ev.Removed)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, th.EmitterAddress1, ev.Logs[0].Address)
+	assert.Equal(t, int64(2), ev.Logs[0].BlockNumber)
+	assert.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), ev.Logs[0].Data)
+	requireNoLogEvent(t, sub)
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	//                \ 2'(L1_2) <- 3
+	// L1_1 is removed, and L1_2 delivered.
+	lca, err := th.Client.BlockByNumber(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, th.Backend.Fork(lca.Hash()))
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Backend.Commit()
+	th.Backend.Commit()
+
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+	ev = requireLogEvent(t, sub)
+	assert.True(t, ev.Removed)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), ev.Logs[0].Data)
+	ev = requireLogEvent(t, sub)
+	assert.False(t, ev.Removed)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, common.BigToHash(big.NewInt(2)).Bytes(), ev.Logs[0].Data)
+	requireNoLogEvent(t, sub)
+
+	cursor, err := th.ORM.SelectSubscriptionCursor(ctx, filter.Name)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), cursor)
+
+	// Logs saved while unsubscribed are delivered when resubscribing.
+	sub.Close()
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(3)})
+	require.NoError(t, err)
+	th.Backend.Commit()
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+
+	sub, err = th.LogPoller.Subscribe(ctx, filter, evmtypes.Unconfirmed)
+	require.NoError(t, err)
+	th.PollAndSaveLogs(ctx, newStart)
+	ev = requireLogEvent(t, sub)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, common.BigToHash(big.NewInt(3)).Bytes(), ev.Logs[0].Data)
+
+	// Unregistering the filter closes the subscription and deletes its cursor.
+	require.NoError(t, th.LogPoller.UnregisterFilter(ctx, filter.Name))
+	_, ok = <-sub.Events()
+	assert.False(t, ok)
+	_, err = th.ORM.SelectSubscriptionCursor(ctx, filter.Name)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestLogPoller_Subscribe_Confirmations(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	th := SetupTH(t, logpoller.Opts{
+		FinalityDepth:            3,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	})
+	filter := logpoller.Filter{
+		Name:      "confirmed subscription",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}
+
+	_, err := th.LogPoller.Subscribe(ctx, filter, evmtypes.Confirmations(-2))
+	require.Error(t, err)
+
+	newStart := th.PollAndSaveLogs(ctx, 1)
+	sub, err := th.LogPoller.Subscribe(ctx, filter, evmtypes.Confirmations(2))
+	require.NoError(t, err)
+
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Backend.Commit()
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+	requireNoLogEvent(t, sub)
+
+	// The log in block 2 has 2 confirmations once block 4 is saved.
+	th.Backend.Commit()
+	th.Backend.Commit()
+	th.PollAndSaveLogs(ctx, newStart)
+	ev := requireLogEvent(t, sub)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, int64(2), ev.Logs[0].BlockNumber)
+}
@@ -1,10 +1,14 @@
 package monitor
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,10 +21,14 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
@@ -32,6 +40,18 @@ type (
 		services.Service
 	}
 
+	// Config is the subset of the chain config used by the BalanceMonitor.
+	Config interface {
+		BalanceMonitor() config.BalanceMonitor
+		GasEstimator() config.GasEstimator
+	}
+
+	// TxCreator creates the transactions topping up keys with a low balance, and finds those still pending.
+	TxCreator interface {
+		CreateTransaction(ctx context.Context, txRequest txmgr.TxRequest) (tx txmgr.Tx, err error)
+		FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgr.Tx, err error)
+	}
+
 	balanceMonitor struct {
 		services.Service
 		eng *services.Engine
@@ -43,6 +63,13 @@ type (
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx sync.RWMutex
 		sleeperTask    *utils.SleeperTask
+
+		cfg           Config
+		txm           TxCreator
+		httpClient    *http.Client
+		lowBalancesMu sync.Mutex
+		lowBalances   map[gethCommon.Address]bool
+		lastTopUps    map[gethCommon.Address]time.Time
 	}
 
 	NullBalanceMonitor struct{}
@@ -50,8 +77,8 @@ type (
 
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
-// NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger) *balanceMonitor {
+// NewBalanceMonitor returns a new balanceMonitor. Top-ups of keys with a low balance are created with txm.
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, cfg Config, txm TxCreator, lggr logger.Logger) *balanceMonitor {
 	chainId := ethClient.ConfiguredChainID()
 	bm := &balanceMonitor{
 		ethClient:   ethClient,
@@ -59,6 +86,11 @@ func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lgg
 		chainIDStr:  chainId.String(),
 		ethKeyStore: ethKeyStore,
 		ethBalances: make(map[gethCommon.Address]*assets.Eth),
+		cfg:         cfg,
+		txm:         txm,
+		httpClient:  &http.Client{Timeout: webhookTimeout},
+		lowBalances: make(map[gethCommon.Address]bool),
+		lastTopUps:  make(map[gethCommon.Address]time.Time),
 	}
 	bm.Service, bm.eng = services.Config{
 		Name:  "BalanceMonitor",
@@ -127,6 +159,14 @@ var promETHBalance = promauto.NewGaugeVec(
 	[]string{"account", "evmChainID"},
 )
 
+var promETHBalanceLow = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "eth_balance_low",
+		Help: "Set to 1 when an Ethereum account's balance is below its LowBalanceThreshold, 0 otherwise",
+	},
+	[]string{"account", "evmChainID"},
+)
+
 func (bm *balanceMonitor) promUpdateEthBalance(balance *assets.Eth, from gethCommon.Address) {
 	balanceFloat, err := ApproximateFloat64(balance)
 
@@ -162,6 +202,8 @@ func (w *worker) Work(ctx context.Context) {
 		}(address)
 	}
 	wg.Wait()
+
+	w.bm.checkLowBalances(ctx, enabledAddresses)
 }
 
 // Approximately ETH block time
@@ -188,6 +230,181 @@ func (w *worker) checkAccountBalance(ctx context.Context, address gethCommon.Add
 	}
 }
 
+const (
+	// webhookTimeout bounds the delivery of a low balance event to the webhook.
+	webhookTimeout = 10 * time.Second
+
+	lowBalanceEvent       = "LowBalance"
+	balanceRecoveredEvent = "BalanceRecovered"
+
+	// topUpMetaField is the TxMeta field holding the key topped up by a tx.
+	topUpMetaField = "TopUpAddress"
+)
+
+// lowBalanceCondition is the health condition set while the balance of the key is below its threshold.
+func lowBalanceCondition(address gethCommon.Address) string {
+	return "lowBalance:" + address.Hex()
+}
+
+// checkLowBalances compares the balance of each key to its LowBalanceThreshold. Keys crossing the threshold emit an
+// event, and keys below it are topped up from the treasury if enabled.
+func (bm *balanceMonitor) checkLowBalances(ctx context.Context, addresses []gethCommon.Address) {
+	bm.lowBalancesMu.Lock()
+	defer bm.lowBalancesMu.Unlock()
+
+	cfg := bm.cfg.BalanceMonitor()
+	var low []gethCommon.Address
+	for _, address := range addresses {
+		bal := bm.GetEthBalance(address)
+		if bal == nil {
+			continue
+		}
+		threshold := cfg.LowBalanceThresholdKey(address)
+		if threshold == nil || threshold.IsZero() {
+			if bm.lowBalances[address] {
+				delete(bm.lowBalances, address)
+				bm.eng.ClearHealthCond(lowBalanceCondition(address))
+				promETHBalanceLow.WithLabelValues(address.Hex(), bm.chainIDStr).Set(0)
+			}
+			continue
+		}
+
+		isLow := bal.ToInt().Cmp(threshold.ToInt()) < 0
+		if isLow {
+			low = append(low, address)
+		}
+		if isLow == bm.lowBalances[address] {
+			continue
+		}
+		if isLow {
+			bm.lowBalances[address] = true
+			bm.eng.SetHealthCond(lowBalanceCondition(address), fmt.Errorf("balance of key %s is %s, below threshold %s", address.Hex(), bal, threshold))
+			promETHBalanceLow.WithLabelValues(address.Hex(), bm.chainIDStr).Set(1)
+			bm.eng.Warnw("BalanceMonitor: key balance is below threshold", "event", lowBalanceEvent, "address", address.Hex(), "balance", bal.String(), "threshold", threshold.String())
+			bm.notify(lowBalanceEvent, address, bal, threshold)
+		} else {
+			delete(bm.lowBalances, address)
+			bm.eng.ClearHealthCond(lowBalanceCondition(address))
+			promETHBalanceLow.WithLabelValues(address.Hex(), bm.chainIDStr).Set(0)
+			bm.eng.Infow("BalanceMonitor: key balance has recovered", "event", balanceRecoveredEvent, "address", address.Hex(), "balance", bal.String(), "threshold", threshold.String())
+			bm.notify(balanceRecoveredEvent, address, bal, threshold)
+		}
+	}
+
+	if len(low) > 0 && cfg.TopUp().Enabled() {
+		bm.topUp(ctx, cfg.TopUp(), low)
+	}
+}
+
+type balanceWebhookEvent struct {
+	Event      string    `json:"event"`
+	EVMChainID string    `json:"evmChainID"`
+	Address    string    `json:"address"`
+	Balance    string    `json:"balance"`
+	Threshold  string    `json:"threshold"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// notify posts the event to the configured webhook, if any, without blocking the worker.
+func (bm *balanceMonitor) notify(event string, address gethCommon.Address, bal *assets.Eth, threshold *assets.Wei) {
+	webhookURL := bm.cfg.BalanceMonitor().WebhookURL()
+	if webhookURL == nil {
+		return
+	}
+	body, err := json.Marshal(balanceWebhookEvent{
+		Event:      event,
+		EVMChainID: bm.chainIDStr,
+		Address:    address.Hex(),
+		Balance:    bal.ToInt().String(),
+		Threshold:  threshold.ToInt().String(),
+		Timestamp:  time.Now().UTC(),
+	})
+	if err != nil {
+		bm.eng.Errorw("BalanceMonitor: failed to encode webhook event", "err", err, "event", event)
+		return
+	}
+	bm.eng.Go(func(ctx context.Context) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL.String(), bytes.NewReader(body))
+		if err != nil {
+			bm.eng.Errorw("BalanceMonitor: failed to create webhook request", "err", err, "event", event)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := bm.httpClient.Do(req)
+		if err != nil {
+			bm.eng.Warnw("BalanceMonitor: failed to deliver webhook event", "err", err, "event", event, "address", address.Hex())
+			return
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			bm.eng.Warnw("BalanceMonitor: webhook rejected event", "status", resp.StatusCode, "event", event, "address", address.Hex())
+		}
+	})
+}
+
+// topUp sends Amount from the treasury to each of the keys, unless it was topped up less than MinInterval ago or a
+// previous top-up is still pending. Pending top-ups are looked up in the TxManager, so that a restart does not send
+// a key a second top-up. The treasury must be an enabled key of the chain, with a balance covering the amount.
+func (bm *balanceMonitor) topUp(ctx context.Context, cfg config.BalanceMonitorTopUp, addresses []gethCommon.Address) {
+	treasury := cfg.TreasuryAddress().Address()
+	treasuryBal := bm.GetEthBalance(treasury)
+	if treasuryBal == nil {
+		bm.eng.Errorw("BalanceMonitor: unable to top up keys, treasury is not an enabled key for this chain", "treasury", treasury.Hex())
+		return
+	}
+	available := new(big.Int).Set(treasuryBal.ToInt())
+	amount := cfg.Amount()
+
+	for _, address := range addresses {
+		if address == treasury {
+			continue
+		}
+		if last, ok := bm.lastTopUps[address]; ok && time.Since(last) < cfg.MinInterval() {
+			continue
+		}
+		pending, err := bm.pendingTopUp(ctx, address)
+		if err != nil {
+			bm.eng.Errorw("BalanceMonitor: failed to check for a pending top-up", "err", err, "address", address.Hex())
+			continue
+		}
+		if pending {
+			bm.eng.Debugw("BalanceMonitor: top-up of key is still pending", "address", address.Hex())
+			continue
+		}
+		if available.Cmp(amount.ToInt()) < 0 {
+			bm.eng.Errorw("BalanceMonitor: treasury balance too low to top up key", "treasury", treasury.Hex(), "balance", (*assets.Eth)(available).String(), "address", address.Hex(), "amount", amount.String())
+			return
+		}
+		_, err = bm.txm.CreateTransaction(ctx, txmgr.TxRequest{
+			FromAddress:    treasury,
+			ToAddress:      address,
+			Value:          *amount.ToInt(),
+			FeeLimit:       bm.cfg.GasEstimator().LimitTransfer(),
+			Meta:           &txmgr.TxMeta{TopUpAddress: &address},
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			EncodedPayload: []byte{},
+		})
+		if err != nil {
+			bm.eng.Errorw("BalanceMonitor: failed to create top-up transaction", "err", err, "treasury", treasury.Hex(), "address", address.Hex(), "amount", amount.String())
+			continue
+		}
+		bm.lastTopUps[address] = time.Now()
+		available.Sub(available, amount.ToInt())
+		bm.eng.Infow("BalanceMonitor: topping up key from treasury", "event", "TopUp", "treasury", treasury.Hex(), "address", address.Hex(), "amount", amount.String())
+	}
+}
+
+// pendingTopUp returns whether a top-up of the key was created and is not confirmed yet.
+func (bm *balanceMonitor) pendingTopUp(ctx context.Context, address gethCommon.Address) (bool, error) {
+	// TxMeta addresses are encoded as lower case hex.
+	txes, err := bm.txm.FindTxesByMetaFieldAndStates(ctx, topUpMetaField, strings.ToLower(address.Hex()),
+		[]txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed}, bm.chainID)
+	if err != nil {
+		return false, err
+	}
+	return len(txes) > 0, nil
+}
+
 func (*NullBalanceMonitor) GetEthBalance(gethCommon.Address) *assets.Eth {
 	return nil
 }
@@ -2,7 +2,11 @@ package monitor_test
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -14,15 +18,22 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 var nilBigInt *big.Int
@@ -44,7 +55,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM(), nil, logger.Test(t))
 
 		k0bal := big.NewInt(42)
 		k1bal := big.NewInt(43)
@@ -71,7 +82,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM(), nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
@@ -90,7 +101,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM(), nil, logger.Test(t))
 		ctxCancelledAwaiter := testutils.NewAwaiter()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Run(func(args mock.Arguments) {
@@ -119,7 +130,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM(), nil, logger.Test(t))
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
 			Once().
@@ -144,7 +155,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM(), nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -193,7 +204,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, testutils.NewTestChainScopedConfig(t, nil).EVM(), nil, logger.Test(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
@@ -230,6 +241,124 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, callCount.Load(), int32(1))
 }
 
+func TestBalanceMonitor_LowBalance(t *testing.T) {
+	t.Parallel()
+
+	ethKeyStore := ksmocks.NewEth(t)
+	k0Addr := testutils.NewAddress()
+	treasury := testutils.NewAddress()
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{k0Addr, treasury}, nil)
+	ethClient := newEthClientMock(t)
+
+	events := make(chan map[string]any, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&ev))
+		events <- ev
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.BalanceMonitor.LowBalanceThreshold = assets.NewWeiI(100)
+		c.BalanceMonitor.WebhookURL = commonconfig.MustParseURL(srv.URL)
+		c.BalanceMonitor.TopUp.Enabled = ptr(true)
+		c.BalanceMonitor.TopUp.TreasuryAddress = ptr(types.EIP55AddressFromAddress(treasury))
+		c.BalanceMonitor.TopUp.Amount = assets.NewWeiI(1000)
+		c.BalanceMonitor.TopUp.MinInterval = commonconfig.MustNewDuration(time.Hour)
+	})
+	txm := txmmocks.NewMockEvmTxManager(t)
+
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, cfg.EVM(), txm, logger.Test(t))
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(200), nil)
+	ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Once().Return(big.NewInt(5000), nil)
+	servicetest.RunHealthy(t, bm)
+	require.NoError(t, bm.HealthReport()[bm.Name()])
+
+	// The key drops below the threshold, and is topped up once per MinInterval.
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Twice().Return(big.NewInt(50), nil)
+	ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Twice().Return(big.NewInt(5000), nil)
+	txm.On("FindTxesByMetaFieldAndStates", mock.Anything, "TopUpAddress", strings.ToLower(k0Addr.Hex()), mock.Anything, mock.Anything).Once().Return(nil, nil)
+	txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+		return req.FromAddress == treasury && req.ToAddress == k0Addr && req.Value.Cmp(big.NewInt(1000)) == 0 &&
+			req.FeeLimit == cfg.EVM().GasEstimator().LimitTransfer() && req.Meta != nil && *req.Meta.TopUpAddress == k0Addr
+	})).Once().Return(txmgr.Tx{}, nil)
+
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(1))
+	<-bm.WorkDone()
+	require.Error(t, bm.HealthReport()[bm.Name()])
+	ev := requireWebhookEvent(t, events)
+	assert.Equal(t, "LowBalance", ev["event"])
+	assert.Equal(t, k0Addr.Hex(), ev["address"])
+	assert.Equal(t, "50", ev["balance"])
+	assert.Equal(t, "100", ev["threshold"])
+
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(2))
+	<-bm.WorkDone()
+	require.Error(t, bm.HealthReport()[bm.Name()])
+
+	// The key recovers.
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(1050), nil)
+	ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Once().Return(big.NewInt(4000), nil)
+
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(3))
+	<-bm.WorkDone()
+	require.NoError(t, bm.HealthReport()[bm.Name()])
+	ev = requireWebhookEvent(t, events)
+	assert.Equal(t, "BalanceRecovered", ev["event"])
+	assert.Equal(t, "1050", ev["balance"])
+	select {
+	case ev = <-events:
+		t.Fatalf("unexpected webhook event: %v", ev)
+	default:
+	}
+}
+
+func TestBalanceMonitor_TopUpPending(t *testing.T) {
+	t.Parallel()
+
+	ethKeyStore := ksmocks.NewEth(t)
+	k0Addr := testutils.NewAddress()
+	treasury := testutils.NewAddress()
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{k0Addr, treasury}, nil)
+	ethClient := newEthClientMock(t)
+
+	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.BalanceMonitor.LowBalanceThreshold = assets.NewWeiI(100)
+		c.BalanceMonitor.TopUp.Enabled = ptr(true)
+		c.BalanceMonitor.TopUp.TreasuryAddress = ptr(types.EIP55AddressFromAddress(treasury))
+		c.BalanceMonitor.TopUp.Amount = assets.NewWeiI(1000)
+		c.BalanceMonitor.TopUp.MinInterval = commonconfig.MustNewDuration(time.Hour)
+	})
+	txm := txmmocks.NewMockEvmTxManager(t)
+
+	// The key was topped up before a restart, and the tx is not confirmed yet: no second top-up is created.
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(50), nil)
+	ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Once().Return(big.NewInt(5000), nil)
+	txm.On("FindTxesByMetaFieldAndStates", mock.Anything, "TopUpAddress", strings.ToLower(k0Addr.Hex()),
+		[]txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed}, mock.Anything).
+		Once().Return([]*txmgr.Tx{{ID: 1}}, nil)
+
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, cfg.EVM(), txm, logger.Test(t))
+	servicetest.Run(t, bm)
+	require.Error(t, bm.HealthReport()[bm.Name()])
+}
+
+func requireWebhookEvent(t *testing.T, events <-chan map[string]any) map[string]any {
+	t.Helper()
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("timed out waiting for webhook event")
+		return nil
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
 func Test_ApproximateFloat64(t *testing.T) {
 	t.Parallel()
 
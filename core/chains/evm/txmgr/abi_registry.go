@@ -0,0 +1,145 @@
+package txmgr
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/libocr/gethwrappers/offchainaggregator"
+	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_automation_registry_master_wrapper_2_2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_automation_registry_master_wrapper_2_3"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_wrapper1_3"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_wrapper2_0"
+	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2plus_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/keystone/generated/forwarder"
+)
+
+var (
+	// revertErrorSelector is the selector of Error(string), used by require and revert with a reason.
+	revertErrorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// revertPanicSelector is the selector of Panic(uint256), used by failing asserts and arithmetic errors.
+	revertPanicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// ABIRegistry decodes the revert data of failed calls, using the built-in Error(string) and Panic(uint256) errors,
+// and the custom errors of the registered contract ABIs. It is safe for concurrent use.
+type ABIRegistry struct {
+	mu     sync.RWMutex
+	errors map[[4]byte]abi.Error
+}
+
+// NewABIRegistry returns an ABIRegistry with the custom errors of the given ABIs.
+func NewABIRegistry(abis ...abi.ABI) *ABIRegistry {
+	r := &ABIRegistry{errors: make(map[[4]byte]abi.Error)}
+	for _, a := range abis {
+		r.Register(a)
+	}
+	return r
+}
+
+// NewDefaultABIRegistry returns an ABIRegistry with the custom errors of the contracts the node transmits to: the OCR
+// aggregators, the keeper and automation registries, the forwarders and the VRF coordinators.
+func NewDefaultABIRegistry() *ABIRegistry {
+	r := NewABIRegistry()
+	for _, md := range []interface{ GetAbi() (*abi.ABI, error) }{
+		offchainaggregator.OffchainAggregatorMetaData,
+		ocr2aggregator.OCR2AggregatorMetaData,
+		keeper_registry_wrapper1_3.KeeperRegistryMetaData,
+		keeper_registry_wrapper2_0.KeeperRegistryMetaData,
+		i_keeper_registry_master_wrapper_2_1.IKeeperRegistryMasterMetaData,
+		i_automation_registry_master_wrapper_2_2.IAutomationRegistryMasterMetaData,
+		i_automation_registry_master_wrapper_2_3.IAutomationRegistryMaster23MetaData,
+		authorized_forwarder.AuthorizedForwarderMetaData,
+		forwarder.KeystoneForwarderMetaData,
+		v2.VRFCoordinatorV2MetaData,
+		vrf_coordinator_v2plus_interface.IVRFCoordinatorV2PlusInternalMetaData,
+	} {
+		if a, err := md.GetAbi(); err == nil {
+			r.Register(*a)
+		}
+	}
+	return r
+}
+
+// Register adds the custom errors of the ABI to the registry.
+func (r *ABIRegistry) Register(a abi.ABI) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range a.Errors {
+		var selector [4]byte
+		copy(selector[:], e.ID[:4])
+		r.errors[selector] = e
+	}
+}
+
+// RegisterJSON adds the custom errors of the JSON encoded ABI to the registry.
+func (r *ABIRegistry) RegisterJSON(abiJSON string) error {
+	a, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return fmt.Errorf("failed to parse ABI: %w", err)
+	}
+	r.Register(a)
+	return nil
+}
+
+// DecodeRevert returns a human-readable revert reason for the revert data, and whether it could be decoded.
+func (r *ABIRegistry) DecodeRevert(data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	selector, args := data[:4], data[4:]
+	switch {
+	case bytes.Equal(selector, revertErrorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return "", false
+		}
+		return reason, true
+	case bytes.Equal(selector, revertPanicSelector):
+		if len(args) != 32 {
+			return "", false
+		}
+		return fmt.Sprintf("panic: 0x%02x", new(big.Int).SetBytes(args)), true
+	}
+
+	r.mu.RLock()
+	e, ok := r.errors[[4]byte(selector)]
+	r.mu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	values, err := e.Inputs.Unpack(args)
+	if err != nil {
+		return "", false
+	}
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = fmt.Sprint(v)
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(strs, ", ")), true
+}
+
+// revertData extracts the revert data from the data field of a JSON-RPC error. RPCs return it either as a hex
+// string, or prefixed with "Reverted ".
+func revertData(data any) ([]byte, bool) {
+	s, ok := data.(string)
+	if !ok {
+		return nil, false
+	}
+	s = strings.TrimPrefix(strings.TrimSpace(s), "Reverted ")
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
@@ -44,17 +44,20 @@ func NewTxm(
 	var fwdMgr FwdMgr
 
 	if txConfig.ForwardersEnabled() {
-		fwdMgr = forwarders.NewFwdMgr(ds, client, logPoller, lggr, chainConfig)
+		fwdMgr = forwarders.NewFwdMgr(ds, client, logPoller, keyStore, lggr, chainConfig, txConfig.Forwarders())
 	} else {
 		lggr.Info("EvmForwarderManager: Disabled")
 	}
-	checker := &CheckerFactory{Client: client}
+	checker := &CheckerFactory{Client: client, ABIs: NewDefaultABIRegistry()}
 	// create tx attempt builder
 	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
 	txStore := NewTxStore(ds, lggr)
 	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
 	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
 	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
+	if estimator != nil {
+		txmClient.WithL1Oracle(chainConfig.ChainType(), estimator.L1Oracle())
+	}
 	chainID := txmClient.ConfiguredChainID()
 	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync(), chainConfig.ChainType())
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
@@ -65,8 +68,9 @@ func NewTxm(
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
-	return txm, nil
+	evmTxm := NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
+	evmFinalizer.SetTxEvents(evmTxm.TxEvents())
+	return evmTxm, nil
 }
 
 // NewEvmTxm creates a new concrete EvmTxm
@@ -19,7 +19,9 @@ import (
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
@@ -28,12 +30,25 @@ var _ TxmClient = (*evmTxmClient)(nil)
 type evmTxmClient struct {
 	client       client.Client
 	clientErrors config.ClientErrors
+	l1Oracle     rollups.L1Oracle
 }
 
 func NewEvmTxmClient(c client.Client, clientErrors config.ClientErrors) *evmTxmClient {
 	return &evmTxmClient{client: c, clientErrors: clientErrors}
 }
 
+// WithL1Oracle sets the rollup oracle used to compute the L1 data fee of receipts
+// which do not report one. It is a no-op for chains which include the L1 cost in gasUsed.
+func (c *evmTxmClient) WithL1Oracle(chainType chaintype.ChainType, l1Oracle rollups.L1Oracle) *evmTxmClient {
+	switch chainType {
+	case chaintype.ChainArbitrum, chaintype.ChainZkSync:
+		// L1 costs are already part of gasUsed
+	default:
+		c.l1Oracle = l1Oracle
+	}
+	return c
+}
+
 func (c *evmTxmClient) PendingSequenceAt(ctx context.Context, addr common.Address) (evmtypes.Nonce, error) {
 	return c.PendingNonceAt(ctx, addr)
 }
@@ -145,9 +160,36 @@ func (c *evmTxmClient) BatchGetReceipts(ctx context.Context, attempts []TxAttemp
 	for _, req := range reqs {
 		txErr = append(txErr, req.Error)
 	}
+	if c.l1Oracle != nil {
+		c.estimateL1Fees(ctx, attempts, txReceipt, txErr)
+	}
 	return txReceipt, txErr, nil
 }
 
+// estimateL1Fees fills in the L1 data fee of rollup receipts which did not report one, using the L1
+// oracle's own cost calculation at the block the transaction was included in. The fee is left unknown
+// if the oracle cannot compute it.
+func (c *evmTxmClient) estimateL1Fees(ctx context.Context, attempts []TxAttempt, receipts []*evmtypes.Receipt, errs []error) {
+	oracle, ok := c.l1Oracle.(rollups.L1GasCostOracle)
+	if !ok {
+		return
+	}
+	for i, receipt := range receipts {
+		if errs[i] != nil || receipt == nil || receipt.IsZero() || receipt.L1Fee != nil || len(attempts[i].SignedRawTx) == 0 {
+			continue
+		}
+		tx, err := GetGethSignedTx(attempts[i].SignedRawTx)
+		if err != nil {
+			continue
+		}
+		fee, err := oracle.GetGasCost(ctx, tx, receipt.BlockNumber)
+		if err != nil || fee == nil {
+			continue
+		}
+		receipt.L1Fee = fee.ToInt()
+	}
+}
+
 // sendEmptyTransaction sends a transaction with 0 Eth and an empty payload to the burn address
 // May be useful for clearing stuck nonces
 func (c *evmTxmClient) SendEmptyTransaction(
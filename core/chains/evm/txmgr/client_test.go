@@ -0,0 +1,111 @@
+package txmgr_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	clientmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
+	rollupsmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+)
+
+func TestEvmTxmClient_BatchGetReceipts_L1Fee(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := gethtypes.LatestSignerForChainID(testutils.FixtureChainID)
+	signedTx, err := gethtypes.SignNewTx(key, signer, &gethtypes.LegacyTx{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1), Data: []byte{1, 0, 1}})
+	require.NoError(t, err)
+	rawTx, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+
+	reported := txmgr.TxAttempt{Hash: utils.NewHash(), SignedRawTx: rawTx}
+	estimated := txmgr.TxAttempt{Hash: utils.NewHash(), SignedRawTx: rawTx}
+	missing := txmgr.TxAttempt{Hash: utils.NewHash(), SignedRawTx: rawTx}
+	attempts := []txmgr.TxAttempt{reported, estimated, missing}
+
+	newEthClient := func(t *testing.T) *clientmocks.Client {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 3
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			*(elems[0].Result.(*evmtypes.Receipt)) = evmtypes.Receipt{TxHash: reported.Hash, L1Fee: big.NewInt(42)}
+			*(elems[1].Result.(*evmtypes.Receipt)) = evmtypes.Receipt{TxHash: estimated.Hash, BlockNumber: big.NewInt(7)}
+			// the third receipt is not found
+		}).Once()
+		return ethClient
+	}
+
+	t.Run("fills in missing L1 fees with the L1 oracle's gas cost", func(t *testing.T) {
+		l1Oracle := &l1GasCostOracle{L1Oracle: rollupsmocks.NewL1Oracle(t), getGasCost: func(tx *gethtypes.Transaction, blockNum *big.Int) (*assets.Wei, error) {
+			assert.Equal(t, signedTx.Hash(), tx.Hash())
+			assert.Equal(t, big.NewInt(7), blockNum)
+			return assets.NewWeiI(360), nil
+		}}
+		c := txmgr.NewEvmTxmClient(newEthClient(t), nil).WithL1Oracle(chaintype.ChainOptimismBedrock, l1Oracle)
+
+		receipts, errs, err := c.BatchGetReceipts(tests.Context(t), attempts)
+		require.NoError(t, err)
+		require.Len(t, errs, 3)
+		assert.Equal(t, big.NewInt(42), receipts[0].L1Fee)
+		assert.Equal(t, big.NewInt(360), receipts[1].L1Fee)
+		assert.Nil(t, receipts[2].L1Fee)
+	})
+
+	t.Run("leaves L1 fees unknown if the L1 oracle cannot compute them", func(t *testing.T) {
+		l1Oracle := &l1GasCostOracle{L1Oracle: rollupsmocks.NewL1Oracle(t), getGasCost: func(*gethtypes.Transaction, *big.Int) (*assets.Wei, error) {
+			return nil, errors.New("getL1Fee() call failed")
+		}}
+		c := txmgr.NewEvmTxmClient(newEthClient(t), nil).WithL1Oracle(chaintype.ChainOptimismBedrock, l1Oracle)
+
+		receipts, _, err := c.BatchGetReceipts(tests.Context(t), attempts)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), receipts[0].L1Fee)
+		assert.Nil(t, receipts[1].L1Fee)
+
+		// the oracle does not implement GetGasCost
+		c = txmgr.NewEvmTxmClient(newEthClient(t), nil).WithL1Oracle(chaintype.ChainScroll, rollupsmocks.NewL1Oracle(t))
+
+		receipts, _, err = c.BatchGetReceipts(tests.Context(t), attempts)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), receipts[0].L1Fee)
+		assert.Nil(t, receipts[1].L1Fee)
+	})
+
+	t.Run("does not estimate L1 fees for chains which include them in gasUsed", func(t *testing.T) {
+		l1Oracle := rollupsmocks.NewL1Oracle(t)
+		c := txmgr.NewEvmTxmClient(newEthClient(t), nil).WithL1Oracle(chaintype.ChainArbitrum, l1Oracle)
+
+		receipts, _, err := c.BatchGetReceipts(tests.Context(t), attempts)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), receipts[0].L1Fee)
+		assert.Nil(t, receipts[1].L1Fee)
+	})
+}
+
+type l1GasCostOracle struct {
+	*rollupsmocks.L1Oracle
+	getGasCost func(tx *gethtypes.Transaction, blockNum *big.Int) (*assets.Wei, error)
+}
+
+func (o *l1GasCostOracle) GetGasCost(_ context.Context, tx *gethtypes.Transaction, blockNum *big.Int) (*assets.Wei, error) {
+	return o.getGasCost(tx, blockNum)
+}
@@ -0,0 +1,121 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commontxmgr "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func newTestTxEvents(t *testing.T) *txmgr.TxEvents {
+	return commontxmgr.NewTxEvents[common.Address, common.Hash](logger.Test(t))
+}
+
+func TestTxEvents_NewTxAttemptEvent(t *testing.T) {
+	t.Parallel()
+
+	nonce := types.Nonce(7)
+	key := "key"
+	runID := uuid.New()
+	tx := txmgr.Tx{
+		ID:                1,
+		IdempotencyKey:    &key,
+		FromAddress:       testutils.NewAddress(),
+		ToAddress:         testutils.NewAddress(),
+		Sequence:          &nonce,
+		PipelineTaskRunID: uuid.NullUUID{UUID: runID, Valid: true},
+	}
+	attempt := txmgr.TxAttempt{TxID: 1, Hash: utils.NewHash()}
+
+	ev := commontxmgr.NewTxAttemptEvent(commontxmgr.TxEventBump, tx, attempt)
+	assert.Equal(t, commontxmgr.TxEventBump, ev.Type)
+	assert.Equal(t, tx.ID, ev.TxID)
+	assert.Equal(t, &key, ev.IdempotencyKey)
+	assert.Equal(t, tx.FromAddress, ev.FromAddress)
+	assert.Equal(t, tx.ToAddress, ev.ToAddress)
+	require.NotNil(t, ev.Sequence)
+	assert.Equal(t, int64(7), *ev.Sequence)
+	require.NotNil(t, ev.TxHash)
+	assert.Equal(t, attempt.Hash, *ev.TxHash)
+	assert.Equal(t, runID, ev.PipelineTaskRunID.UUID)
+
+	t.Run("uses the attempt tx ID when the tx is not loaded", func(t *testing.T) {
+		ev := commontxmgr.NewTxAttemptEvent(commontxmgr.TxEventResend, txmgr.Tx{}, attempt)
+		assert.Equal(t, attempt.TxID, ev.TxID)
+		assert.Nil(t, ev.Sequence)
+	})
+}
+
+func TestTxEvents_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delivers matching events", func(t *testing.T) {
+		events := newTestTxEvents(t)
+		all := events.Subscribe(nil)
+		defer all.Close()
+		confirmed := events.Subscribe(func(ev txmgr.TxEvent) bool { return ev.Type == commontxmgr.TxEventConfirmed })
+		defer confirmed.Close()
+
+		events.Publish(txmgr.TxEvent{Type: commontxmgr.TxEventUnconfirmed, TxID: 1})
+		events.Publish(txmgr.TxEvent{Type: commontxmgr.TxEventConfirmed, TxID: 1})
+
+		assert.Equal(t, commontxmgr.TxEventUnconfirmed, (<-all.Events()).Type)
+		assert.Equal(t, commontxmgr.TxEventConfirmed, (<-all.Events()).Type)
+		assert.Equal(t, commontxmgr.TxEventConfirmed, (<-confirmed.Events()).Type)
+		assert.Empty(t, confirmed.Events())
+	})
+
+	t.Run("drops events for a full subscription without blocking", func(t *testing.T) {
+		events := newTestTxEvents(t)
+		sub := events.Subscribe(nil)
+		defer sub.Close()
+
+		for i := 0; i < 2*cap(sub.Events()); i++ {
+			events.Publish(txmgr.TxEvent{Type: commontxmgr.TxEventResend, TxID: int64(i)})
+		}
+		assert.Len(t, sub.Events(), cap(sub.Events()))
+		assert.Equal(t, int64(0), (<-sub.Events()).TxID)
+	})
+
+	t.Run("stops delivering when closed", func(t *testing.T) {
+		events := newTestTxEvents(t)
+		sub := events.Subscribe(nil)
+		sub.Close()
+		sub.Close()
+
+		events.Publish(txmgr.TxEvent{Type: commontxmgr.TxEventConfirmed})
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+	})
+
+	t.Run("closes subscriptions when closed", func(t *testing.T) {
+		events := newTestTxEvents(t)
+		sub := events.Subscribe(nil)
+		events.Close()
+		sub.Close()
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+
+		_, ok = <-events.Subscribe(nil).Events()
+		assert.False(t, ok)
+		events.Publish(txmgr.TxEvent{Type: commontxmgr.TxEventConfirmed})
+	})
+
+	t.Run("nil events", func(t *testing.T) {
+		var events *txmgr.TxEvents
+		sub := events.Subscribe(nil)
+		events.Publish(txmgr.TxEvent{Type: commontxmgr.TxEventConfirmed})
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+	})
+}
@@ -37,8 +37,14 @@ var (
 	// ErrCouldNotGetReceipt is the error string we save if we reach our LatestFinalizedBlockNum for a confirmed transaction
 	// without ever getting a receipt. This most likely happened because an external wallet used the account for this nonce
 	ErrCouldNotGetReceipt = "could not get receipt"
+	// ErrJobSpendCapExceeded is returned by CreateTransaction when the job has already spent its
+	// MaxDailyGasSpend on the chain over the last 24 hours
+	ErrJobSpendCapExceeded = errors.New("job daily gas spend cap exceeded")
 )
 
+// jobSpendCapWindow is the rolling window over which a job's MaxDailyGasSpend is enforced
+const jobSpendCapWindow = 24 * time.Hour
+
 // EvmTxStore combines the txmgr tx store interface and the interface needed for the API to read from the tx DB
 type EvmTxStore interface {
 	// redeclare TxStore for mockery
@@ -47,7 +53,7 @@ type EvmTxStore interface {
 
 	// methods used solely in EVM components
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []Receipt, err error)
-	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error
+	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) ([]Tx, error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -60,6 +66,21 @@ type TxStoreWebApi interface {
 	FindTxAttempt(ctx context.Context, hash common.Hash) (*TxAttempt, error)
 	FindTxWithAttempts(ctx context.Context, etxID int64) (etx Tx, err error)
 	FindTxsByStateAndFromAddresses(ctx context.Context, addresses []common.Address, state txmgrtypes.TxState, chainID *big.Int) (txs []*Tx, err error)
+	TxSpendByJob(ctx context.Context, chainID *big.Int, since time.Time) ([]TxSpend, error)
+	TxSpendByKey(ctx context.Context, chainID *big.Int, since time.Time) ([]TxSpend, error)
+}
+
+// TxSpend is the native token spent on gas by confirmed transactions, aggregated per chain
+// and either per job or per sending key. L1 data fees which are not known are left out of
+// L1Fee and TotalFee.
+type TxSpend struct {
+	EVMChainID  ubig.Big
+	JobID       *int32
+	FromAddress *common.Address
+	TxCount     int64
+	GasUsed     int64
+	L1Fee       assets.Wei
+	TotalFee    assets.Wei
 }
 
 type TestEvmTxStore interface {
@@ -175,8 +196,9 @@ type DbEthTx struct {
 	Value          assets.Eth
 	// GasLimit on the EthTx is always the conceptual gas limit, which is not
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
-	GasLimit uint64
-	Error    nullv4.String
+	GasLimit     uint64
+	Error        nullv4.String
+	RevertReason nullv4.String
 	// BroadcastAt is updated every time an attempt for this eth_tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt *time.Time
@@ -188,6 +210,7 @@ type DbEthTx struct {
 	// at send time.
 	Meta              *sqlutil.JSON
 	Subject           uuid.NullUUID
+	Priority          txmgrtypes.TxPriority
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  null.Uint32
 	EVMChainID        ubig.Big
@@ -210,11 +233,13 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.Value = assets.Eth(tx.Value)
 	db.GasLimit = tx.FeeLimit
 	db.Error = tx.Error
+	db.RevertReason = tx.RevertReason
 	db.BroadcastAt = tx.BroadcastAt
 	db.CreatedAt = tx.CreatedAt
 	db.State = tx.State
 	db.Meta = tx.Meta
 	db.Subject = tx.Subject
+	db.Priority = tx.Priority
 	db.PipelineTaskRunID = tx.PipelineTaskRunID
 	db.MinConfirmations = tx.MinConfirmations
 	db.TransmitChecker = tx.TransmitChecker
@@ -244,11 +269,13 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.Value = *db.Value.ToInt()
 	tx.FeeLimit = db.GasLimit
 	tx.Error = db.Error
+	tx.RevertReason = db.RevertReason
 	tx.BroadcastAt = db.BroadcastAt
 	tx.CreatedAt = db.CreatedAt
 	tx.State = db.State
 	tx.Meta = db.Meta
 	tx.Subject = db.Subject
+	tx.Priority = db.Priority
 	tx.PipelineTaskRunID = db.PipelineTaskRunID
 	tx.MinConfirmations = db.MinConfirmations
 	tx.ChainID = db.EVMChainID.ToInt()
@@ -530,8 +557,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, revert_reason, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, priority, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :revert_reason, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :priority, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -978,8 +1005,204 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 
 	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
 
-	_, err = o.q.ExecContext(ctx, stmt, valueArgs...)
-	return pkgerrors.Wrap(err, "SaveFetchedReceipts failed to save receipts")
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		if _, err = orm.q.ExecContext(ctx, stmt, valueArgs...); err != nil {
+			return pkgerrors.Wrap(err, "SaveFetchedReceipts failed to save receipts")
+		}
+		return pkgerrors.Wrap(orm.saveTxSpend(ctx, receipts, chainID), "SaveFetchedReceipts failed to save tx spend")
+	})
+}
+
+// saveTxSpend records the gas spent by each receipt's transaction. The fee is gasUsed multiplied by
+// the effective gas price reported by the receipt, falling back to the price of the attempt for nodes
+// which do not report it, plus the L1 data fee for rollups. An L1 fee which is not known is stored as
+// NULL and left out of the total.
+func (o *evmTxStore) saveTxSpend(ctx context.Context, receipts []rawOnchainReceipt, chainID *big.Int) error {
+	var valueStrs []string
+	var valueArgs []interface{}
+	for _, r := range receipts {
+		var effectiveGasPrice, l1Fee interface{}
+		if r.EffectiveGasPrice != nil {
+			effectiveGasPrice = r.EffectiveGasPrice.String()
+		}
+		if r.L1Fee != nil {
+			l1Fee = r.L1Fee.String()
+		}
+		valueStrs = append(valueStrs, "(?::bytea,?::bigint,?::numeric,?::numeric)")
+		valueArgs = append(valueArgs, r.TxHash, r.GasUsed, effectiveGasPrice, l1Fee)
+	}
+	valueArgs = append(valueArgs, chainID.String())
+
+	/* #nosec G201 */
+	sql := `
+	INSERT INTO evm.tx_spend (eth_tx_id, evm_chain_id, from_address, job_id, tx_hash, gas_used, effective_gas_price, l1_fee, total_fee, created_at)
+	SELECT evm.txes.id, evm.txes.evm_chain_id, evm.txes.from_address,
+		COALESCE((evm.txes.meta->>'JobID')::int, (
+			SELECT job_pipeline_specs.job_id FROM pipeline_task_runs
+			JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+			JOIN job_pipeline_specs ON job_pipeline_specs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+			WHERE pipeline_task_runs.id = evm.txes.pipeline_task_run_id
+			LIMIT 1
+		)),
+		spend.tx_hash, spend.gas_used, spend.price, spend.l1_fee, spend.gas_used * spend.price + COALESCE(spend.l1_fee, 0), NOW()
+	FROM (
+		SELECT v.tx_hash, v.gas_used,
+			COALESCE(v.effective_gas_price, evm.tx_attempts.gas_price, evm.tx_attempts.gas_fee_cap, 0) AS price,
+			v.l1_fee,
+			evm.tx_attempts.eth_tx_id
+		FROM (VALUES %s) AS v(tx_hash, gas_used, effective_gas_price, l1_fee)
+		JOIN evm.tx_attempts ON evm.tx_attempts.hash = v.tx_hash
+	) AS spend
+	JOIN evm.txes ON evm.txes.id = spend.eth_tx_id
+	WHERE evm.txes.evm_chain_id = ?
+	ON CONFLICT (eth_tx_id) DO UPDATE SET
+		tx_hash = EXCLUDED.tx_hash,
+		gas_used = EXCLUDED.gas_used,
+		effective_gas_price = EXCLUDED.effective_gas_price,
+		l1_fee = EXCLUDED.l1_fee,
+		total_fee = EXCLUDED.total_fee
+	`
+
+	stmt := sqlx.Rebind(sqlx.DOLLAR, fmt.Sprintf(sql, strings.Join(valueStrs, ",")))
+	_, err := o.q.ExecContext(ctx, stmt, valueArgs...)
+	return err
+}
+
+// TxSpendByJob returns the gas spent by each job's transactions created since the given time.
+// If chainID is nil, spend is returned for all chains.
+func (o *evmTxStore) TxSpendByJob(ctx context.Context, chainID *big.Int, since time.Time) (spend []TxSpend, err error) {
+	return o.txSpend(ctx, "job_id", chainID, since)
+}
+
+// TxSpendByKey returns the gas spent by each key's transactions created since the given time.
+// If chainID is nil, spend is returned for all chains.
+func (o *evmTxStore) TxSpendByKey(ctx context.Context, chainID *big.Int, since time.Time) (spend []TxSpend, err error) {
+	return o.txSpend(ctx, "from_address", chainID, since)
+}
+
+type dbTxSpend struct {
+	EVMChainID  ubig.Big
+	JobID       *int32
+	FromAddress []byte
+	TxCount     int64
+	GasUsed     int64
+	L1Fee       assets.Wei
+	TotalFee    assets.Wei
+}
+
+func (o *evmTxStore) txSpend(ctx context.Context, groupBy string, chainID *big.Int, since time.Time) (spend []TxSpend, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var chain *string
+	if chainID != nil {
+		s := chainID.String()
+		chain = &s
+	}
+	var jobID, fromAddress string
+	switch groupBy {
+	case "job_id":
+		jobID, fromAddress = "job_id", "NULL::bytea"
+	case "from_address":
+		jobID, fromAddress = "NULL::int", "from_address"
+	default:
+		return nil, fmt.Errorf("invalid tx spend grouping: %s", groupBy)
+	}
+	/* #nosec G201 */
+	stmt := fmt.Sprintf(`
+	SELECT evm_chain_id, %[2]s AS job_id, %[3]s AS from_address, COUNT(*) AS tx_count,
+		SUM(gas_used) AS gas_used, COALESCE(SUM(l1_fee), 0) AS l1_fee, SUM(total_fee) AS total_fee
+	FROM evm.tx_spend
+	WHERE created_at >= $1 AND ($2::numeric IS NULL OR evm_chain_id = $2) AND %[1]s IS NOT NULL
+	GROUP BY evm_chain_id, %[1]s
+	ORDER BY evm_chain_id, total_fee DESC
+	`, groupBy, jobID, fromAddress)
+	var dbSpend []dbTxSpend
+	if err = o.q.SelectContext(ctx, &dbSpend, stmt, since, chain); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to load evm.tx_spend")
+	}
+	spend = make([]TxSpend, len(dbSpend))
+	for i, s := range dbSpend {
+		spend[i] = TxSpend{EVMChainID: s.EVMChainID, JobID: s.JobID, TxCount: s.TxCount, GasUsed: s.GasUsed, L1Fee: s.L1Fee, TotalFee: s.TotalFee}
+		if s.FromAddress != nil {
+			addr := common.BytesToAddress(s.FromAddress)
+			spend[i].FromAddress = &addr
+		}
+	}
+	return spend, nil
+}
+
+// checkJobSpendCap returns ErrJobSpendCapExceeded if the job creating the transaction has a
+// MaxDailyGasSpend and has already spent at least that much on this chain within the window.
+// Transactions of the job which are still in flight count as their gas limit multiplied by the
+// highest fee cap of their attempts, or of the latest attempt on the chain if none was made yet.
+//
+// The job's row is locked for the rest of the transaction, so that concurrent transactions of the
+// same job are checked one at a time and each sees the transactions created before it.
+func (o *evmTxStore) checkJobSpendCap(ctx context.Context, txRequest TxRequest, chainID *big.Int) error {
+	var jobID *int32
+	if txRequest.Meta != nil {
+		jobID = txRequest.Meta.JobID
+	}
+	if jobID == nil && txRequest.PipelineTaskRunID == nil {
+		return nil
+	}
+	var job struct {
+		ID               int32
+		MaxDailyGasSpend assets.Wei
+	}
+	err := o.q.GetContext(ctx, &job, `
+SELECT jobs.id, jobs.max_daily_gas_spend FROM jobs
+WHERE jobs.max_daily_gas_spend IS NOT NULL AND jobs.id = COALESCE($1::int, (
+	SELECT pipeline_runs.pruning_key FROM pipeline_task_runs
+	JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+	WHERE pipeline_task_runs.id = $2::uuid
+))
+FOR UPDATE`, jobID, txRequest.PipelineTaskRunID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return pkgerrors.Wrap(err, "failed to lock job to check its gas spend cap")
+	}
+
+	// The spend is loaded by a separate statement, so that its snapshot is taken after the lock was acquired.
+	var res struct {
+		Spent    assets.Wei
+		InFlight assets.Wei
+	}
+	err = o.q.GetContext(ctx, &res, `
+WITH latest_fee AS (
+	SELECT COALESCE(evm.tx_attempts.gas_fee_cap, evm.tx_attempts.gas_price) AS fee FROM evm.tx_attempts
+	JOIN evm.txes ON evm.txes.id = evm.tx_attempts.eth_tx_id
+	WHERE evm.txes.evm_chain_id = $2
+	ORDER BY evm.tx_attempts.id DESC
+	LIMIT 1
+)
+SELECT
+	COALESCE((
+		SELECT SUM(total_fee) FROM evm.tx_spend
+		WHERE evm.tx_spend.job_id = $1 AND evm.tx_spend.evm_chain_id = $2 AND evm.tx_spend.created_at > $3
+	), 0) AS spent,
+	COALESCE((
+		SELECT SUM(evm.txes.gas_limit * COALESCE((
+			SELECT MAX(COALESCE(evm.tx_attempts.gas_fee_cap, evm.tx_attempts.gas_price)) FROM evm.tx_attempts
+			WHERE evm.tx_attempts.eth_tx_id = evm.txes.id
+		), (SELECT fee FROM latest_fee), 0))
+		FROM evm.txes
+		LEFT JOIN pipeline_task_runs ON pipeline_task_runs.id = evm.txes.pipeline_task_run_id
+		LEFT JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+		WHERE evm.txes.evm_chain_id = $2
+		AND evm.txes.state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt')
+		AND COALESCE((evm.txes.meta->>'JobID')::int, pipeline_runs.pruning_key) = $1
+	), 0) AS in_flight`, job.ID, chainID.String(), time.Now().Add(-jobSpendCapWindow))
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to check job gas spend cap")
+	}
+	total := res.Spent.Add(&res.InFlight)
+	if total.Cmp(&job.MaxDailyGasSpend) >= 0 {
+		return fmt.Errorf("%w: job %d has spent %s and has %s in flight of its %s cap on chain %s in the last %s", ErrJobSpendCapExceeded, job.ID, res.Spent.String(), res.InFlight.String(), job.MaxDailyGasSpend.String(), chainID.String(), jobSpendCapWindow)
+	}
+	return nil
 }
 
 // MarkAllConfirmedMissingReceipt
@@ -1175,7 +1398,11 @@ USING evm.tx_attempts
 WHERE evm.receipts.tx_hash = evm.tx_attempts.hash
 AND evm.tx_attempts.eth_tx_id = $1
 	`, etxID)
-	return pkgerrors.Wrap(err, "deleteEthReceipts failed")
+	if err != nil {
+		return pkgerrors.Wrap(err, "deleteEthReceipts failed")
+	}
+	_, err = orm.q.ExecContext(ctx, `DELETE FROM evm.tx_spend WHERE eth_tx_id = $1`, etxID)
+	return pkgerrors.Wrap(err, "deleteEthReceipts failed to delete tx spend")
 }
 
 func (o *evmTxStore) UpdateTxForRebroadcast(ctx context.Context, etx Tx, etxAttempt TxAttempt) error {
@@ -1593,13 +1820,22 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
-func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
+// Finds the saved transaction with the highest priority that has yet to be broadcast from the given address, the
+// earliest one if there are several. Transactions which have waited for longer than starvationTimeout are treated as
+// high priority, unless it is zero.
+func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, starvationTimeout time.Duration, chainID *big.Int) (*Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
+	// the zero time starves nothing
+	var starvedBefore time.Time
+	if starvationTimeout > 0 {
+		starvedBefore = time.Now().Add(-starvationTimeout)
+	}
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2
+ORDER BY CASE WHEN created_at < $3 THEN GREATEST(priority, $4) ELSE priority END DESC, value ASC, created_at ASC, id ASC`,
+		fromAddress, chainID.String(), starvedBefore, txmgrtypes.TxPriorityHigh)
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1629,7 +1865,7 @@ func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 		}
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
+		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, revert_reason=$3, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$4 RETURNING *`, etx.State, etx.Error, etx.RevertReason, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 		dbEtx.ToTx(etx)
 		return err
 	})
@@ -1835,6 +2071,26 @@ func (o *evmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress commo
 	return
 }
 
+func (o *evmTxStore) CheckTxQueueCapacityByPriority(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, priority txmgrtypes.TxPriority, chainID *big.Int) (err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if maxQueuedTransactions == 0 {
+		return nil
+	}
+	var count uint64
+	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 AND priority = $3`, fromAddress, chainID.String(), priority)
+	if err != nil {
+		err = pkgerrors.Wrap(err, "CheckTxQueueCapacityByPriority query failed")
+		return
+	}
+
+	if count >= maxQueuedTransactions {
+		err = pkgerrors.Errorf("cannot create transaction; too many unstarted %s priority transactions in the queue (%v/%v)", priority, count, maxQueuedTransactions)
+	}
+	return
+}
+
 func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest, chainID *big.Int) (tx Tx, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1852,13 +2108,16 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 				return nil
 			}
 		}
+		if err = orm.checkJobSpendCap(ctx, txRequest, chainID); err != nil {
+			return err
+		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, priority)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txmgrtypes.TxPriorityOf(txRequest.Strategy))
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1897,6 +2156,34 @@ id < (
 	return
 }
 
+func (o *evmTxStore) PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority txmgrtypes.TxPriority) (ids []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		err := orm.q.SelectContext(ctx, &ids, `
+DELETE FROM evm.txes
+WHERE state = 'unstarted' AND subject = $1 AND priority = $2 AND
+id < (
+	SELECT min(id) FROM (
+		SELECT id
+		FROM evm.txes
+		WHERE state = 'unstarted' AND subject = $1 AND priority = $2
+		ORDER BY id DESC
+		LIMIT $3
+	) numbers
+) RETURNING id`, subject, priority, queueSize)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("PruneUnstartedTxQueueByPriority failed: %w", err)
+		}
+		return err
+	})
+	return
+}
+
 func (o *evmTxStore) ReapTxHistory(ctx context.Context, timeThreshold time.Time, chainID *big.Int) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1983,6 +2270,15 @@ AND evm_chain_id = $3`, limit, timeThreshold, chainID.String())
 	if err != nil {
 		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes batch delete of confirmed evm.txes failed")
 	}
+	// Delete old evm.tx_spend records of transactions which are still kept, keeping at least the window
+	// needed to enforce job spend caps. Records of reaped evm.txes are removed with them by a foreign key.
+	spendThreshold := timeThreshold
+	if capThreshold := time.Now().Add(-jobSpendCapWindow); capThreshold.Before(spendThreshold) {
+		spendThreshold = capThreshold
+	}
+	if _, err = o.q.ExecContext(ctx, `DELETE FROM evm.tx_spend WHERE created_at < $1 AND evm_chain_id = $2`, spendThreshold, chainID.String()); err != nil {
+		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes failed to delete old evm.tx_spend")
+	}
 
 	return nil
 }
@@ -2128,19 +2424,32 @@ func (o *evmTxStore) FindConfirmedTxesReceipts(ctx context.Context, finalizedBlo
 }
 
 // Mark transactions corresponding to receipt IDs as finalized
-func (o *evmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, receiptIDs []int64, chainId *big.Int) error {
+// UpdateTxStatesToFinalizedUsingReceiptIds marks as finalized the transactions of the receipts, and returns them, each
+// loaded with the attempt included on-chain.
+func (o *evmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, receiptIDs []int64, chainId *big.Int) ([]Tx, error) {
 	if len(receiptIDs) == 0 {
-		return nil
+		return nil, nil
 	}
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	sql := `
-UPDATE evm.txes SET state = 'finalized' WHERE evm.txes.evm_chain_id = $1 AND evm.txes.id IN (SELECT evm.txes.id FROM evm.txes
-	INNER JOIN evm.tx_attempts ON evm.tx_attempts.eth_tx_id = evm.txes.id
-	INNER JOIN evm.receipts ON evm.receipts.tx_hash = evm.tx_attempts.hash
-	WHERE evm.receipts.id = ANY($2))
+UPDATE evm.txes SET state = 'finalized' FROM evm.tx_attempts, evm.receipts
+	WHERE evm.txes.evm_chain_id = $1 AND evm.tx_attempts.eth_tx_id = evm.txes.id
+	AND evm.receipts.tx_hash = evm.tx_attempts.hash AND evm.receipts.id = ANY($2)
+	RETURNING evm.txes.*, evm.tx_attempts.hash AS attempt_hash
 `
-	_, err := o.q.ExecContext(ctx, sql, chainId.String(), pq.Array(receiptIDs))
-	return err
+	var dbEtxs []struct {
+		DbEthTx
+		AttemptHash common.Hash `db:"attempt_hash"`
+	}
+	if err := o.q.SelectContext(ctx, &dbEtxs, sql, chainId.String(), pq.Array(receiptIDs)); err != nil {
+		return nil, err
+	}
+	etxs := make([]Tx, len(dbEtxs))
+	for i, dbEtx := range dbEtxs {
+		dbEtx.ToTx(&etxs[i])
+		etxs[i].TxAttempts = []TxAttempt{{TxID: dbEtx.ID, Hash: dbEtx.AttemptHash}}
+	}
+	return etxs, nil
 }
@@ -533,6 +533,123 @@ func TestORM_SaveFetchedReceipts(t *testing.T) {
 	require.Equal(t, txmgrcommon.TxConfirmed, etx0.State)
 }
 
+func TestORM_TxSpend(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	chainID := ethClient.ConfiguredChainID()
+	ctx := tests.Context(t)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	etx := mustInsertConfirmedMissingReceiptEthTxWithLegacyAttempt(t, txStore, 0, 1, time.Now(), fromAddress)
+	_, err := db.Exec(`UPDATE evm.txes SET meta = jsonb_build_object('JobID', $1::int) WHERE id = $2`, jb.ID, etx.ID)
+	require.NoError(t, err)
+
+	receipt := evmtypes.Receipt{
+		TxHash:            etx.TxAttempts[0].Hash,
+		BlockHash:         utils.NewHash(),
+		BlockNumber:       big.NewInt(42),
+		GasUsed:           21_000,
+		EffectiveGasPrice: big.NewInt(10),
+		L1Fee:             big.NewInt(5),
+	}
+	require.NoError(t, txStore.SaveFetchedReceipts(ctx, []*evmtypes.Receipt{&receipt}, txmgrcommon.TxConfirmed, nil, chainID))
+
+	byJob, err := txStore.TxSpendByJob(ctx, chainID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, byJob, 1)
+	require.NotNil(t, byJob[0].JobID)
+	assert.Equal(t, jb.ID, *byJob[0].JobID)
+	assert.Nil(t, byJob[0].FromAddress)
+	assert.Equal(t, int64(1), byJob[0].TxCount)
+	assert.Equal(t, int64(21_000), byJob[0].GasUsed)
+	assert.Equal(t, assets.NewWeiI(5), &byJob[0].L1Fee)
+	assert.Equal(t, assets.NewWeiI(21_000*10+5), &byJob[0].TotalFee)
+
+	byKey, err := txStore.TxSpendByKey(ctx, nil, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, byKey, 1)
+	require.NotNil(t, byKey[0].FromAddress)
+	assert.Equal(t, fromAddress, *byKey[0].FromAddress)
+	assert.Nil(t, byKey[0].JobID)
+	assert.Equal(t, assets.NewWeiI(21_000*10+5), &byKey[0].TotalFee)
+
+	byJob, err = txStore.TxSpendByJob(ctx, chainID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, byJob)
+
+	t.Run("enforces the job daily spend cap in CreateTransaction", func(t *testing.T) {
+		txRequest := txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21_000,
+			Meta:           &txmgr.TxMeta{JobID: &jb.ID},
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		}
+		_, err := txStore.CreateTransaction(ctx, txRequest, chainID)
+		require.NoError(t, err)
+
+		_, err = db.Exec(`UPDATE jobs SET max_daily_gas_spend = $1 WHERE id = $2`, 1_000_000, jb.ID)
+		require.NoError(t, err)
+		_, err = txStore.CreateTransaction(ctx, txRequest, chainID)
+		require.NoError(t, err)
+
+		_, err = db.Exec(`UPDATE jobs SET max_daily_gas_spend = $1 WHERE id = $2`, 21_000*10+5, jb.ID)
+		require.NoError(t, err)
+		_, err = txStore.CreateTransaction(ctx, txRequest, chainID)
+		require.ErrorIs(t, err, txmgr.ErrJobSpendCapExceeded)
+
+		// The two unstarted transactions count at their gas limit and the latest fee cap on the chain, 1 wei
+		inFlight := int64(2 * 21_000)
+		_, err = db.Exec(`UPDATE jobs SET max_daily_gas_spend = $1 WHERE id = $2`, 21_000*10+5+inFlight, jb.ID)
+		require.NoError(t, err)
+		_, err = txStore.CreateTransaction(ctx, txRequest, chainID)
+		require.ErrorIs(t, err, txmgr.ErrJobSpendCapExceeded)
+
+		_, err = db.Exec(`UPDATE jobs SET max_daily_gas_spend = $1 WHERE id = $2`, 21_000*10+5+inFlight+1, jb.ID)
+		require.NoError(t, err)
+		_, err = txStore.CreateTransaction(ctx, txRequest, chainID)
+		require.NoError(t, err)
+
+		txRequest.Meta = nil
+		_, err = txStore.CreateTransaction(ctx, txRequest, chainID)
+		require.NoError(t, err)
+	})
+
+	t.Run("removes spend when the receipt is removed by a re-org", func(t *testing.T) {
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.NoError(t, txStore.UpdateTxForRebroadcast(ctx, etx, etx.TxAttempts[0]))
+		cltest.AssertCount(t, db, "evm.tx_spend", 0)
+	})
+
+	t.Run("leaves an unknown L1 fee out of the total", func(t *testing.T) {
+		receipt.L1Fee = nil
+		require.NoError(t, txStore.SaveFetchedReceipts(ctx, []*evmtypes.Receipt{&receipt}, txmgrcommon.TxConfirmed, nil, chainID))
+
+		var l1Fee *assets.Wei
+		require.NoError(t, db.Get(&l1Fee, `SELECT l1_fee FROM evm.tx_spend WHERE eth_tx_id = $1`, etx.ID))
+		assert.Nil(t, l1Fee)
+
+		byJob, err := txStore.TxSpendByJob(ctx, chainID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, byJob, 1)
+		assert.Equal(t, assets.NewWeiI(0), &byJob[0].L1Fee)
+		assert.Equal(t, assets.NewWeiI(21_000*10), &byJob[0].TotalFee)
+	})
+
+	t.Run("removes spend with its transaction", func(t *testing.T) {
+		_, err := db.Exec(`DELETE FROM evm.txes WHERE id = $1`, etx.ID)
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "evm.tx_spend", 0)
+	})
+}
+
 func TestORM_MarkAllConfirmedMissingReceipt(t *testing.T) {
 	t.Parallel()
 
@@ -1254,21 +1371,84 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 
 	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	const starvationTimeout = 5 * time.Minute
 
 	t.Run("cannot find unstarted tx", func(t *testing.T) {
 		mustInsertInProgressEthTxWithAttempt(t, txStore, 13, fromAddress)
 
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, starvationTimeout, ethClient.ConfiguredChainID())
 		assert.ErrorIs(t, err, sql.ErrNoRows)
 		assert.Nil(t, resultEtx)
 	})
 
 	t.Run("finds unstarted tx", func(t *testing.T) {
 		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, starvationTimeout, ethClient.ConfiguredChainID())
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
 	})
+
+	t.Run("finds highest priority tx, unless a lower priority one is starving", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		low := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithStrategy(txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgrtypes.TxPriorityLow, 0)))
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		high := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+			txRequestWithStrategy(txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgrtypes.TxPriorityHigh, 0)))
+
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, starvationTimeout, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, high.ID, resultEtx.ID)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, resultEtx.Priority)
+
+		_, err = db.ExecContext(tests.Context(t), `UPDATE evm.txes SET created_at = $1 WHERE id = $2`, time.Now().Add(-2*starvationTimeout), low.ID)
+		require.NoError(t, err)
+		resultEtx, err = txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, starvationTimeout, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, low.ID, resultEtx.ID)
+
+		// starvation is disabled with a zero timeout
+		resultEtx, err = txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, 0, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, high.ID, resultEtx.ID)
+	})
+
+	t.Run("starving txes are raised to high priority, not above it", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		now := time.Now()
+		txes := []struct {
+			name     string
+			priority txmgrtypes.TxPriority
+			age      time.Duration
+		}{
+			{"fresh low", txmgrtypes.TxPriorityLow, 0},
+			{"fresh normal", txmgrtypes.TxPriorityNormal, 0},
+			{"fresh high", txmgrtypes.TxPriorityHigh, 0},
+			{"starving low", txmgrtypes.TxPriorityLow, 3 * starvationTimeout},
+			{"starving normal", txmgrtypes.TxPriorityNormal, 2 * starvationTimeout},
+			{"older starving high", txmgrtypes.TxPriorityHigh, 4 * starvationTimeout},
+			{"waiting normal", txmgrtypes.TxPriorityNormal, starvationTimeout / 2},
+		}
+		names := make(map[int64]string, len(txes))
+		for _, tx := range txes {
+			etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+				txRequestWithStrategy(txmgrcommon.NewPriorityStrategy(uuid.Nil, tx.priority, 0)))
+			_, err := db.ExecContext(tests.Context(t), `UPDATE evm.txes SET created_at = $1 WHERE id = $2`, now.Add(-tx.age), etx.ID)
+			require.NoError(t, err)
+			names[etx.ID] = tx.name
+		}
+
+		// Starving txes rank with the high priority ones, by age, then the others by priority and age.
+		var order []string
+		for range txes {
+			resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, starvationTimeout, ethClient.ConfiguredChainID())
+			require.NoError(t, err)
+			order = append(order, names[resultEtx.ID])
+			_, err = db.ExecContext(tests.Context(t), `DELETE FROM evm.txes WHERE id = $1`, resultEtx.ID)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []string{"older starving high", "starving low", "starving normal", "fresh high", "waiting normal", "fresh normal", "fresh low"}, order)
+	})
 }
 
 func TestORM_UpdateTxFatalError(t *testing.T) {
@@ -1292,6 +1472,20 @@ func TestORM_UpdateTxFatalError(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, etx.TxAttempts, 0)
 		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		assert.False(t, etx.RevertReason.Valid)
+	})
+
+	t.Run("saves revert reason", func(t *testing.T) {
+		etx := mustInsertInProgressEthTxWithAttempt(t, txStore, 14, fromAddress)
+		etx.Error = null.StringFrom("transaction reverted during simulation: not enough LINK")
+		etx.RevertReason = null.StringFrom("not enough LINK")
+
+		err := txStore.UpdateTxFatalError(tests.Context(t), &etx)
+		require.NoError(t, err)
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		assert.Equal(t, null.StringFrom("not enough LINK"), etx.RevertReason)
 	})
 }
 
@@ -1717,6 +1911,37 @@ func TestORM_CheckTxQueueCapacity(t *testing.T) {
 	})
 }
 
+func TestORM_CheckTxQueueCapacityByPriority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	low := txRequestWithStrategy(txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgrtypes.TxPriorityLow, 0))
+	high := txRequestWithStrategy(txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgrtypes.TxPriorityHigh, 0))
+
+	for i := 0; i < 2; i++ {
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, low)
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+	}
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, high)
+
+	t.Run("only counts txes of the priority class", func(t *testing.T) {
+		err := txStore.CheckTxQueueCapacityByPriority(tests.Context(t), fromAddress, 2, txmgrtypes.TxPriorityHigh, testutils.FixtureChainID)
+		require.NoError(t, err)
+
+		err = txStore.CheckTxQueueCapacityByPriority(tests.Context(t), fromAddress, 2, txmgrtypes.TxPriorityLow, testutils.FixtureChainID)
+		require.EqualError(t, err, "cannot create transaction; too many unstarted low priority transactions in the queue (2/2)")
+	})
+
+	t.Run("disables check with 0 limit", func(t *testing.T) {
+		err := txStore.CheckTxQueueCapacityByPriority(tests.Context(t), fromAddress, 0, txmgrtypes.TxPriorityLow, testutils.FixtureChainID)
+		require.NoError(t, err)
+	})
+}
+
 func TestORM_CreateTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -1842,6 +2067,17 @@ func TestORM_PruneUnstartedTxQueue(t *testing.T) {
 		}
 		AssertCountPerSubject(t, txStore, int64(2), subject2)
 	})
+
+	t.Run("prunes each priority class separately", func(t *testing.T) {
+		subject3 := uuid.New()
+		high := txmgrcommon.NewPriorityStrategy(subject3, txmgrtypes.TxPriorityHigh, uint32(3))
+		low := txmgrcommon.NewPriorityStrategy(subject3, txmgrtypes.TxPriorityLow, uint32(2))
+		for i := 0; i < 4; i++ {
+			mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(high))
+			mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(low))
+		}
+		AssertCountPerSubject(t, txStore, int64(3), subject3)
+	})
 }
 
 func TestORM_FindTxesWithAttemptsAndReceiptsByIdsAndState(t *testing.T) {
@@ -1922,8 +2158,13 @@ func TestORM_UpdateTxesFinalized(t *testing.T) {
 		err = txStore.InsertTxAttempt(ctx, &attempt)
 		require.NoError(t, err)
 		receipt := mustInsertEthReceipt(t, txStore, 100, testutils.NewHash(), attempt.Hash)
-		err = txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, []int64{receipt.ID}, testutils.FixtureChainID)
+		finalized, err := txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, []int64{receipt.ID}, testutils.FixtureChainID)
 		require.NoError(t, err)
+		require.Len(t, finalized, 1)
+		require.Equal(t, tx.ID, finalized[0].ID)
+		require.Equal(t, txmgrcommon.TxFinalized, finalized[0].State)
+		require.Len(t, finalized[0].TxAttempts, 1)
+		require.Equal(t, attempt.Hash, finalized[0].TxAttempts[0].Hash)
 		etx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
 		require.NoError(t, err)
 		require.Equal(t, txmgrcommon.TxFinalized, etx.State)
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 )
@@ -25,7 +27,7 @@ const processHeadTimeout = 10 * time.Minute
 
 type finalizerTxStore interface {
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) ([]Receipt, error)
-	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, txs []int64, chainId *big.Int) error
+	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, txs []int64, chainId *big.Int) ([]Tx, error)
 }
 
 type finalizerChainClient interface {
@@ -50,6 +52,7 @@ type evmFinalizer struct {
 	mb     *mailbox.Mailbox[*evmtypes.Head]
 	stopCh services.StopChan
 	wg     sync.WaitGroup
+	events *TxEvents
 
 	lastProcessedFinalizedBlockNum int64
 }
@@ -74,6 +77,11 @@ func NewEvmFinalizer(
 	}
 }
 
+// SetTxEvents sets the events on which finalized transactions are published.
+func (f *evmFinalizer) SetTxEvents(events *TxEvents) {
+	f.events = events
+}
+
 // Start the finalizer
 func (f *evmFinalizer) Start(ctx context.Context) error {
 	return f.StartOnce("Finalizer", func() error {
@@ -206,10 +214,22 @@ func (f *evmFinalizer) processFinalizedHead(ctx context.Context, latestFinalized
 
 	receiptIDs := f.buildReceiptIdList(finalizedReceipts)
 
-	err = f.txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, receiptIDs, f.chainId)
+	finalizedTxs, err := f.txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, receiptIDs, f.chainId)
 	if err != nil {
 		return fmt.Errorf("failed to update transactions as finalized: %w", err)
 	}
+	blockNums := make(map[common.Hash]int64, len(finalizedReceipts))
+	for _, receipt := range finalizedReceipts {
+		blockNums[receipt.TxHash] = receipt.BlockNumber
+	}
+	for _, tx := range finalizedTxs {
+		attempt := tx.TxAttempts[0]
+		ev := txmgr.NewTxAttemptEvent(txmgr.TxEventFinalized, tx, attempt)
+		if blockNum, ok := blockNums[attempt.Hash]; ok {
+			ev.BlockNumber = &blockNum
+		}
+		f.events.Publish(ev)
+	}
 	// Update lastProcessedFinalizedBlockNum after processing has completed to allow failed processing to retry on subsequent heads
 	// Does not need to be protected with mutex lock because the Finalizer only runs in a single loop
 	f.lastProcessedFinalizedBlockNum = latestFinalizedHead.BlockNumber()
@@ -112,6 +112,10 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 
 	t.Run("returns finalized for tx with receipt in a finalized block", func(t *testing.T) {
 		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		events := newTestTxEvents(t)
+		finalizer.SetTxEvents(events)
+		sub := events.Subscribe(nil)
+		defer sub.Close()
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -138,6 +142,13 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 		tx, err = txStore.FindTxWithIdempotencyKey(ctx, idempotencyKey, testutils.FixtureChainID)
 		require.NoError(t, err)
 		require.Equal(t, txmgrcommon.TxFinalized, tx.State)
+
+		ev := <-sub.Events()
+		require.Equal(t, txmgrcommon.TxEventFinalized, ev.Type)
+		require.Equal(t, tx.ID, ev.TxID)
+		require.Equal(t, &idempotencyKey, ev.IdempotencyKey)
+		require.Equal(t, &attemptHash, ev.TxHash)
+		require.Equal(t, head.Parent.Load().Number, *ev.BlockNumber)
 	})
 
 	t.Run("returns finalized for tx with receipt older than block history depth", func(t *testing.T) {
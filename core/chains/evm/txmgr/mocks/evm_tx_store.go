@@ -135,6 +135,56 @@ func (_c *EvmTxStore_CheckTxQueueCapacity_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CheckTxQueueCapacityByPriority provides a mock function with given fields: ctx, fromAddress, maxQueuedTransactions, priority, chainID
+func (_m *EvmTxStore) CheckTxQueueCapacityByPriority(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, priority types.TxPriority, chainID *big.Int) error {
+	ret := _m.Called(ctx, fromAddress, maxQueuedTransactions, priority, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTxQueueCapacityByPriority")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, uint64, types.TxPriority, *big.Int) error); ok {
+		r0 = rf(ctx, fromAddress, maxQueuedTransactions, priority, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_CheckTxQueueCapacityByPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckTxQueueCapacityByPriority'
+type EvmTxStore_CheckTxQueueCapacityByPriority_Call struct {
+	*mock.Call
+}
+
+// CheckTxQueueCapacityByPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - maxQueuedTransactions uint64
+//   - priority types.TxPriority
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) CheckTxQueueCapacityByPriority(ctx interface{}, fromAddress interface{}, maxQueuedTransactions interface{}, priority interface{}, chainID interface{}) *EvmTxStore_CheckTxQueueCapacityByPriority_Call {
+	return &EvmTxStore_CheckTxQueueCapacityByPriority_Call{Call: _e.mock.On("CheckTxQueueCapacityByPriority", ctx, fromAddress, maxQueuedTransactions, priority, chainID)}
+}
+
+func (_c *EvmTxStore_CheckTxQueueCapacityByPriority_Call) Run(run func(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, priority types.TxPriority, chainID *big.Int)) *EvmTxStore_CheckTxQueueCapacityByPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(uint64), args[3].(types.TxPriority), args[4].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CheckTxQueueCapacityByPriority_Call) Return(err error) *EvmTxStore_CheckTxQueueCapacityByPriority_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EvmTxStore_CheckTxQueueCapacityByPriority_Call) RunAndReturn(run func(context.Context, common.Address, uint64, types.TxPriority, *big.Int) error) *EvmTxStore_CheckTxQueueCapacityByPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *EvmTxStore) Close() {
 	_m.Called()
@@ -678,9 +728,9 @@ func (_c *EvmTxStore_FindLatestSequence_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID
-func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
-	ret := _m.Called(ctx, fromAddress, chainID)
+// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, starvationTimeout, chainID
+func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, starvationTimeout time.Duration, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, starvationTimeout, chainID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindNextUnstartedTransactionFromAddress")
@@ -688,19 +738,19 @@ func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Contex
 
 	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
-		return rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, time.Duration, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, starvationTimeout, chainID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
-		r0 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, time.Duration, *big.Int) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, starvationTimeout, chainID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
-		r1 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, time.Duration, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, starvationTimeout, chainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -716,14 +766,15 @@ type EvmTxStore_FindNextUnstartedTransactionFromAddress_Call struct {
 // FindNextUnstartedTransactionFromAddress is a helper method to define mock.On call
 //   - ctx context.Context
 //   - fromAddress common.Address
+//   - starvationTimeout time.Duration
 //   - chainID *big.Int
-func (_e *EvmTxStore_Expecter) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
-	return &EvmTxStore_FindNextUnstartedTransactionFromAddress_Call{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, chainID)}
+func (_e *EvmTxStore_Expecter) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, starvationTimeout interface{}, chainID interface{}) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+	return &EvmTxStore_FindNextUnstartedTransactionFromAddress_Call{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, starvationTimeout, chainID)}
 }
 
-func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, starvationTimeout time.Duration, chainID *big.Int)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int))
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(time.Duration), args[3].(*big.Int))
 	})
 	return _c
 }
@@ -733,7 +784,7 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Return(_a0 *t
 	return _c
 }
 
-func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, time.Duration, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2371,6 +2422,67 @@ func (_c *EvmTxStore_PruneUnstartedTxQueue_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// PruneUnstartedTxQueueByPriority provides a mock function with given fields: ctx, queueSize, subject, priority
+func (_m *EvmTxStore) PruneUnstartedTxQueueByPriority(ctx context.Context, queueSize uint32, subject uuid.UUID, priority types.TxPriority) ([]int64, error) {
+	ret := _m.Called(ctx, queueSize, subject, priority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneUnstartedTxQueueByPriority")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, types.TxPriority) ([]int64, error)); ok {
+		return rf(ctx, queueSize, subject, priority)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uuid.UUID, types.TxPriority) []int64); ok {
+		r0 = rf(ctx, queueSize, subject, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uuid.UUID, types.TxPriority) error); ok {
+		r1 = rf(ctx, queueSize, subject, priority)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_PruneUnstartedTxQueueByPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneUnstartedTxQueueByPriority'
+type EvmTxStore_PruneUnstartedTxQueueByPriority_Call struct {
+	*mock.Call
+}
+
+// PruneUnstartedTxQueueByPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueSize uint32
+//   - subject uuid.UUID
+//   - priority types.TxPriority
+func (_e *EvmTxStore_Expecter) PruneUnstartedTxQueueByPriority(ctx interface{}, queueSize interface{}, subject interface{}, priority interface{}) *EvmTxStore_PruneUnstartedTxQueueByPriority_Call {
+	return &EvmTxStore_PruneUnstartedTxQueueByPriority_Call{Call: _e.mock.On("PruneUnstartedTxQueueByPriority", ctx, queueSize, subject, priority)}
+}
+
+func (_c *EvmTxStore_PruneUnstartedTxQueueByPriority_Call) Run(run func(ctx context.Context, queueSize uint32, subject uuid.UUID, priority types.TxPriority)) *EvmTxStore_PruneUnstartedTxQueueByPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(uuid.UUID), args[3].(types.TxPriority))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_PruneUnstartedTxQueueByPriority_Call) Return(ids []int64, err error) *EvmTxStore_PruneUnstartedTxQueueByPriority_Call {
+	_c.Call.Return(ids, err)
+	return _c
+}
+
+func (_c *EvmTxStore_PruneUnstartedTxQueueByPriority_Call) RunAndReturn(run func(context.Context, uint32, uuid.UUID, types.TxPriority) ([]int64, error)) *EvmTxStore_PruneUnstartedTxQueueByPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReapTxHistory provides a mock function with given fields: ctx, timeThreshold, chainID
 func (_m *EvmTxStore) ReapTxHistory(ctx context.Context, timeThreshold time.Time, chainID *big.Int) error {
 	ret := _m.Called(ctx, timeThreshold, chainID)
@@ -2960,6 +3072,126 @@ func (_c *EvmTxStore_TxAttempts_Call) RunAndReturn(run func(context.Context, int
 	return _c
 }
 
+// TxSpendByJob provides a mock function with given fields: ctx, chainID, since
+func (_m *EvmTxStore) TxSpendByJob(ctx context.Context, chainID *big.Int, since time.Time) ([]txmgr.TxSpend, error) {
+	ret := _m.Called(ctx, chainID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxSpendByJob")
+	}
+
+	var r0 []txmgr.TxSpend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, time.Time) ([]txmgr.TxSpend, error)); ok {
+		return rf(ctx, chainID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, time.Time) []txmgr.TxSpend); ok {
+		r0 = rf(ctx, chainID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.TxSpend)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, time.Time) error); ok {
+		r1 = rf(ctx, chainID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_TxSpendByJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxSpendByJob'
+type EvmTxStore_TxSpendByJob_Call struct {
+	*mock.Call
+}
+
+// TxSpendByJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - since time.Time
+func (_e *EvmTxStore_Expecter) TxSpendByJob(ctx interface{}, chainID interface{}, since interface{}) *EvmTxStore_TxSpendByJob_Call {
+	return &EvmTxStore_TxSpendByJob_Call{Call: _e.mock.On("TxSpendByJob", ctx, chainID, since)}
+}
+
+func (_c *EvmTxStore_TxSpendByJob_Call) Run(run func(ctx context.Context, chainID *big.Int, since time.Time)) *EvmTxStore_TxSpendByJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_TxSpendByJob_Call) Return(_a0 []txmgr.TxSpend, _a1 error) *EvmTxStore_TxSpendByJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_TxSpendByJob_Call) RunAndReturn(run func(context.Context, *big.Int, time.Time) ([]txmgr.TxSpend, error)) *EvmTxStore_TxSpendByJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TxSpendByKey provides a mock function with given fields: ctx, chainID, since
+func (_m *EvmTxStore) TxSpendByKey(ctx context.Context, chainID *big.Int, since time.Time) ([]txmgr.TxSpend, error) {
+	ret := _m.Called(ctx, chainID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxSpendByKey")
+	}
+
+	var r0 []txmgr.TxSpend
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, time.Time) ([]txmgr.TxSpend, error)); ok {
+		return rf(ctx, chainID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, time.Time) []txmgr.TxSpend); ok {
+		r0 = rf(ctx, chainID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.TxSpend)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, time.Time) error); ok {
+		r1 = rf(ctx, chainID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_TxSpendByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxSpendByKey'
+type EvmTxStore_TxSpendByKey_Call struct {
+	*mock.Call
+}
+
+// TxSpendByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - since time.Time
+func (_e *EvmTxStore_Expecter) TxSpendByKey(ctx interface{}, chainID interface{}, since interface{}) *EvmTxStore_TxSpendByKey_Call {
+	return &EvmTxStore_TxSpendByKey_Call{Call: _e.mock.On("TxSpendByKey", ctx, chainID, since)}
+}
+
+func (_c *EvmTxStore_TxSpendByKey_Call) Run(run func(ctx context.Context, chainID *big.Int, since time.Time)) *EvmTxStore_TxSpendByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_TxSpendByKey_Call) Return(_a0 []txmgr.TxSpend, _a1 error) *EvmTxStore_TxSpendByKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_TxSpendByKey_Call) RunAndReturn(run func(context.Context, *big.Int, time.Time) ([]txmgr.TxSpend, error)) *EvmTxStore_TxSpendByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateBroadcastAts provides a mock function with given fields: ctx, now, etxIDs
 func (_m *EvmTxStore) UpdateBroadcastAts(ctx context.Context, now time.Time, etxIDs []int64) error {
 	ret := _m.Called(ctx, now, etxIDs)
@@ -3201,21 +3433,33 @@ func (_c *EvmTxStore_UpdateTxForRebroadcast_Call) RunAndReturn(run func(context.
 }
 
 // UpdateTxStatesToFinalizedUsingReceiptIds provides a mock function with given fields: ctx, etxIDs, chainId
-func (_m *EvmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error {
+func (_m *EvmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, etxIDs, chainId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateTxStatesToFinalizedUsingReceiptIds")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []int64, *big.Int) error); ok {
+	var r0 []types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, *big.Int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, etxIDs, chainId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, *big.Int) []types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
 		r0 = rf(ctx, etxIDs, chainId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, *big.Int) error); ok {
+		r1 = rf(ctx, etxIDs, chainId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxStatesToFinalizedUsingReceiptIds'
@@ -3238,12 +3482,12 @@ func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) Run(run func
 	return _c
 }
 
-func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) Return(_a0 error) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
-	_c.Call.Return(_a0)
+func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) Return(_a0 []types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 error) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) RunAndReturn(run func(context.Context, []int64, *big.Int) error) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
+func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) RunAndReturn(run func(context.Context, []int64, *big.Int) ([]types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -43,6 +43,9 @@ type (
 	TransactionClient      = txmgrtypes.TransactionClient[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	ChainReceipt           = txmgrtypes.ChainReceipt[common.Hash, common.Hash]
 	Finalizer              = txmgrtypes.Finalizer[common.Hash, *evmtypes.Head]
+	TxEvent                = txmgr.TxEvent[common.Address, common.Hash]
+	TxEvents               = txmgr.TxEvents[common.Address, common.Hash]
+	TxEventSubscription    = txmgr.TxEventSubscription[common.Address, common.Hash]
 )
 
 var _ KeyStore = (keystore.Eth)(nil) // check interface in txmgr to avoid circular import
@@ -52,6 +55,10 @@ const (
 	// chain.
 	TransmitCheckerTypeSimulate = txmgrtypes.TransmitCheckerType("simulate")
 
+	// TransmitCheckerTypeSimulatePending is a checker that simulates the transaction against the pending block right
+	// before it is broadcast, and fatally errors it with the decoded revert reason if it would revert.
+	TransmitCheckerTypeSimulatePending = txmgrtypes.TransmitCheckerType("simulate_pending")
+
 	// TransmitCheckerTypeVRFV1 is a checker that will not submit VRF V1 fulfillment requests that
 	// have already been fulfilled. This could happen if the request was fulfilled by another node.
 	TransmitCheckerTypeVRFV1 = txmgrtypes.TransmitCheckerType("vrf_v1")
@@ -65,6 +72,22 @@ const (
 	TransmitCheckerTypeVRFV2Plus = txmgrtypes.TransmitCheckerType("vrf_v2plus")
 )
 
+// Priority classes of the transactions sent by the node's products, see txmgrtypes.TxPriority. Transactions which are
+// worthless once a deadline passes are sent first.
+const (
+	// TxPriorityReport is the priority of OCR, OCR2 and Flux Monitor reports, which are rejected once their round is
+	// over.
+	TxPriorityReport = txmgrtypes.TxPriorityHigh
+	// TxPriorityUpkeep is the priority of Keeper and Automation upkeeps, which are due at a block and only paid to the
+	// first node to perform them.
+	TxPriorityUpkeep = txmgrtypes.TxPriorityHigh
+	// TxPriorityExecution is the priority of CCIP executions, which remain valid until the messages are executed.
+	TxPriorityExecution = txmgrtypes.TxPriorityNormal
+	// TxPriorityVRFFulfillment is the priority of VRF fulfillments, which remain valid once the request's block is out
+	// of reach of the BLOCKHASH opcode, thanks to the blockhash store.
+	TxPriorityVRFFulfillment = txmgrtypes.TxPriorityNormal
+)
+
 // GetGethSignedTx decodes the SignedRawTx into a types.Transaction struct
 func GetGethSignedTx(signedRawTx []byte) (*types.Transaction, error) {
 	s := rlp.NewStream(bytes.NewReader(signedRawTx), 0)
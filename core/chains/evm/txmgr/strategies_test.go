@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 )
 
@@ -50,3 +51,31 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 		assert.Equal(t, []int64{1, 2}, ids)
 	})
 }
+
+func Test_PriorityStrategy(t *testing.T) {
+	t.Parallel()
+
+	s := txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgrtypes.TxPriorityHigh, 0)
+	assert.False(t, s.Subject().Valid)
+	assert.Equal(t, txmgrtypes.TxPriorityHigh, txmgrtypes.TxPriorityOf(s))
+	assert.Equal(t, txmgrtypes.TxPriorityNormal, txmgrtypes.TxPriorityOf(txmgrcommon.NewSendEveryStrategy()))
+
+	ids, err := s.PruneQueue(tests.Context(t), nil)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func Test_PriorityStrategy_PruneQueue(t *testing.T) {
+	t.Parallel()
+	subject := uuid.New()
+	queueSize := uint32(3)
+	mockTxStore := mocks.NewEvmTxStore(t)
+
+	t.Run("calls PruneUnstartedTxQueueByPriority for the given subject, priority and queueSize", func(t *testing.T) {
+		strategy := txmgrcommon.NewPriorityStrategy(subject, txmgrtypes.TxPriorityLow, queueSize)
+		mockTxStore.On("PruneUnstartedTxQueueByPriority", mock.Anything, queueSize-1, subject, txmgrtypes.TxPriorityLow).Once().Return([]int64{1}, nil)
+		ids, err := strategy.PruneQueue(tests.Context(t), mockTxStore)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, ids)
+	})
+}
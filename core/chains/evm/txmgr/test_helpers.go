@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
@@ -103,6 +104,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) FeeOracle() evmconfig.FeeOracle {
+	return nil
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -163,13 +168,15 @@ type transactionsConfig struct {
 	autoPurge evmconfig.AutoPurgeConfig
 }
 
-func (*transactionsConfig) ForwardersEnabled() bool                { return true }
-func (t *transactionsConfig) MaxInFlight() uint32                  { return t.e.MaxInFlight }
-func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.MaxQueued }
-func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
-func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
-func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
-func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (*transactionsConfig) ForwardersEnabled() bool                 { return true }
+func (t *transactionsConfig) MaxInFlight() uint32                   { return t.e.MaxInFlight }
+func (t *transactionsConfig) MaxQueued() uint64                     { return t.e.MaxQueued }
+func (t *transactionsConfig) ReaperInterval() time.Duration         { return t.e.ReaperInterval }
+func (t *transactionsConfig) ReaperThreshold() time.Duration        { return t.e.ReaperThreshold }
+func (t *transactionsConfig) ResendAfterThreshold() time.Duration   { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig  { return t.autoPurge }
+func (t *transactionsConfig) Forwarders() evmconfig.Forwarders      { return &forwardersConfig{} }
+func (t *transactionsConfig) Priority() txmgrtypes.TxPriorityConfig { return &priorityConfig{} }
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -177,6 +184,18 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type forwardersConfig struct {
+	evmconfig.Forwarders
+}
+
+func (f *forwardersConfig) DiscoveryEnabled() bool              { return false }
+func (f *forwardersConfig) VerificationInterval() time.Duration { return 5 * time.Minute }
+
+type priorityConfig struct{}
+
+func (p *priorityConfig) StarvationTimeout() time.Duration                { return 5 * time.Minute }
+func (p *priorityConfig) MaxQueued(priority txmgrtypes.TxPriority) uint64 { return 0 }
+
 type MockConfig struct {
 	EvmConfig          *TestEvmConfig
 	finalityDepth      uint32
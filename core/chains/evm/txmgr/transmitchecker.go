@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -36,7 +37,9 @@ var (
 	NoChecker TransmitChecker = noChecker{}
 
 	_ TransmitCheckerFactory = &CheckerFactory{}
+	_ TransmitChecker        = CombinedChecker{}
 	_ TransmitChecker        = &SimulateChecker{}
+	_ TransmitChecker        = &SimulatePendingChecker{}
 	_ TransmitChecker        = &VRFV1Checker{}
 	_ TransmitChecker        = &VRFV2Checker{}
 )
@@ -44,13 +47,45 @@ var (
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
 type CheckerFactory struct {
 	Client evmclient.Client
+	// ABIs decodes the revert reasons of simulated transactions. Optional.
+	ABIs *ABIRegistry
 }
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
+	if err := ValidateCheckerSpec(spec); err != nil {
+		return nil, err
+	}
+	checker, err := c.buildChecker(spec)
+	if err != nil || !spec.Simulate {
+		return checker, err
+	}
+	simulate := &SimulatePendingChecker{Client: c.Client, ABIs: c.ABIs}
+	if checker == NoChecker {
+		return simulate, nil
+	}
+	return CombinedChecker{checker, simulate}, nil
+}
+
+// ValidateCheckerSpec returns an error if the spec asks for a simulation on top of a checker type that already
+// simulates the transaction.
+func ValidateCheckerSpec(spec TransmitCheckerSpec) error {
+	if !spec.Simulate {
+		return nil
+	}
+	switch spec.CheckerType {
+	case TransmitCheckerTypeSimulate, TransmitCheckerTypeSimulatePending:
+		return pkgerrors.Errorf("malformed checker, Simulate cannot be combined with checker type %s, which already simulates the transaction", spec.CheckerType)
+	}
+	return nil
+}
+
+func (c *CheckerFactory) buildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
 		return &SimulateChecker{c.Client}, nil
+	case TransmitCheckerTypeSimulatePending:
+		return &SimulatePendingChecker{Client: c.Client, ABIs: c.ABIs}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -117,6 +152,24 @@ func (noChecker) Check(
 	return nil
 }
 
+// CombinedChecker runs each of its checkers in order, and fails with the error of the first one that fails.
+type CombinedChecker []TransmitChecker
+
+// Check satisfies the TransmitChecker interface.
+func (c CombinedChecker) Check(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	a TxAttempt,
+) error {
+	for _, checker := range c {
+		if err := checker.Check(ctx, l, tx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SimulateChecker simulates transactions, producing an error if they revert on chain.
 type SimulateChecker struct {
 	Client evmclient.Client
@@ -161,6 +214,62 @@ func (s *SimulateChecker) Check(
 	return nil
 }
 
+// SimulatePendingChecker simulates transactions against the pending block, producing an error with the decoded revert
+// reason if they would revert on chain.
+type SimulatePendingChecker struct {
+	Client evmclient.Client
+	ABIs   *ABIRegistry
+}
+
+// Check satisfies the TransmitChecker interface.
+func (s *SimulatePendingChecker) Check(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	a TxAttempt,
+) error {
+	// NOTE: Deliberately do not include gas prices, see SimulateChecker.
+	b, err := evmclient.SimulateCall(ctx, s.Client, ethereum.CallMsg{
+		From:  tx.FromAddress,
+		To:    &tx.ToAddress,
+		Gas:   a.ChainSpecificFeeLimit,
+		Value: &tx.Value,
+		Data:  tx.EncodedPayload,
+	})
+	if err == nil {
+		l.Debugw("Transaction simulation succeeded",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "returnValue", hexutil.Bytes(b).String())
+		return nil
+	}
+	jErr := evmclient.ExtractRPCErrorOrNil(err)
+	if jErr == nil {
+		l.Warnw("Transaction simulation failed, will attempt to send anyway",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err)
+		return nil
+	}
+	reason := s.revertReason(jErr)
+	l.Criticalw("Transaction would revert, not sending it",
+		"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "revertReason", reason)
+	return &txmgr.RevertError{Reason: reason}
+}
+
+// revertReason decodes the revert data of the error if possible, and falls back to the error message.
+func (s *SimulatePendingChecker) revertReason(jErr *evmclient.JsonError) string {
+	abis := s.ABIs
+	if abis == nil {
+		abis = NewABIRegistry()
+	}
+	if data, ok := revertData(jErr.Data); ok {
+		if reason, ok := abis.DecodeRevert(data); ok {
+			return reason
+		}
+	}
+	if jErr.Message != "" {
+		return jErr.Message
+	}
+	return jErr.String()
+}
+
 // VRFV1Checker is an implementation of TransmitChecker that checks whether a VRF V1 fulfillment
 // has already been fulfilled.
 type VRFV1Checker struct {
@@ -8,9 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -93,6 +96,37 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
 	})
 
+	t.Run("simulate pending checker", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeSimulatePending,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulatePendingChecker{Client: client}, c)
+	})
+
+	t.Run("simulate", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{Simulate: true})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulatePendingChecker{Client: client}, c)
+
+		c, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType:           txmgr.TransmitCheckerTypeVRFV1,
+			VRFCoordinatorAddress: testutils.NewAddressPtr(),
+			Simulate:              true,
+		})
+		require.NoError(t, err)
+		require.IsType(t, txmgr.CombinedChecker{}, c)
+		combined := c.(txmgr.CombinedChecker)
+		require.Len(t, combined, 2)
+		require.IsType(t, &txmgr.VRFV1Checker{}, combined[0])
+		require.Equal(t, &txmgr.SimulatePendingChecker{Client: client}, combined[1])
+
+		for _, checkerType := range []txmgrtypes.TransmitCheckerType{txmgr.TransmitCheckerTypeSimulate, txmgr.TransmitCheckerTypeSimulatePending} {
+			_, err = factory.BuildChecker(txmgr.TransmitCheckerSpec{CheckerType: checkerType, Simulate: true})
+			require.ErrorContains(t, err, "Simulate cannot be combined with checker type "+string(checkerType))
+		}
+	})
+
 	t.Run("invalid checker type", func(t *testing.T) {
 		_, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType: "invalid",
@@ -111,6 +145,15 @@ func TestTransmitCheckers(t *testing.T) {
 		require.NoError(t, checker.Check(ctx, log, txmgr.Tx{}, txmgr.TxAttempt{}))
 	})
 
+	t.Run("combined", func(t *testing.T) {
+		require.NoError(t, txmgr.CombinedChecker{txmgr.NoChecker, txmgr.NoChecker}.Check(ctx, log, txmgr.Tx{}, txmgr.TxAttempt{}))
+
+		second := &countingChecker{}
+		checker := txmgr.CombinedChecker{&countingChecker{err: pkgerrors.New("already fulfilled")}, second}
+		require.EqualError(t, checker.Check(ctx, log, txmgr.Tx{}, txmgr.TxAttempt{}), "already fulfilled")
+		require.Zero(t, second.calls)
+	})
+
 	t.Run("simulate", func(t *testing.T) {
 		checker := txmgr.SimulateChecker{Client: client}
 
@@ -170,6 +213,113 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 
+	t.Run("simulate pending", func(t *testing.T) {
+		abis := txmgr.NewABIRegistry()
+		require.NoError(t, abis.RegisterJSON(`[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"have","type":"uint256"},{"name":"want","type":"uint256"}]}]`))
+		checker := txmgr.SimulatePendingChecker{Client: client, ABIs: abis}
+
+		tx := txmgr.Tx{
+			FromAddress:    common.HexToAddress("0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9"),
+			ToAddress:      common.HexToAddress("0xff0Aac13eab788cb9a2D662D3FB661Aa5f58FA21"),
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          big.Int(assets.NewEthValue(642)),
+			FeeLimit:       1e9,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgrcommon.TxUnstarted,
+		}
+		attempt := txmgr.TxAttempt{
+			Tx:        tx,
+			Hash:      common.Hash{},
+			CreatedAt: tx.CreatedAt,
+			State:     txmgrtypes.TxAttemptInProgress,
+		}
+		expectCall := func(ret error) {
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.MatchedBy(func(callarg map[string]interface{}) bool {
+					return fmt.Sprintf("%s", callarg["value"]) == "0x282" // 642
+				}), "pending").Return(ret).Once()
+		}
+		uint256, err := abi.NewType("uint256", "", nil)
+		require.NoError(t, err)
+
+		t.Run("success", func(t *testing.T) {
+			expectCall(nil)
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
+		t.Run("revert with reason", func(t *testing.T) {
+			str, err := abi.NewType("string", "", nil)
+			require.NoError(t, err)
+			args, err := abi.Arguments{{Type: str}}.Pack("not enough LINK")
+			require.NoError(t, err)
+			expectCall(&evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    hexutil.Encode(append(crypto.Keccak256([]byte("Error(string)"))[:4], args...)),
+			})
+
+			err = checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: not enough LINK")
+			var revertErr *txmgrcommon.RevertError
+			require.ErrorAs(t, err, &revertErr)
+			require.Equal(t, "not enough LINK", revertErr.Reason)
+		})
+
+		t.Run("revert with custom error", func(t *testing.T) {
+			args, err := abi.Arguments{{Type: uint256}, {Type: uint256}}.Pack(big.NewInt(1), big.NewInt(2))
+			require.NoError(t, err)
+			expectCall(&evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    "Reverted " + hexutil.Encode(append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], args...)),
+			})
+
+			err = checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: InsufficientBalance(1, 2)")
+		})
+
+		t.Run("revert with registry error", func(t *testing.T) {
+			checker := txmgr.SimulatePendingChecker{Client: client, ABIs: txmgr.NewDefaultABIRegistry()}
+			expectCall(&evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    hexutil.Encode(crypto.Keccak256([]byte("ConfigDigestMismatch()"))[:4]),
+			})
+
+			err := checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: ConfigDigestMismatch()")
+		})
+
+		t.Run("revert with panic", func(t *testing.T) {
+			args, err := abi.Arguments{{Type: uint256}}.Pack(big.NewInt(0x11))
+			require.NoError(t, err)
+			expectCall(&evmclient.JsonError{
+				Code: 3,
+				Data: hexutil.Encode(append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], args...)),
+			})
+
+			err = checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: panic: 0x11")
+		})
+
+		t.Run("revert with unknown error", func(t *testing.T) {
+			expectCall(&evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    "0xdeadbeef",
+			})
+
+			err := checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: execution reverted")
+		})
+
+		t.Run("non revert error", func(t *testing.T) {
+			expectCall(pkgerrors.New("error"))
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+	})
+
 	t.Run("VRF V1", func(t *testing.T) {
 		testDefaultSubID := uint64(2)
 		testDefaultMaxLink := "1000000000000000000"
@@ -370,3 +520,13 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 }
+
+type countingChecker struct {
+	err   error
+	calls int
+}
+
+func (c *countingChecker) Check(context.Context, logger.SugaredLogger, txmgr.Tx, txmgr.TxAttempt) error {
+	c.calls++
+	return c.err
+}
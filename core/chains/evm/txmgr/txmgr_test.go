@@ -389,6 +389,43 @@ func TestTxm_CreateTransaction(t *testing.T) {
 	})
 }
 
+func TestTxm_CreateTransaction_ForwarderRevoked(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	kst := cltest.NewKeyStore(t, db)
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth())
+	toAddress := testutils.NewAddress()
+	fwdrAddress := testutils.NewAddress()
+	_, dbConfig, evmConfig := txmgr.MakeTestConfigs(t)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	eb := txmgr.NewEvmBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmConfig), txmgr.NewEvmTxmFeeConfig(evmConfig.GasEstimator()),
+		evmConfig.Transactions(), dbConfig.Listener(), kst.Eth(), nil, logger.Test(t), nil, false, "")
+	fwdMgr := commontxmmocks.NewForwarderManager[common.Address](t)
+	fwdMgr.On("Revoked", fwdrAddress, fromAddress).Return(true)
+	txm := txmgr.NewEvmTxm(&cltest.FixtureChainID, txmgr.NewEvmTxmConfig(evmConfig), evmConfig.Transactions(), kst.Eth(), logger.Test(t), nil, fwdMgr, nil, txStore, eb, nil, nil, nil, nil)
+
+	// the transaction is sent directly from the key, without converting the payload
+	etx, err := txm.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+		FromAddress:      fromAddress,
+		ToAddress:        toAddress,
+		EncodedPayload:   []byte{1, 2, 3},
+		FeeLimit:         21000,
+		ForwarderAddress: fwdrAddress,
+		Strategy:         txmgrcommon.NewSendEveryStrategy(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, toAddress, etx.ToAddress)
+	assert.Equal(t, []byte{1, 2, 3}, etx.EncodedPayload)
+	m, err := etx.GetMeta()
+	require.NoError(t, err)
+	if m != nil {
+		assert.Nil(t, m.FwdrDestAddress)
+	}
+}
+
 func newMockTxStrategy(t *testing.T) *commontxmmocks.TxStrategy {
 	return commontxmmocks.NewTxStrategy(t)
 }
@@ -66,6 +66,8 @@ type Receipt struct {
 	BlockNumber       *big.Int        `json:"blockNumber,omitempty"`
 	TransactionIndex  uint            `json:"transactionIndex"`
 	RevertReason      []byte          `json:"revertReason,omitempty"` // Only provided by Hedera
+	EffectiveGasPrice *big.Int        `json:"effectiveGasPrice,omitempty"`
+	L1Fee             *big.Int        `json:"l1Fee,omitempty"` // Only provided by OP stack and Scroll based chains
 }
 
 // FromGethReceipt converts a gethTypes.Receipt to a Receipt
@@ -90,6 +92,8 @@ func FromGethReceipt(gr *gethTypes.Receipt) *Receipt {
 		gr.BlockNumber,
 		gr.TransactionIndex,
 		nil,
+		gr.EffectiveGasPrice,
+		nil,
 	}
 }
 
@@ -124,6 +128,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 		BlockNumber       *hexutil.Big    `json:"blockNumber,omitempty"`
 		TransactionIndex  hexutil.Uint    `json:"transactionIndex"`
 		RevertReason      hexutil.Bytes   `json:"revertReason,omitempty"` // Only provided by Hedera
+		EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice,omitempty"`
+		L1Fee             *hexutil.Big    `json:"l1Fee,omitempty"` // Only provided by OP stack and Scroll based chains
 	}
 	var enc Receipt
 	enc.PostState = r.PostState
@@ -138,6 +144,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.BlockNumber = (*hexutil.Big)(r.BlockNumber)
 	enc.TransactionIndex = hexutil.Uint(r.TransactionIndex)
 	enc.RevertReason = r.RevertReason
+	enc.EffectiveGasPrice = (*hexutil.Big)(r.EffectiveGasPrice)
+	enc.L1Fee = (*hexutil.Big)(r.L1Fee)
 	return json.Marshal(&enc)
 }
 
@@ -156,6 +164,8 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		BlockNumber       *hexutil.Big     `json:"blockNumber,omitempty"`
 		TransactionIndex  *hexutil.Uint    `json:"transactionIndex"`
 		RevertReason      *hexutil.Bytes   `json:"revertReason,omitempty"` // Only provided by Hedera
+		EffectiveGasPrice *hexutil.Big     `json:"effectiveGasPrice,omitempty"`
+		L1Fee             *hexutil.Big     `json:"l1Fee,omitempty"` // Only provided by OP stack and Scroll based chains
 	}
 	var dec Receipt
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -195,6 +205,12 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 	if dec.RevertReason != nil {
 		r.RevertReason = *dec.RevertReason
 	}
+	if dec.EffectiveGasPrice != nil {
+		r.EffectiveGasPrice = (*big.Int)(dec.EffectiveGasPrice)
+	}
+	if dec.L1Fee != nil {
+		r.L1Fee = (*big.Int)(dec.L1Fee)
+	}
 	return nil
 }
 
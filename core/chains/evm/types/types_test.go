@@ -56,6 +56,7 @@ var (
 		BlockHash:         common.HexToHash("0x11111111111111"),
 		BlockNumber:       big.NewInt(555),
 		TransactionIndex:  777,
+		EffectiveGasPrice: big.NewInt(2_000_000_000),
 		Logs: []*gethTypes.Log{
 			testGethLog1,
 			testGethLog2,
@@ -79,6 +80,8 @@ func TestFromGethReceipt(t *testing.T) {
 	assert.Equal(t, testGethReceipt.BlockHash, receipt.BlockHash)
 	assert.Equal(t, testGethReceipt.BlockNumber, receipt.BlockNumber)
 	assert.Equal(t, testGethReceipt.TransactionIndex, receipt.TransactionIndex)
+	assert.Equal(t, testGethReceipt.EffectiveGasPrice, receipt.EffectiveGasPrice)
+	assert.Nil(t, receipt.L1Fee)
 	assert.Len(t, receipt.Logs, len(testGethReceipt.Logs))
 
 	for i, log := range receipt.Logs {
@@ -132,6 +135,14 @@ func TestReceipt_MarshalUnmarshalJson(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, receipt, parsedReceipt)
+
+	t.Run("decodes rollup L1 fee", func(t *testing.T) {
+		parsedReceipt := &types.Receipt{}
+		require.NoError(t, parsedReceipt.UnmarshalJSON([]byte(`{"gasUsed":"0x5208","effectiveGasPrice":"0x3b9aca00","l1Fee":"0x2540be400"}`)))
+		assert.Equal(t, uint64(21000), parsedReceipt.GasUsed)
+		assert.Equal(t, big.NewInt(1_000_000_000), parsedReceipt.EffectiveGasPrice)
+		assert.Equal(t, big.NewInt(10_000_000_000), parsedReceipt.L1Fee)
+	})
 }
 
 func TestLog_MarshalUnmarshalJson(t *testing.T) {
@@ -112,6 +112,7 @@ type chain struct {
 	logger          logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
 	headTracker     httypes.HeadTracker
+	gapRepairer     *headtracker.GapRepairer
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
@@ -209,17 +210,24 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	headBroadcaster := headtracker.NewHeadBroadcaster(l)
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
+	var gapRepairer *headtracker.GapRepairer
 	if !opts.AppConfig.EVMRPCEnabled() {
 		headTracker = headtracker.NullTracker
 	} else if opts.GenHeadTracker == nil {
 		var orm headtracker.ORM
 		if cfg.EVM().HeadTracker().PersistenceEnabled() {
 			orm = headtracker.NewORM(*chainID, opts.DS)
+			if cfg.EVM().HeadTracker().GapRepair().Enabled() {
+				orm = headtracker.NewCheckpointORM(orm, cfg.EVM().HeadTracker())
+			}
 		} else {
 			orm = headtracker.NewNullORM()
 		}
 		headSaver = headtracker.NewHeadSaver(l, orm, cfg.EVM(), cfg.EVM().HeadTracker())
 		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, opts.MailMon)
+		if cfg.EVM().HeadTracker().PersistenceEnabled() && cfg.EVM().HeadTracker().GapRepair().Enabled() {
+			gapRepairer = headtracker.NewGapRepairer(l, chainID, orm, client, headTracker, cfg.EVM().HeadTracker())
+		}
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
@@ -260,7 +268,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, cfg.EVM(), txm, l)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
@@ -291,6 +299,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		logger:          l,
 		headBroadcaster: headBroadcaster,
 		headTracker:     headTracker,
+		gapRepairer:     gapRepairer,
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
@@ -322,6 +331,11 @@ func (c *chain) Start(ctx context.Context) error {
 				return err
 			}
 		}
+		if c.gapRepairer != nil {
+			if err := ms.Start(ctx, c.gapRepairer); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -335,6 +349,10 @@ func (c *chain) Close() error {
 			c.logger.Debug("Chain: stopping balance monitor")
 			merr = c.balanceMonitor.Close()
 		}
+		if c.gapRepairer != nil {
+			c.logger.Debug("Chain: stopping head gap repairer")
+			merr = multierr.Combine(merr, c.gapRepairer.Close())
+		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
@@ -361,6 +379,9 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.gapRepairer != nil {
+		merr = multierr.Combine(merr, c.gapRepairer.Ready())
+	}
 	return
 }
 
@@ -378,6 +399,9 @@ func (c *chain) HealthReport() map[string]error {
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
+	if c.gapRepairer != nil {
+		services.CopyHealth(report, c.gapRepairer.HealthReport())
+	}
 
 	return report
 }
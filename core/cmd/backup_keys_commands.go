@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type BackupKeyPresenter struct {
+	JAID
+	presenters.BackupKeyResource
+}
+
+// RenderTable implements TableRenderer
+func (p BackupKeyPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"ID"}
+	rows := [][]string{p.ToRow()}
+
+	if _, err := rt.Write([]byte("🔑 Backup Keys\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func (p *BackupKeyPresenter) ToRow() []string {
+	row := []string{
+		p.ID,
+	}
+
+	return row
+}
+
+type BackupKeyPresenters []BackupKeyPresenter
+
+// RenderTable implements TableRenderer
+func (ps BackupKeyPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"ID"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔑 Backup Keys\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func NewBackupKeysClient(s *Shell) KeysClient {
+	return newKeysClient[backupkey.Key, BackupKeyPresenter, BackupKeyPresenters]("Backup", s)
+}
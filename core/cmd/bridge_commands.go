@@ -3,6 +3,8 @@ package cmd
 import (
 	"errors"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -52,14 +54,41 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Fallback URLs", "Default Confirmations", "Outgoing Token"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
+		strings.Join(p.FallbackURLs, "\n"),
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
 	})
 	render("Bridge", table)
+
+	if len(p.Health) == 0 {
+		return nil
+	}
+	health := rt.newTable([]string{"URL", "Circuit Breaker", "Consecutive Failures", "Latency p50", "Latency p90", "Latency p99", "Last Checked", "Last Error"})
+	for _, h := range p.Health {
+		var lastChecked string
+		if h.LastCheckedAt != nil {
+			lastChecked = h.LastCheckedAt.Format(time.RFC3339)
+		}
+		var p50, p90, p99 string
+		if h.LatencySamples > 0 {
+			p50, p90, p99 = h.LatencyP50, h.LatencyP90, h.LatencyP99
+		}
+		health.Append([]string{
+			h.URL,
+			h.BreakerState,
+			strconv.FormatUint(uint64(h.ConsecutiveFailures), 10),
+			p50,
+			p90,
+			p99,
+			lastChecked,
+			h.LastError,
+		})
+	}
+	render("Bridge Health", health)
 	return nil
 }
 
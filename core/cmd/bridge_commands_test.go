@@ -23,6 +23,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	var (
 		name          = "Bridge 1"
 		url           = "http://example.com"
+		fallbackURL   = "http://fallback.example.com"
 		createdAt     = time.Now()
 		outgoingToken = "anoutgoingtoken"
 		buffer        = bytes.NewBufferString("")
@@ -34,9 +35,14 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			JAID:          presenters.NewJAID(name),
 			Name:          name,
 			URL:           url,
+			FallbackURLs:  []string{fallbackURL},
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
 			CreatedAt:     createdAt,
+			Health: []presenters.BridgeURLHealth{
+				{URL: url, BreakerState: "open", ConsecutiveFailures: 5, LastError: "connection refused"},
+				{URL: fallbackURL, BreakerState: "closed", LatencyP50: "120ms", LatencyP90: "240ms", LatencyP99: "1.5s", LatencySamples: 100},
+			},
 		},
 	}
 
@@ -46,8 +52,12 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	output := buffer.String()
 	assert.Contains(t, output, name)
 	assert.Contains(t, output, url)
+	assert.Contains(t, output, fallbackURL)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "open")
+	assert.Contains(t, output, "connection refused")
+	assert.Contains(t, output, "240ms")
 
 	// Render many resources
 	buffer.Reset()
@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -62,6 +65,27 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "spend",
+				Usage:  "Show the gas spent by EVM transactions over a rolling window, per job or per key",
+				Action: s.ShowTransactionSpend,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "by",
+						Usage: "aggregate spend per job or per key",
+						Value: "job",
+					},
+					cli.DurationFlag{
+						Name:  "window",
+						Usage: "rolling window to aggregate spend over",
+						Value: 24 * time.Hour,
+					},
+					cli.Int64Flag{
+						Name:  "id",
+						Usage: "chain ID, defaults to all chains",
+					},
+				},
+			},
 		},
 	}
 }
@@ -105,6 +129,61 @@ func (ps EthTxPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+type EthTxSpendPresenter struct {
+	JAID
+	presenters.EthTxSpendResource
+}
+
+type EthTxSpendPresenters []EthTxSpendPresenter
+
+// RenderTable implements TableRenderer
+func (ps EthTxSpendPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Chain ID", "Job ID", "Address", "Txs", "Gas Used", "L1 Fee", "Total Fee"})
+	for _, p := range ps {
+		var jobID, address string
+		if p.JobID != nil {
+			jobID = strconv.FormatInt(int64(*p.JobID), 10)
+		}
+		if p.Address != nil {
+			address = p.Address.Hex()
+		}
+		table.Append([]string{
+			p.EVMChainID.String(),
+			jobID,
+			address,
+			strconv.FormatInt(p.TxCount, 10),
+			strconv.FormatInt(p.GasUsed, 10),
+			p.L1Fee.String(),
+			p.TotalFee.String(),
+		})
+	}
+
+	render("EVM Transaction Spend", table)
+	return nil
+}
+
+// ShowTransactionSpend displays the gas spent by EVM transactions over a rolling window,
+// aggregated per job or per key
+func (s *Shell) ShowTransactionSpend(c *cli.Context) (err error) {
+	params := url.Values{}
+	params.Set("groupBy", c.String("by"))
+	params.Set("window", c.Duration("window").String())
+	if c.IsSet("id") {
+		params.Set("evmChainID", c.String("id"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/transactions/evm/spend?"+params.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EthTxSpendPresenters{})
+}
+
 // IndexTransactions returns the list of transactions in descending order,
 // taking an optional page parameter
 func (s *Shell) IndexTransactions(c *cli.Context) error {
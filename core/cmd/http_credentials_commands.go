@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"bytes"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initHTTPCredentialsSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "http",
+		Usage: "Remote commands for administering the credentials used by HTTP tasks",
+		Subcommands: cli.Commands{
+			{
+				Name:   "create",
+				Usage:  format(`Store an HTTP credential from a JSON file, encrypted with the rest of the node's keys. HTTP tasks reference it with credentialName.`),
+				Action: s.CreateHTTPCredential,
+			},
+			{
+				Name:   "list",
+				Usage:  format(`List HTTP credentials, without their secrets`),
+				Action: s.ListHTTPCredentials,
+			},
+			{
+				Name:  "delete",
+				Usage: format(`Delete an HTTP credential by name. To replace a credential, delete it and create it again.`),
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "skip the confirmation prompt",
+					},
+				},
+				Action: s.DeleteHTTPCredential,
+			},
+		},
+	}
+}
+
+type HTTPCredentialPresenter struct {
+	JAID
+	presenters.HTTPCredentialResource
+}
+
+// RenderTable implements TableRenderer
+func (p *HTTPCredentialPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Name", "Schemes", "Allowed hosts", "Headers", "OAuth2 token URL", "OAuth2 client ID", "HMAC key ID", "TLS expiry"}
+	rows := [][]string{p.ToRow()}
+
+	if _, err := rt.Write([]byte("🔑 HTTP Credentials\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return nil
+}
+
+func (p *HTTPCredentialPresenter) ToRow() []string {
+	var tlsNotAfter string
+	if p.TLSNotAfter != nil {
+		tlsNotAfter = p.TLSNotAfter.Format(time.RFC3339)
+	}
+	return []string{
+		p.Name,
+		strings.Join(p.Schemes, ", "),
+		strings.Join(p.AllowedHosts, ", "),
+		strings.Join(p.Headers, ", "),
+		p.OAuth2TokenURL,
+		p.OAuth2ClientID,
+		p.HMACKeyID,
+		tlsNotAfter,
+	}
+}
+
+type HTTPCredentialPresenters []HTTPCredentialPresenter
+
+// RenderTable implements TableRenderer
+func (ps HTTPCredentialPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"Name", "Schemes", "Allowed hosts", "Headers", "OAuth2 token URL", "OAuth2 client ID", "HMAC key ID", "TLS expiry"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔑 HTTP Credentials\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListHTTPCredentials lists the HTTP credentials
+func (s *Shell) ListHTTPCredentials(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/keys/http", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &HTTPCredentialPresenters{})
+}
+
+// CreateHTTPCredential stores an HTTP credential. The path to its JSON file must be passed, so that secrets are not
+// passed on the command line.
+func (s *Shell) CreateHTTPCredential(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the filepath of the credential JSON"))
+	}
+	credJSON, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keys/http", bytes.NewReader(credJSON))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &HTTPCredentialPresenter{}, "🔑 Created HTTP credential")
+}
+
+// DeleteHTTPCredential deletes an HTTP credential by name
+func (s *Shell) DeleteHTTPCredential(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the name of the credential to be deleted"))
+	}
+	name := c.Args().First()
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/keys/http/"+url.PathEscape(name))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &HTTPCredentialPresenter{}, "🔑 Deleted HTTP credential")
+}
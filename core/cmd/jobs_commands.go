@@ -50,6 +50,21 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a job run with stubbed task results, without creating the job",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "JSON string or file of stubbed http, bridge, ethcall and ethtx task results, keyed by task DOT ID",
+				},
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON string or file of pipeline variables, such as jobRun.meta",
+				},
+			},
+		},
 	}
 }
 
@@ -313,3 +328,103 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineSimulationPresenter wraps the JSONAPI PipelineSimulation Resource and adds rendering functionality
+type PipelineSimulationPresenter struct {
+	JAID
+	presenters.PipelineSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Stubbed", "Inputs", "Output", "Error", "Duration"})
+	for _, tr := range p.TaskRuns {
+		var inputs []string
+		for _, in := range tr.Inputs {
+			if in.Error != nil {
+				inputs = append(inputs, "error: "+*in.Error)
+			} else {
+				inputs = append(inputs, stringOrEmpty(in.Value))
+			}
+		}
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			fmt.Sprintf("%t", tr.Stubbed),
+			strings.Join(inputs, "\n"),
+			stringOrEmpty(tr.Output),
+			stringOrEmpty(tr.Error),
+			tr.Duration,
+		})
+	}
+	render("Simulated Task Runs", table)
+
+	table = rt.newTable([]string{"Outputs", "Fatal Errors"})
+	var outputs, fatalErrors []string
+	for _, o := range p.Outputs {
+		outputs = append(outputs, stringOrEmpty(o))
+	}
+	for _, e := range p.FatalErrors {
+		fatalErrors = append(fatalErrors, stringOrEmpty(e))
+	}
+	table.Append([]string{strings.Join(outputs, "\n"), strings.Join(fatalErrors, "\n")})
+	render("Simulated Run", table)
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// SimulateJob executes a job's pipeline on the node with stubbed task results, without creating the job.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.SimulateJobRequest{TOML: tomlString}
+	if fixtures := c.String("fixtures"); fixtures != "" {
+		buf, ferr := getBufferFromJSON(fixtures)
+		if ferr != nil {
+			return s.errorOut(ferr)
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.Fixtures); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid fixtures"))
+		}
+	}
+	if vars := c.String("vars"); vars != "" {
+		buf, verr := getBufferFromJSON(vars)
+		if verr != nil {
+			return s.errorOut(verr)
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid vars"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{})
+}
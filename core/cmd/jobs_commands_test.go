@@ -88,6 +88,50 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
+func TestPipelineSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+		str    = func(s string) *string { return &s }
+	)
+
+	p := cmd.PipelineSimulationPresenter{
+		PipelineSimulationResource: presenters.PipelineSimulationResource{
+			Outputs: []*string{str("123")},
+			TaskRuns: []presenters.PipelineSimulatedTaskRunResource{
+				{DotID: "ds1", Type: "http", Stubbed: true, Output: str(`{"USD":1.23}`), Duration: "1ms"},
+				{
+					DotID:    "ds1_parse",
+					Type:     "jsonparse",
+					Inputs:   []presenters.PipelineSimulatedTaskInput{{Value: str(`{"USD":1.23}`)}},
+					Output:   str("1.23"),
+					Duration: "2ms",
+				},
+				{
+					DotID:    "ds1_multiply",
+					Type:     "multiply",
+					Inputs:   []presenters.PipelineSimulatedTaskInput{{Error: str("boom")}},
+					Error:    str("task inputs: boom"),
+					Duration: "3ms",
+				},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "ds1_parse")
+	assert.Contains(t, output, "jsonparse")
+	assert.Contains(t, output, `{"USD":1.23}`)
+	assert.Contains(t, output, "error: boom")
+	assert.Contains(t, output, "task inputs: boom")
+	assert.Contains(t, output, "2ms")
+	assert.Contains(t, output, "123")
+}
+
 func TestJobRenderer_GetTasks(t *testing.T) {
 	t.Parallel()
 
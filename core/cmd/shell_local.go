@@ -4,6 +4,7 @@ import (
 	"context"
 	crand "crypto/rand"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math"
@@ -37,12 +38,16 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
@@ -117,6 +122,18 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Name:  "gasLimit, gas-limit",
 					Usage: "OPTIONAL: gas limit to use for each transaction ",
 				},
+				cli.BoolFlag{
+					Name:  "dryRun, dry-run",
+					Usage: "OPTIONAL: only display the plan for each nonce, without sending any transactions",
+				},
+				cli.BoolFlag{
+					Name:  "fillGaps, fill-gaps",
+					Usage: "OPTIONAL: only rebroadcast nonces which are neither mined nor known to the node. Beginning and ending nonces default to the mined nonce and the highest nonce in the DB",
+				},
+				cli.StringFlag{
+					Name:  "report, r",
+					Usage: "OPTIONAL: file to write a JSON report of the plan and results to",
+				},
 			},
 		},
 		{
@@ -237,6 +254,34 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 						},
 					},
 				},
+				{
+					Name:   "restore-backup",
+					Usage:  "Restore a database backup taken by the node, fetching it from the sink configured in Database.Backup. Restores the most recent backup unless --name is set. WARNING: This will REPLACE ALL DATA in the database referred to by CL_DATABASE_URL env variable or by the Database.URL field in a secrets TOML config.",
+					Action: s.RestoreDatabaseBackup,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "name",
+							Usage: "name of the backup to restore, as shown by --list",
+						},
+						cli.BoolFlag{
+							Name:  "list",
+							Usage: "list the available backups instead of restoring one",
+						},
+						cli.StringFlag{
+							Name:  "key-file",
+							Usage: "`FILE` holding the backup key exported with `chainlink keys backup export`, required to restore encrypted backups",
+						},
+						cli.StringFlag{
+							Name:  "key-password",
+							Usage: "`FILE` containing the password the backup key was exported with",
+						},
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+				},
 				{
 					Name:    "delete-chain",
 					Aliases: []string{},
@@ -279,6 +324,58 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "logpoller",
+			Usage: "Commands for exporting and importing LogPoller data, to bootstrap new nodes",
+			Subcommands: []cli.Command{
+				{
+					Name:   "export",
+					Usage:  "Exports the filters, blocks and logs of a finalized block range to a snapshot file",
+					Action: s.ExportLogPoller,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "evm-chain-id",
+							Usage:    "Chain ID of the EVM-based blockchain",
+							Required: true,
+						},
+						cli.Int64Flag{
+							Name:  "from-block",
+							Usage: "Beginning of block range to be exported",
+						},
+						cli.Int64Flag{
+							Name:  "to-block",
+							Usage: "End of block range to be exported, defaults to the latest finalized block",
+						},
+						cli.StringFlag{
+							Name:     "file, f",
+							Usage:    "Path of the snapshot file to write",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "import",
+					Usage:  "Imports a snapshot file, after validating its block hashes against the RPC",
+					Action: s.ImportLogPoller,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "evm-chain-id",
+							Usage:    "Chain ID of the EVM-based blockchain",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "file, f",
+							Usage:    "Path of the snapshot file to read",
+							Required: true,
+						},
+						cli.BoolFlag{
+							Name:  "import-filters",
+							Usage: "Also register the filters of the snapshot, and import their logs. By default, only the logs matching the filters of this node are imported",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -476,6 +573,13 @@ func (s *Shell) runNode(c *cli.Context) error {
 		}
 	}
 
+	if s.Config.Database().Backup().Encrypt() {
+		err2 := app.GetKeyStore().Backup().EnsureKey(rootCtx)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to ensure backup key")
+		}
+	}
+
 	err2 := app.GetKeyStore().CSA().EnsureKey(rootCtx)
 	if err2 != nil {
 		return errors.Wrap(err2, "failed to ensure CSA key")
@@ -601,6 +705,9 @@ func (s *Shell) RebroadcastTransactions(c *cli.Context) (err error) {
 	overrideGasLimit := c.Uint("gasLimit")
 	addressHex := c.String("address")
 	chainIDStr := c.String("evmChainID")
+	dryRun := c.Bool("dryRun")
+	fillGaps := c.Bool("fillGaps")
+	reportPath := c.String("report")
 
 	addressBytes, err := hexutil.Decode(addressHex)
 	if err != nil {
@@ -672,25 +779,206 @@ func (s *Shell) RebroadcastTransactions(c *cli.Context) (err error) {
 		return s.errorOut(err)
 	}
 
-	s.Logger.Infof("Rebroadcasting transactions from %v to %v", beginningNonce, endingNonce)
+	if gasPriceWei > math.MaxInt64 {
+		return s.errorOut(fmt.Errorf("integer overflow conversion error. GasPrice: %v", gasPriceWei))
+	}
+	//nolint:gosec // disable G115
+	fee := gas.EvmFee{GasPrice: assets.NewWeiI(int64(gasPriceWei))}
 
 	orm := txmgr.NewTxStore(app.GetDB(), lggr)
+
+	// On-chain state is only needed to plan or report, so the plain rebroadcast keeps working against nodes
+	// which are out of sync.
+	planned := dryRun || fillGaps || reportPath != ""
+	var minedNonce, pendingNonce uint64
+	if planned {
+		minedNonce, err = ethClient.NonceAt(ctx, address, nil)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to fetch mined nonce"))
+		}
+		pendingNonce, err = ethClient.PendingNonceAt(ctx, address)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to fetch pending nonce"))
+		}
+	}
+	if fillGaps {
+		if !c.IsSet("beginningNonce") {
+			beginningNonce = int64(minedNonce) //nolint:gosec // nonces fit in int64
+		}
+		if !c.IsSet("endingNonce") {
+			latest, err2 := orm.FindLatestSequence(ctx, address, chain.ID())
+			if err2 != nil && !errors.Is(err2, sql.ErrNoRows) {
+				return s.errorOut(errors.Wrap(err2, "failed to find latest nonce"))
+			}
+			endingNonce = latest.Int64()
+			if errors.Is(err2, sql.ErrNoRows) {
+				endingNonce = int64(pendingNonce) - 1 //nolint:gosec // nonces fit in int64
+			}
+		}
+	}
+
+	report := RebroadcastReport{
+		EVMChainID:   chain.ID().String(),
+		Address:      address,
+		DryRun:       dryRun,
+		FillGaps:     fillGaps,
+		MinedNonce:   minedNonce,
+		PendingNonce: pendingNonce,
+	}
+	var nonces []evmtypes.Nonce
+	for nonce := beginningNonce; nonce <= endingNonce; nonce++ {
+		etx, err2 := orm.FindTxWithSequence(ctx, address, evmtypes.Nonce(nonce))
+		if err2 != nil {
+			return s.errorOut(err2)
+		}
+		entry := RebroadcastReportTx{
+			Nonce:    nonce,
+			DBState:  "none",
+			GasPrice: fee.GasPrice.String(),
+			GasLimit: uint64(overrideGasLimit),
+			Action:   "send_empty",
+		}
+		if planned {
+			entry.OnChainState = onChainNonceState(ctx, ethClient, uint64(nonce), minedNonce, pendingNonce, etx) //nolint:gosec // nonce is non-negative
+		}
+		if etx != nil {
+			entry.TxID = &etx.ID
+			entry.DBState = string(etx.State)
+			entry.Action = "rebroadcast"
+			if entry.GasLimit == 0 {
+				entry.GasLimit = etx.FeeLimit
+			}
+		}
+		if entry.GasLimit == 0 {
+			entry.GasLimit = chain.Config().EVM().GasEstimator().LimitDefault()
+		}
+		if fillGaps && entry.OnChainState != rebroadcastNonceMissing {
+			entry.Action = "skip"
+		} else {
+			nonces = append(nonces, evmtypes.Nonce(nonce))
+		}
+		report.Transactions = append(report.Transactions, entry)
+	}
+
+	if dryRun {
+		s.Logger.Infof("Dry run: planned rebroadcast of %d transactions from %v to %v", len(nonces), beginningNonce, endingNonce)
+		if err = writeRebroadcastReport(reportPath, report); err != nil {
+			return s.errorOut(err)
+		}
+		return s.Renderer.Render(&RebroadcastReportPresenter{report})
+	}
+
+	s.Logger.Infof("Rebroadcasting %d transactions from %v to %v", len(nonces), beginningNonce, endingNonce)
+
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), chain.Config().EVM().GasEstimator(), keyStore.Eth(), nil)
 	cfg := txmgr.NewEvmTxmConfig(chain.Config().EVM())
 	feeCfg := txmgr.NewEvmTxmFeeConfig(chain.Config().EVM().GasEstimator())
 	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, ethClient.ConfiguredChainID(), "", assets.NewWei(assets.NewEth(100).ToInt()), chain.Config().EVM().Transactions().AutoPurge(), nil, orm, ethClient)
 	ec := txmgr.NewEvmConfirmer(orm, txmgr.NewEvmTxmClient(ethClient, chain.Config().EVM().NodePool().Errors()),
 		cfg, feeCfg, chain.Config().EVM().Transactions(), app.GetConfig().Database(), keyStore.Eth(), txBuilder, chain.Logger(), stuckTxDetector, chain.HeadTracker())
-	totalNonces := endingNonce - beginningNonce + 1
-	nonces := make([]evmtypes.Nonce, totalNonces)
-	for i := int64(0); i < totalNonces; i++ {
-		nonces[i] = evmtypes.Nonce(beginningNonce + i)
+	results, err := ec.ForceRebroadcast(ctx, nonces, fee, address, uint64(overrideGasLimit))
+	for _, result := range results {
+		for i := range report.Transactions {
+			if report.Transactions[i].Nonce != result.Sequence.Int64() {
+				continue
+			}
+			report.Transactions[i].TxHash = result.TxHash
+			if result.Err != nil {
+				report.Transactions[i].Error = result.Err.Error()
+			}
+		}
+	}
+	if rerr := writeRebroadcastReport(reportPath, report); rerr != nil {
+		err = multierr.Append(err, rerr)
+	}
+	return s.errorOut(err)
+}
+
+const (
+	rebroadcastNonceMined   = "mined"
+	rebroadcastNoncePending = "pending"
+	rebroadcastNonceMissing = "missing"
+)
+
+// onChainNonceState returns whether the nonce is mined, pending in the node's mempool, or missing.
+// Nonces past a gap are not counted by the pending nonce, so the attempts of a tx are checked directly.
+func onChainNonceState(ctx context.Context, ethClient evmclient.Client, nonce, minedNonce, pendingNonce uint64, etx *txmgr.Tx) string {
+	switch {
+	case nonce < minedNonce:
+		return rebroadcastNonceMined
+	case nonce < pendingNonce:
+		return rebroadcastNoncePending
+	}
+	if etx != nil {
+		for _, attempt := range etx.TxAttempts {
+			if tx, err := ethClient.TransactionByHash(ctx, attempt.Hash); err == nil && tx != nil {
+				return rebroadcastNoncePending
+			}
+		}
 	}
-	if gasPriceWei <= math.MaxInt64 {
-		//nolint:gosec // disable G115
-		return s.errorOut(ec.ForceRebroadcast(ctx, nonces, gas.EvmFee{GasPrice: assets.NewWeiI(int64(gasPriceWei))}, address, uint64(overrideGasLimit)))
+	return rebroadcastNonceMissing
+}
+
+func writeRebroadcastReport(path string, report RebroadcastReport) error {
+	if path == "" {
+		return nil
+	}
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal report")
+	}
+	return errors.Wrap(os.WriteFile(path, b, 0600), "failed to write report")
+}
+
+// RebroadcastReport is the plan, and results when not a dry run, of rebroadcast-transactions.
+type RebroadcastReport struct {
+	EVMChainID   string                `json:"evmChainID"`
+	Address      gethCommon.Address    `json:"address"`
+	DryRun       bool                  `json:"dryRun"`
+	FillGaps     bool                  `json:"fillGaps"`
+	MinedNonce   uint64                `json:"minedNonce"`
+	PendingNonce uint64                `json:"pendingNonce"`
+	Transactions []RebroadcastReportTx `json:"transactions"`
+}
+
+// RebroadcastReportTx is the plan and result for a single nonce.
+type RebroadcastReportTx struct {
+	Nonce        int64  `json:"nonce"`
+	TxID         *int64 `json:"txID,omitempty"`
+	DBState      string `json:"dbState"`
+	OnChainState string `json:"onChainState,omitempty"`
+	Action       string `json:"action"`
+	GasPrice     string `json:"gasPrice"`
+	GasLimit     uint64 `json:"gasLimit"`
+	TxHash       string `json:"txHash,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+type RebroadcastReportPresenter struct {
+	RebroadcastReport
+}
+
+// RenderTable implements TableRenderer
+func (p *RebroadcastReportPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Nonce", "Tx ID", "DB State", "On-chain State", "Action", "Gas Price", "Gas Limit"})
+	for _, tx := range p.Transactions {
+		var txID string
+		if tx.TxID != nil {
+			txID = strconv.FormatInt(*tx.TxID, 10)
+		}
+		table.Append([]string{
+			strconv.FormatInt(tx.Nonce, 10),
+			txID,
+			tx.DBState,
+			tx.OnChainState,
+			tx.Action,
+			tx.GasPrice,
+			strconv.FormatUint(tx.GasLimit, 10),
+		})
 	}
-	return s.errorOut(fmt.Errorf("integer overflow conversion error. GasPrice: %v", gasPriceWei))
+
+	render(fmt.Sprintf("Rebroadcast plan for %s on chain %s (mined nonce %d, pending nonce %d)", p.Address.Hex(), p.EVMChainID, p.MinedNonce, p.PendingNonce), table)
+	return nil
 }
 
 type HealthCheckPresenter struct {
@@ -1029,6 +1317,61 @@ func (s *Shell) CreateMigration(c *cli.Context) error {
 	return nil
 }
 
+// RestoreDatabaseBackup lists or restores database backups taken by the node.
+func (s *Shell) RestoreDatabaseBackup(c *cli.Context) error {
+	ctx := s.ctx()
+	cfg := s.Config.Database()
+	backupCfg := cfg.Backup()
+
+	if c.Bool("list") {
+		backups, err := periodicbackup.ListBackups(ctx, s.Config.RootDir(), backupCfg)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to list backups"))
+		}
+		if len(backups) == 0 {
+			s.Logger.Info("No backups found")
+		}
+		for _, b := range backups {
+			s.Logger.Infof("%s\t%d bytes\t%s", b.Name, b.Size, b.ModTime.Format(time.RFC3339))
+		}
+		return nil
+	}
+
+	var getKey periodicbackup.BackupKeyFunc
+	if keyFile := c.String("key-file"); keyFile != "" {
+		keyJSON, err := os.ReadFile(keyFile)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to read backup key file"))
+		}
+		passwordFile := c.String("key-password")
+		if passwordFile == "" {
+			return s.errorOut(errors.New("Must specify --key-password with --key-file"))
+		}
+		// trimmed like the password given to `chainlink keys backup export`
+		password, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "Could not read password file"))
+		}
+		key, err := backupkey.FromEncryptedJSON(keyJSON, strings.TrimSpace(string(password)))
+		if err != nil {
+			return s.errorOut(err)
+		}
+		getKey = periodicbackup.StaticBackupKey(key)
+	}
+
+	dbURL := cfg.URL()
+	s.Logger.Warnf("This will replace all data in database %s", dbURL.Redacted())
+	if !confirmAction(c) {
+		return nil
+	}
+
+	if err := periodicbackup.RestoreBackup(ctx, dbURL, s.Config.RootDir(), backupCfg, c.String("name"), getKey, s.Logger); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to restore backup"))
+	}
+	s.Logger.Info("Backup restored successfully. Run `chainlink node db migrate` if the backup was taken by an older node version.")
+	return nil
+}
+
 // CleanupChainTables deletes database table rows based on chain type and chain id input.
 func (s *Shell) CleanupChainTables(c *cli.Context) error {
 	ctx := s.ctx()
@@ -1245,15 +1588,90 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 		}
 	}
 
+	return s.runWithLockedApp("RemoveBlocks", func(ctx context.Context, app chainlink.Application, lggr logger.SugaredLogger) error {
+		if err := app.DeleteLogPollerDataAfter(ctx, chainID, start); err != nil {
+			return err
+		}
+
+		lggr.Infof("RemoveBlocks: successfully removed blocks")
+		return nil
+	})
+}
+
+// ExportLogPoller writes the LogPoller data of a finalized block range to a snapshot file.
+func (s *Shell) ExportLogPoller(c *cli.Context) error {
+	from, to := c.Int64("from-block"), c.Int64("to-block")
+	if from < 0 || to < 0 {
+		return s.errorOut(errors.New("'--from-block' and '--to-block' must not be negative"))
+	}
+	if to != 0 && from > to {
+		return s.errorOut(errors.New("'--from-block' must not be greater than '--to-block'"))
+	}
+	chainID := big.NewInt(c.Int64("evm-chain-id"))
+	path := c.String("file")
+
+	return s.runWithLockedApp("ExportLogPoller", func(ctx context.Context, app chainlink.Application, lggr logger.SugaredLogger) error {
+		// Write to a temporary file first, so that a failed export does not leave a truncated snapshot behind.
+		f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+		if err != nil {
+			return errors.Wrap(err, "failed to create snapshot file")
+		}
+		defer os.Remove(f.Name())
+
+		info, err := app.ExportLogPollerData(ctx, chainID, from, to, f)
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		if err = f.Close(); err != nil {
+			return errors.Wrap(err, "failed to write snapshot file")
+		}
+		if err = os.Rename(f.Name(), path); err != nil {
+			return errors.Wrap(err, "failed to write snapshot file")
+		}
+
+		lggr.Infow("ExportLogPoller: successfully exported snapshot", "file", path, "fromBlock", info.FromBlock, "toBlock", info.ToBlock,
+			"filters", info.Filters, "blocks", info.Blocks, "logs", info.Logs)
+		return nil
+	})
+}
+
+// ImportLogPoller restores LogPoller data from a snapshot file written by ExportLogPoller.
+func (s *Shell) ImportLogPoller(c *cli.Context) error {
+	chainID := big.NewInt(c.Int64("evm-chain-id"))
+	path := c.String("file")
+	importFilters := c.Bool("import-filters")
+
+	return s.runWithLockedApp("ImportLogPoller", func(ctx context.Context, app chainlink.Application, lggr logger.SugaredLogger) error {
+		f, err := os.Open(path)
+		if err != nil {
+			return errors.Wrap(err, "failed to open snapshot file")
+		}
+		defer f.Close()
+
+		info, err := app.ImportLogPollerData(ctx, chainID, f, importFilters)
+		if err != nil {
+			return err
+		}
+
+		lggr.Infow("ImportLogPoller: successfully imported snapshot", "file", path, "fromBlock", info.FromBlock, "toBlock", info.ToBlock,
+			"filters", info.Filters, "blocks", info.Blocks, "logs", info.Logs)
+		return nil
+	})
+}
+
+// runWithLockedApp instantiates the application, without starting it, while holding the database lock, and calls fn.
+func (s *Shell) runWithLockedApp(name string, fn func(ctx context.Context, app chainlink.Application, lggr logger.SugaredLogger) error) error {
 	cfg := s.Config
 	err := cfg.Validate()
 	if err != nil {
 		return s.errorOut(fmt.Errorf("error validating configuration: %+v", err))
 	}
 
-	lggr := logger.Sugared(s.Logger.Named("RemoveBlocks"))
+	lggr := logger.Sugared(s.Logger.Named(name))
 	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	go shutdown.HandleShutdown(func(sig string) {
 		cancel()
 		lggr.Info("received signal to stop - closing the database and releasing lock")
@@ -1281,12 +1699,8 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 		return s.errorOut(errors.Wrap(err, "fatal error instantiating application"))
 	}
 
-	err = app.DeleteLogPollerDataAfter(ctx, chainID, start)
-	if err != nil {
+	if err = fn(ctx, app, lggr); err != nil {
 		return s.errorOut(err)
 	}
-
-	lggr.Infof("RemoveBlocks: successfully removed blocks")
-
 	return nil
 }
@@ -1,10 +1,13 @@
 package cmd_test
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -14,6 +17,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	cmdMocks "github.com/smartcontractkit/chainlink/v2/core/cmd/mocks"
@@ -343,6 +347,110 @@ func TestShell_RebroadcastTransactions_Txm(t *testing.T) {
 	assert.NoError(t, c.RebroadcastTransactions(ctx))
 }
 
+func TestShell_RebroadcastTransactions_FillGaps_Txm(t *testing.T) {
+	for _, dryRun := range []bool{true, false} {
+		t.Run(fmt.Sprintf("dryRun=%t", dryRun), func(t *testing.T) {
+			// Use a non-transactional db for this test because we need to
+			// test multiple connections to the database, and changes made within
+			// the transaction cannot be seen from another connection.
+			config, sqlxDB := heavyweight.FullTestDBV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.Database.Dialect = dialects.Postgres
+				// evm config is used in this test. but if set, it must be pass config validation.
+				// simplest to make it nil
+				c.EVM = nil
+				// seems to be needed for config validate
+				c.Insecure.OCRDevelopmentMode = nil
+			})
+			keyStore := cltest.NewKeyStore(t, sqlxDB)
+			_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+			// nonce 7 is mined, nonce 8 is a gap and nonce 9 is stuck behind it in the mempool
+			txStore := cltest.NewTestTxStore(t, sqlxDB)
+			cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 7, 42, fromAddress)
+			stuck := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 9, fromAddress)
+
+			app := mocks.NewApplication(t)
+			app.On("GetDB").Return(sqlxDB)
+			app.On("GetKeyStore").Return(keyStore)
+			app.On("ID").Maybe().Return(uuid.New())
+			app.On("GetConfig").Return(config)
+			ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+			legacy := cltest.NewLegacyChainsWithMockChain(t, ethClient, config)
+
+			mockRelayerChainInteroperators := &chainlinkmocks.FakeRelayerChainInteroperators{EVMChains: legacy}
+			app.On("GetRelayers").Return(mockRelayerChainInteroperators).Maybe()
+			ethClient.On("Dial", mock.Anything).Return(nil)
+			ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(8), nil)
+			ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(8), nil)
+			ethClient.On("TransactionByHash", mock.Anything, stuck.TxAttempts[0].Hash).Return(&gethTypes.Transaction{}, nil)
+
+			r := &cltest.RendererMock{}
+			c := cmd.Shell{
+				Renderer:               r,
+				Config:                 config,
+				AppFactory:             cltest.InstanceAppFactory{App: app},
+				FallbackAPIInitializer: cltest.NewMockAPIInitializer(t),
+				Runner:                 cltest.EmptyRunner{},
+				Logger:                 logger.TestLogger(t),
+			}
+
+			reportPath := filepath.Join(t.TempDir(), "report.json")
+			set := flag.NewFlagSet("test", 0)
+			flagSetApplyFromAction(c.RebroadcastTransactions, set, "")
+
+			require.NoError(t, set.Set("evmChainID", testutils.FixtureChainID.String()))
+			require.NoError(t, set.Set("gasPriceWei", "100000000000"))
+			require.NoError(t, set.Set("gasLimit", "3000000"))
+			require.NoError(t, set.Set("address", fromAddress.Hex()))
+			require.NoError(t, set.Set("password", "../internal/fixtures/correct_password.txt"))
+			require.NoError(t, set.Set("fillGaps", "true"))
+			require.NoError(t, set.Set("dryRun", strconv.FormatBool(dryRun)))
+			require.NoError(t, set.Set("report", reportPath))
+
+			if !dryRun {
+				ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+					return tx.Nonce() == 8
+				}), mock.Anything).Once().Return(client.Successful, nil)
+			}
+
+			require.NoError(t, c.RebroadcastTransactions(cli.NewContext(nil, set, nil)))
+
+			b, err := os.ReadFile(reportPath)
+			require.NoError(t, err)
+			var report cmd.RebroadcastReport
+			require.NoError(t, json.Unmarshal(b, &report))
+			assert.Equal(t, dryRun, report.DryRun)
+			assert.Equal(t, uint64(8), report.MinedNonce)
+			require.Len(t, report.Transactions, 2)
+
+			gap := report.Transactions[0]
+			assert.Equal(t, int64(8), gap.Nonce)
+			assert.Nil(t, gap.TxID)
+			assert.Equal(t, "none", gap.DBState)
+			assert.Equal(t, "missing", gap.OnChainState)
+			assert.Equal(t, "send_empty", gap.Action)
+			assert.Equal(t, uint64(3000000), gap.GasLimit)
+
+			pending := report.Transactions[1]
+			assert.Equal(t, int64(9), pending.Nonce)
+			assert.Equal(t, &stuck.ID, pending.TxID)
+			assert.Equal(t, "unconfirmed", pending.DBState)
+			assert.Equal(t, "pending", pending.OnChainState)
+			assert.Equal(t, "skip", pending.Action)
+			assert.Empty(t, pending.TxHash)
+
+			if dryRun {
+				require.Len(t, r.Renders, 1)
+				assert.Empty(t, gap.TxHash)
+			} else {
+				assert.Empty(t, r.Renders)
+				assert.NotEmpty(t, gap.TxHash)
+				assert.Empty(t, gap.Error)
+			}
+		})
+	}
+}
+
 func TestShell_RebroadcastTransactions_OutsideRange_Txm(t *testing.T) {
 	beginningNonce := uint(7)
 	endingNonce := uint(10)
@@ -570,3 +678,98 @@ func TestShell_RemoveBlocks(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestShell_ExportImportLogPoller(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		s.Password.Keystore = models.NewSecret("dummy")
+		c.EVM[0].Nodes[0].Name = ptr("fake")
+		c.EVM[0].Nodes[0].HTTPURL = commonconfig.MustParseURL("http://fake.com")
+		c.EVM[0].Nodes[0].WSURL = commonconfig.MustParseURL("WSS://fake.com/ws")
+		// seems to be needed for config validate
+		c.Insecure.OCRDevelopmentMode = nil
+	})
+
+	lggr := logger.TestLogger(t)
+
+	app := mocks.NewApplication(t)
+	app.On("GetSqlxDB").Maybe().Return(db)
+	shell := cmd.Shell{
+		Config:                 cfg,
+		AppFactory:             cltest.InstanceAppFactory{App: app},
+		FallbackAPIInitializer: cltest.NewMockAPIInitializer(t),
+		Runner:                 cltest.EmptyRunner{},
+		Logger:                 lggr,
+	}
+	file := filepath.Join(t.TempDir(), "snapshot.gz")
+	info := &logpoller.SnapshotInfo{Version: logpoller.SnapshotVersion, FromBlock: 100, ToBlock: 200}
+
+	t.Run("Export returns error, if the block range is invalid", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogPoller, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("from-block", "200"))
+		require.NoError(t, set.Set("to-block", "100"))
+		require.NoError(t, set.Set("file", file))
+		c := cli.NewContext(nil, set, nil)
+		err := shell.ExportLogPoller(c)
+		require.ErrorContains(t, err, "'--from-block' must not be greater than '--to-block'")
+	})
+	t.Run("Export does not leave a file behind, if export fails", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogPoller, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("from-block", "100"))
+		require.NoError(t, set.Set("file", file))
+		expectedError := fmt.Errorf("failed to export LogPoller data")
+		app.On("ExportLogPollerData", mock.Anything, big.NewInt(12), int64(100), int64(0), mock.Anything).Return(nil, expectedError).Once()
+		c := cli.NewContext(nil, set, nil)
+		err := shell.ExportLogPoller(c)
+		require.ErrorContains(t, err, expectedError.Error())
+		require.NoFileExists(t, file)
+	})
+	t.Run("Export happy path", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogPoller, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("from-block", "100"))
+		require.NoError(t, set.Set("to-block", "200"))
+		require.NoError(t, set.Set("file", file))
+		app.On("ExportLogPollerData", mock.Anything, big.NewInt(12), int64(100), int64(200), mock.Anything).
+			Run(func(args mock.Arguments) {
+				_, err := args.Get(4).(io.Writer).Write([]byte("snapshot"))
+				require.NoError(t, err)
+			}).Return(info, nil).Once()
+		c := cli.NewContext(nil, set, nil)
+		require.NoError(t, shell.ExportLogPoller(c))
+		b, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.Equal(t, "snapshot", string(b))
+	})
+	t.Run("Import returns error, if import fails", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportLogPoller, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", file))
+		expectedError := fmt.Errorf("block 150 hash mismatch")
+		app.On("ImportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything, false).Return(nil, expectedError).Once()
+		c := cli.NewContext(nil, set, nil)
+		err := shell.ImportLogPoller(c)
+		require.ErrorContains(t, err, expectedError.Error())
+	})
+	t.Run("Import happy path", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportLogPoller, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", file))
+		require.NoError(t, set.Set("import-filters", "true"))
+		app.On("ImportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything, true).
+			Run(func(args mock.Arguments) {
+				b, err := io.ReadAll(args.Get(2).(io.ReadSeeker))
+				require.NoError(t, err)
+				assert.Equal(t, "snapshot", string(b))
+			}).Return(info, nil).Once()
+		c := cli.NewContext(nil, set, nil)
+		require.NoError(t, shell.ImportLogPoller(c))
+	})
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "executions",
+			Usage: "Commands for inspecting and managing workflow executions",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List workflow executions, newest first",
+					Action: s.ListWorkflowExecutions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "only list executions of this workflow",
+						},
+						cli.StringSliceFlag{
+							Name:  "status",
+							Usage: "only list executions with this status, may be repeated",
+						},
+						cli.StringFlag{
+							Name:  "created-after",
+							Usage: "only list executions created at or after this RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "created-before",
+							Usage: "only list executions created before this RFC3339 time",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a workflow execution and the state of its steps",
+					Action: s.ShowWorkflowExecution,
+				},
+				{
+					Name:   "cancel",
+					Usage:  "Cancel a workflow execution which is in progress",
+					Action: s.CancelWorkflowExecution,
+				},
+				{
+					Name:   "replay",
+					Usage:  "Replay a finished workflow execution with its original trigger output",
+					Action: s.ReplayWorkflowExecution,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "from-failed-step",
+							Usage: "keep the steps which completed, and only run the remaining steps again",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WorkflowExecutionPresenter struct {
+	presenters.WorkflowExecutionResource
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created At", "Finished At"})
+	table.Append(p.ToRow())
+	render("Workflow Execution", table)
+
+	steps := rt.newTable([]string{"Ref", "Status", "Inputs", "Outputs", "Error"})
+	for _, step := range p.Steps {
+		var errMsg string
+		if step.Error != nil {
+			errMsg = *step.Error
+		}
+		steps.Append([]string{step.Ref, step.Status, string(step.Inputs), string(step.Outputs), errMsg})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+func (p *WorkflowExecutionPresenter) ToRow() []string {
+	return []string{p.ID, p.WorkflowID, p.Status, formatOptionalTime(p.CreatedAt), formatOptionalTime(p.FinishedAt)}
+}
+
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created At", "Finished At"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+// ListWorkflowExecutions lists the executions of workflows matching the given filters.
+func (s *Shell) ListWorkflowExecutions(c *cli.Context) (err error) {
+	q := url.Values{}
+	if id := c.String("workflow-id"); id != "" {
+		q.Set("workflowID", id)
+	}
+	if statuses := c.StringSlice("status"); len(statuses) > 0 {
+		q.Set("status", strings.Join(statuses, ","))
+	}
+	for _, f := range []struct{ flag, param string }{
+		{"created-after", "createdAfter"},
+		{"created-before", "createdBefore"},
+	} {
+		v := c.String(f.flag)
+		if v == "" {
+			continue
+		}
+		if _, err = time.Parse(time.RFC3339, v); err != nil {
+			return s.errorOut(errors.New("--" + f.flag + " must be an RFC3339 time"))
+		}
+		q.Set(f.param, v)
+	}
+
+	uri := "/v2/workflows/executions"
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+	return s.getPage(uri, c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution shows a workflow execution by ID.
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow execution to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// CancelWorkflowExecution cancels a workflow execution which is in progress.
+func (s *Shell) CancelWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow execution to be cancelled"))
+	}
+	uri := "/v2/workflows/executions/" + url.PathEscape(c.Args().First()) + "/cancel"
+	resp, err := s.HTTP.Post(s.ctx(), uri, bytes.NewBufferString("{}"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Workflow execution cancelled")
+}
+
+// ReplayWorkflowExecution starts a new execution with the trigger output of a finished workflow execution.
+func (s *Shell) ReplayWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow execution to be replayed"))
+	}
+	q := url.Values{}
+	q.Set("fromFailedStep", strconv.FormatBool(c.Bool("from-failed-step")))
+	uri := "/v2/workflows/executions/" + url.PathEscape(c.Args().First()) + "/replay?" + q.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), uri, bytes.NewBufferString("{}"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Workflow execution replayed")
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -66,3 +66,11 @@ var (
 	DatabaseBackupModeLite DatabaseBackupMode = "lite"
 	DatabaseBackupModeFull DatabaseBackupMode = "full"
 )
+
+type DatabaseBackupSink string
+
+var (
+	DatabaseBackupSinkLocal DatabaseBackupSink = "local"
+	DatabaseBackupSinkS3    DatabaseBackupSink = "s3"
+	DatabaseBackupSinkSFTP  DatabaseBackupSink = "sftp"
+)
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 )
 
@@ -11,6 +13,37 @@ type CapabilitiesExternalRegistry interface {
 	RelayID() types.RelayID
 }
 
+type CapabilitiesWorkflowRegistry interface {
+	Address() string
+	NetworkID() string
+	ChainID() string
+	RelayID() types.RelayID
+}
+
+type CapabilitiesWorkflowExecutions interface {
+	ReaperInterval() time.Duration
+	ReaperThreshold() time.Duration
+	MaxPerWorkflow() uint32
+	ReaperBatchSize() uint32
+}
+
+// CapabilitiesComputeQuotas are the resource quotas of custom compute WASM modules by workflow owner.
+type CapabilitiesComputeQuotas interface {
+	Default() ComputeQuota
+	// Owners are keyed by lower case workflow owner address, and replace the default quota.
+	Owners() map[string]ComputeQuota
+}
+
+// ComputeQuota limits the resources of a WASM module. Zero values leave a resource unlimited.
+type ComputeQuota interface {
+	MaxMemoryMBs() int64
+	MaxFuel() uint64
+	MaxTimeout() time.Duration
+	MaxFetchRequests() uint32
+	MaxResponseBytes() uint32
+	AllowedHosts() []string
+}
+
 type GatewayConnector interface {
 	ChainIDForNodeKey() string
 	NodeAddress() string
@@ -30,5 +63,8 @@ type Capabilities interface {
 	Peering() P2P
 	Dispatcher() Dispatcher
 	ExternalRegistry() CapabilitiesExternalRegistry
+	WorkflowRegistry() CapabilitiesWorkflowRegistry
+	WorkflowExecutions() CapabilitiesWorkflowExecutions
+	ComputeQuotas() CapabilitiesComputeQuotas
 	GatewayConnector() GatewayConnector
 }
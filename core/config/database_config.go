@@ -13,6 +13,29 @@ type Backup interface {
 	Mode() DatabaseBackupMode
 	OnVersionUpgrade() bool
 	URL() *url.URL
+	Sink() DatabaseBackupSink
+	MaxCount() uint32
+	MaxAge() time.Duration
+	Encrypt() bool
+	S3() BackupS3
+	SFTP() BackupSFTP
+}
+
+type BackupS3 interface {
+	Endpoint() *url.URL
+	Region() string
+	Bucket() string
+	Prefix() string
+	AccessKeyID() string
+	SecretAccessKey() string
+}
+
+type BackupSFTP interface {
+	Address() string
+	User() string
+	KeyFile() string
+	HostKey() string
+	Dir() string
 }
 
 type Lock interface {
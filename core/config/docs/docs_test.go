@@ -54,7 +54,8 @@ func TestDoc(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
 		ks := evmcfg.KeySpecific{Key: new(types.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator:   evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			BalanceMonitor: evmcfg.KeySpecificBalanceMonitor{LowBalanceThreshold: new(assets.Wei)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
@@ -96,10 +97,29 @@ func TestDoc(t *testing.T) {
 		docDefaults.Transactions.AutoPurge.DetectionApiUrl = nil
 		docDefaults.Transactions.AutoPurge.Threshold = nil
 		docDefaults.Transactions.AutoPurge.MinAttempts = nil
+		// Transactions.Forwarders has no discovery addresses by default
+		docDefaults.Transactions.Forwarders.DiscoveryAddresses = nil
+		docDefaults.Transactions.Forwarders.DiscoveryStartBlock = nil
 
 		// Fallback DA oracle is not set
 		docDefaults.GasEstimator.DAOracle = evmcfg.DAOracle{}
 
+		// BalanceMonitor alerts and top-ups have no global destination
+		require.Empty(t, docDefaults.BalanceMonitor.WebhookURL.String())
+		require.Zero(t, *docDefaults.BalanceMonitor.TopUp.TreasuryAddress)
+		docDefaults.BalanceMonitor.WebhookURL = nil
+		docDefaults.BalanceMonitor.TopUp.TreasuryAddress = nil
+
+		// FeeOracle endpoint and response paths are only set if the estimator is used
+		docDefaults.GasEstimator.FeeOracle.URLs = nil
+		docDefaults.GasEstimator.FeeOracle.PricePath = nil
+		docDefaults.GasEstimator.FeeOracle.TipCapPath = nil
+		docDefaults.GasEstimator.FeeOracle.FeeCapPath = nil
+
+		// HeadTracker.GapRepair has no trusted checkpoint by default
+		docDefaults.HeadTracker.GapRepair.TrustedCheckpointNumber = nil
+		docDefaults.HeadTracker.GapRepair.TrustedCheckpointHash = nil
+
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
 
@@ -6,6 +6,13 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 )
 
+type BridgeHealth interface {
+	CheckInterval() time.Duration
+	CheckTimeout() time.Duration
+	FailureThreshold() uint32
+	Cooldown() time.Duration
+}
+
 type JobPipeline interface {
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() commonconfig.Duration
@@ -16,4 +23,5 @@ type JobPipeline interface {
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
+	BridgeHealth() BridgeHealth
 }
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
@@ -133,9 +135,10 @@ func dbURLPasswordComplexity(err error) string {
 }
 
 type DatabaseSecrets struct {
-	URL                  *models.SecretURL
-	BackupURL            *models.SecretURL
-	AllowSimplePasswords *bool
+	URL                     *models.SecretURL
+	BackupURL               *models.SecretURL
+	AllowSimplePasswords    *bool
+	BackupS3SecretAccessKey *models.Secret
 }
 
 func validateDBURL(dbURI url.URL) error {
@@ -200,6 +203,9 @@ func (d *DatabaseSecrets) SetFrom(f *DatabaseSecrets) (err error) {
 	if v := f.URL; v != nil {
 		d.URL = v
 	}
+	if v := f.BackupS3SecretAccessKey; v != nil {
+		d.BackupS3SecretAccessKey = v
+	}
 	return nil
 }
 
@@ -216,6 +222,10 @@ func (d *DatabaseSecrets) validateMerge(f *DatabaseSecrets) (err error) {
 		err = multierr.Append(err, configutils.ErrOverride{Name: "URL"})
 	}
 
+	if d.BackupS3SecretAccessKey != nil && f.BackupS3SecretAccessKey != nil {
+		err = multierr.Append(err, configutils.ErrOverride{Name: "BackupS3SecretAccessKey"})
+	}
+
 	return err
 }
 
@@ -437,6 +447,13 @@ type DatabaseBackup struct {
 	Frequency        *commonconfig.Duration
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
+	Sink             *config.DatabaseBackupSink
+	MaxCount         *uint32
+	MaxAge           *commonconfig.Duration
+	Encrypt          *bool
+
+	S3   DatabaseBackupS3   `toml:",omitempty"`
+	SFTP DatabaseBackupSFTP `toml:",omitempty"`
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
@@ -452,6 +469,101 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.Sink; v != nil {
+		d.Sink = v
+	}
+	if v := f.MaxCount; v != nil {
+		d.MaxCount = v
+	}
+	if v := f.MaxAge; v != nil {
+		d.MaxAge = v
+	}
+	if v := f.Encrypt; v != nil {
+		d.Encrypt = v
+	}
+	d.S3.setFrom(&f.S3)
+	d.SFTP.setFrom(&f.SFTP)
+}
+
+func (d *DatabaseBackup) ValidateConfig() (err error) {
+	if d.Sink == nil {
+		return nil
+	}
+	switch *d.Sink {
+	case config.DatabaseBackupSinkLocal:
+	case config.DatabaseBackupSinkS3:
+		if d.S3.Endpoint == nil || d.S3.Endpoint.URL() == nil || d.S3.Endpoint.URL().String() == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "S3.Endpoint", Msg: "required when Sink is s3"})
+		}
+		if d.S3.Bucket == nil || *d.S3.Bucket == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "S3.Bucket", Msg: "required when Sink is s3"})
+		}
+	case config.DatabaseBackupSinkSFTP:
+		if d.SFTP.Address == nil || *d.SFTP.Address == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "SFTP.Address", Msg: "required when Sink is sftp"})
+		}
+		if d.SFTP.KeyFile == nil || *d.SFTP.KeyFile == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "SFTP.KeyFile", Msg: "required when Sink is sftp"})
+		}
+		if d.SFTP.HostKey == nil || *d.SFTP.HostKey == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "SFTP.HostKey", Msg: "required when Sink is sftp"})
+		}
+	default:
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Sink", Value: *d.Sink, Msg: "must be one of: local, s3, sftp"})
+	}
+	return err
+}
+
+type DatabaseBackupS3 struct {
+	Endpoint    *commonconfig.URL
+	Region      *string
+	Bucket      *string
+	Prefix      *string
+	AccessKeyID *string
+}
+
+func (s *DatabaseBackupS3) setFrom(f *DatabaseBackupS3) {
+	if v := f.Endpoint; v != nil {
+		s.Endpoint = v
+	}
+	if v := f.Region; v != nil {
+		s.Region = v
+	}
+	if v := f.Bucket; v != nil {
+		s.Bucket = v
+	}
+	if v := f.Prefix; v != nil {
+		s.Prefix = v
+	}
+	if v := f.AccessKeyID; v != nil {
+		s.AccessKeyID = v
+	}
+}
+
+type DatabaseBackupSFTP struct {
+	Address *string
+	User    *string
+	KeyFile *string
+	HostKey *string
+	Dir     *string
+}
+
+func (s *DatabaseBackupSFTP) setFrom(f *DatabaseBackupSFTP) {
+	if v := f.Address; v != nil {
+		s.Address = v
+	}
+	if v := f.User; v != nil {
+		s.User = v
+	}
+	if v := f.KeyFile; v != nil {
+		s.KeyFile = v
+	}
+	if v := f.HostKey; v != nil {
+		s.HostKey = v
+	}
+	if v := f.Dir; v != nil {
+		s.Dir = v
+	}
 }
 
 type TelemetryIngress struct {
@@ -873,7 +985,8 @@ type JobPipeline struct {
 	ResultWriteQueueDepth     *uint32
 	VerboseLogging            *bool
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest  JobPipelineHTTPRequest  `toml:",omitempty"`
+	BridgeHealth JobPipelineBridgeHealth `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -899,6 +1012,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.VerboseLogging = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.BridgeHealth.setFrom(&f.BridgeHealth)
 }
 
 type JobPipelineHTTPRequest struct {
@@ -915,6 +1029,38 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineBridgeHealth struct {
+	CheckInterval    *commonconfig.Duration
+	CheckTimeout     *commonconfig.Duration
+	FailureThreshold *uint32
+	Cooldown         *commonconfig.Duration
+}
+
+func (j *JobPipelineBridgeHealth) setFrom(f *JobPipelineBridgeHealth) {
+	if v := f.CheckInterval; v != nil {
+		j.CheckInterval = v
+	}
+	if v := f.CheckTimeout; v != nil {
+		j.CheckTimeout = v
+	}
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.Cooldown; v != nil {
+		j.Cooldown = v
+	}
+}
+
+func (j *JobPipelineBridgeHealth) ValidateConfig() (err error) {
+	if j.CheckInterval != nil && j.CheckInterval.Duration() > 0 && j.CheckTimeout != nil && j.CheckTimeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "CheckTimeout", Value: j.CheckTimeout.String(), Msg: "must be greater than zero when CheckInterval is set"})
+	}
+	if j.FailureThreshold != nil && *j.FailureThreshold > 0 && j.Cooldown != nil && j.Cooldown.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Cooldown", Value: j.Cooldown.String(), Msg: "must be greater than zero when FailureThreshold is set"})
+	}
+	return
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
@@ -1448,6 +1594,141 @@ func (r *ExternalRegistry) setFrom(f *ExternalRegistry) {
 	}
 }
 
+type WorkflowRegistry struct {
+	Address   *string
+	NetworkID *string
+	ChainID   *string
+}
+
+func (r *WorkflowRegistry) setFrom(f *WorkflowRegistry) {
+	if f.Address != nil {
+		r.Address = f.Address
+	}
+
+	if f.NetworkID != nil {
+		r.NetworkID = f.NetworkID
+	}
+
+	if f.ChainID != nil {
+		r.ChainID = f.ChainID
+	}
+}
+
+type WorkflowExecutions struct {
+	ReaperInterval  *commonconfig.Duration
+	ReaperThreshold *commonconfig.Duration
+	MaxPerWorkflow  *uint32
+	ReaperBatchSize *uint32
+}
+
+func (w *WorkflowExecutions) setFrom(f *WorkflowExecutions) {
+	if f.ReaperInterval != nil {
+		w.ReaperInterval = f.ReaperInterval
+	}
+	if f.ReaperThreshold != nil {
+		w.ReaperThreshold = f.ReaperThreshold
+	}
+	if f.MaxPerWorkflow != nil {
+		w.MaxPerWorkflow = f.MaxPerWorkflow
+	}
+	if f.ReaperBatchSize != nil {
+		w.ReaperBatchSize = f.ReaperBatchSize
+	}
+}
+
+func (w *WorkflowExecutions) ValidateConfig() (err error) {
+	if w.ReaperBatchSize != nil && *w.ReaperBatchSize == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ReaperBatchSize", Value: 0, Msg: "must be greater than zero"})
+	}
+	return
+}
+
+type ComputeQuotas struct {
+	Default ComputeQuota
+	Owners  []ComputeOwnerQuota `toml:",omitempty"`
+}
+
+func (q *ComputeQuotas) setFrom(f *ComputeQuotas) {
+	q.Default.setFrom(&f.Default)
+	if v := f.Owners; v != nil {
+		q.Owners = v
+	}
+}
+
+func (q *ComputeQuotas) ValidateConfig() (err error) {
+	owners := make(map[string]struct{}, len(q.Owners))
+	for _, owner := range q.Owners {
+		if owner.Address == nil {
+			continue
+		}
+		address := strings.ToLower(*owner.Address)
+		if _, ok := owners[address]; ok {
+			err = multierr.Append(err, configutils.NewErrDuplicate("Owners.Address", *owner.Address))
+		}
+		owners[address] = struct{}{}
+	}
+	return
+}
+
+// ComputeOwnerQuota is the quota of a workflow owner, which replaces the default quota.
+type ComputeOwnerQuota struct {
+	Address *string
+	ComputeQuota
+}
+
+func (q *ComputeOwnerQuota) ValidateConfig() (err error) {
+	if q.Address == nil || *q.Address == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Address", Msg: "must be set"})
+	} else if !common.IsHexAddress(*q.Address) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Address", Value: *q.Address, Msg: "must be a hex address"})
+	}
+	return multierr.Append(err, q.ComputeQuota.ValidateConfig())
+}
+
+type ComputeQuota struct {
+	MaxMemoryMBs     *int64
+	MaxFuel          *uint64
+	MaxTimeout       *commonconfig.Duration
+	MaxFetchRequests *uint32
+	MaxResponseBytes *uint32
+	AllowedHosts     *[]string
+}
+
+func (q *ComputeQuota) setFrom(f *ComputeQuota) {
+	if f.MaxMemoryMBs != nil {
+		q.MaxMemoryMBs = f.MaxMemoryMBs
+	}
+	if f.MaxFuel != nil {
+		q.MaxFuel = f.MaxFuel
+	}
+	if f.MaxTimeout != nil {
+		q.MaxTimeout = f.MaxTimeout
+	}
+	if f.MaxFetchRequests != nil {
+		q.MaxFetchRequests = f.MaxFetchRequests
+	}
+	if f.MaxResponseBytes != nil {
+		q.MaxResponseBytes = f.MaxResponseBytes
+	}
+	if f.AllowedHosts != nil {
+		q.AllowedHosts = f.AllowedHosts
+	}
+}
+
+func (q *ComputeQuota) ValidateConfig() (err error) {
+	if q.MaxMemoryMBs != nil && *q.MaxMemoryMBs < 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxMemoryMBs", Value: *q.MaxMemoryMBs, Msg: "must not be negative"})
+	}
+	if q.AllowedHosts != nil {
+		for _, pattern := range *q.AllowedHosts {
+			if _, perr := path.Match(pattern, ""); perr != nil {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: "AllowedHosts", Value: pattern, Msg: perr.Error()})
+			}
+		}
+	}
+	return
+}
+
 type Dispatcher struct {
 	SupportedVersion   *int
 	ReceiverBufferSize *int
@@ -1534,15 +1815,21 @@ type ConnectorGateway struct {
 }
 
 type Capabilities struct {
-	Peering          P2P              `toml:",omitempty"`
-	Dispatcher       Dispatcher       `toml:",omitempty"`
-	ExternalRegistry ExternalRegistry `toml:",omitempty"`
-	GatewayConnector GatewayConnector `toml:",omitempty"`
+	Peering            P2P                `toml:",omitempty"`
+	Dispatcher         Dispatcher         `toml:",omitempty"`
+	ExternalRegistry   ExternalRegistry   `toml:",omitempty"`
+	WorkflowRegistry   WorkflowRegistry   `toml:",omitempty"`
+	WorkflowExecutions WorkflowExecutions `toml:",omitempty"`
+	ComputeQuotas      ComputeQuotas      `toml:",omitempty"`
+	GatewayConnector   GatewayConnector   `toml:",omitempty"`
 }
 
 func (c *Capabilities) setFrom(f *Capabilities) {
 	c.Peering.setFrom(&f.Peering)
 	c.ExternalRegistry.setFrom(&f.ExternalRegistry)
+	c.WorkflowRegistry.setFrom(&f.WorkflowRegistry)
+	c.WorkflowExecutions.setFrom(&f.WorkflowExecutions)
+	c.ComputeQuotas.setFrom(&f.ComputeQuotas)
 	c.Dispatcher.setFrom(&f.Dispatcher)
 	c.GatewayConnector.setFrom(&f.GatewayConnector)
 }
@@ -577,5 +577,63 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	}
 }
 
+func TestComputeQuotas_ValidateConfig(t *testing.T) {
+	const owner = "0x2a3e23c6f242F5345320814aC8a1b4E58707D292"
+	tests := []struct {
+		name   string
+		quotas ComputeQuotas
+		errMsg string
+	}{
+		{
+			name: "valid",
+			quotas: ComputeQuotas{
+				Default: ComputeQuota{MaxMemoryMBs: ptr[int64](64), AllowedHosts: &[]string{"*.example.com"}},
+				Owners:  []ComputeOwnerQuota{{Address: ptr(owner), ComputeQuota: ComputeQuota{MaxFuel: ptr[uint64](1000)}}},
+			},
+		},
+		{
+			name:   "negative memory",
+			quotas: ComputeQuotas{Default: ComputeQuota{MaxMemoryMBs: ptr[int64](-1)}},
+			errMsg: "Default.MaxMemoryMBs: invalid value (-1): must not be negative",
+		},
+		{
+			name:   "invalid host pattern",
+			quotas: ComputeQuotas{Default: ComputeQuota{AllowedHosts: &[]string{"[example.com"}}},
+			errMsg: "Default.AllowedHosts: invalid value ([example.com): syntax error in pattern",
+		},
+		{
+			name:   "missing owner address",
+			quotas: ComputeQuotas{Owners: []ComputeOwnerQuota{{}}},
+			errMsg: "Owners.0.Address: missing: must be set",
+		},
+		{
+			name:   "invalid owner address",
+			quotas: ComputeQuotas{Owners: []ComputeOwnerQuota{{Address: ptr("0x123")}}},
+			errMsg: "Owners.0.Address: invalid value (0x123): must be a hex address",
+		},
+		{
+			name:   "invalid owner quota",
+			quotas: ComputeQuotas{Owners: []ComputeOwnerQuota{{Address: ptr(owner), ComputeQuota: ComputeQuota{MaxMemoryMBs: ptr[int64](-1)}}}},
+			errMsg: "Owners.0.MaxMemoryMBs: invalid value (-1): must not be negative",
+		},
+		{
+			name:   "duplicate owner",
+			quotas: ComputeQuotas{Owners: []ComputeOwnerQuota{{Address: ptr(owner)}, {Address: ptr(strings.ToLower(owner))}}},
+			errMsg: "Owners.Address: invalid value (" + strings.ToLower(owner) + "): duplicate - must be unique",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := commonconfig.Validate(&tt.quotas)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
@@ -15,6 +15,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	io "io"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -37,6 +39,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -45,6 +49,8 @@ import (
 
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
+	workflows "github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -202,6 +208,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeHealth provides a mock function with given fields:
+func (_m *Application) BridgeHealth() *bridges.HealthMonitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 *bridges.HealthMonitor
+	if rf, ok := ret.Get(0).(func() *bridges.HealthMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthMonitor)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Application_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeHealth() *Application_BridgeHealth_Call {
+	return &Application_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth")}
+}
+
+func (_c *Application_BridgeHealth_Call) Run(run func()) *Application_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) Return(_a0 *bridges.HealthMonitor) *Application_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) RunAndReturn(run func() *bridges.HealthMonitor) *Application_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -391,6 +444,68 @@ func (_c *Application_EVMORM_Call) RunAndReturn(run func() types.Configs) *Appli
 	return _c
 }
 
+// ExportLogPollerData provides a mock function with given fields: ctx, chainID, from, to, w
+func (_m *Application) ExportLogPollerData(ctx context.Context, chainID *big.Int, from int64, to int64, w io.Writer) (*logpoller.SnapshotInfo, error) {
+	ret := _m.Called(ctx, chainID, from, to, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportLogPollerData")
+	}
+
+	var r0 *logpoller.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, int64, int64, io.Writer) (*logpoller.SnapshotInfo, error)); ok {
+		return rf(ctx, chainID, from, to, w)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, int64, int64, io.Writer) *logpoller.SnapshotInfo); ok {
+		r0 = rf(ctx, chainID, from, to, w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.SnapshotInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, int64, int64, io.Writer) error); ok {
+		r1 = rf(ctx, chainID, from, to, w)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ExportLogPollerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportLogPollerData'
+type Application_ExportLogPollerData_Call struct {
+	*mock.Call
+}
+
+// ExportLogPollerData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - from int64
+//   - to int64
+//   - w io.Writer
+func (_e *Application_Expecter) ExportLogPollerData(ctx interface{}, chainID interface{}, from interface{}, to interface{}, w interface{}) *Application_ExportLogPollerData_Call {
+	return &Application_ExportLogPollerData_Call{Call: _e.mock.On("ExportLogPollerData", ctx, chainID, from, to, w)}
+}
+
+func (_c *Application_ExportLogPollerData_Call) Run(run func(ctx context.Context, chainID *big.Int, from int64, to int64, w io.Writer)) *Application_ExportLogPollerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(int64), args[3].(int64), args[4].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *Application_ExportLogPollerData_Call) Return(_a0 *logpoller.SnapshotInfo, _a1 error) *Application_ExportLogPollerData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ExportLogPollerData_Call) RunAndReturn(run func(context.Context, *big.Int, int64, int64, io.Writer) (*logpoller.SnapshotInfo, error)) *Application_ExportLogPollerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLCA provides a mock function with given fields: ctx, chainID
 func (_m *Application) FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.LogPollerBlock, error) {
 	ret := _m.Called(ctx, chainID)
@@ -1059,6 +1174,67 @@ func (_c *Application_ID_Call) RunAndReturn(run func() uuid.UUID) *Application_I
 	return _c
 }
 
+// ImportLogPollerData provides a mock function with given fields: ctx, chainID, r, importFilters
+func (_m *Application) ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.ReadSeeker, importFilters bool) (*logpoller.SnapshotInfo, error) {
+	ret := _m.Called(ctx, chainID, r, importFilters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLogPollerData")
+	}
+
+	var r0 *logpoller.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.ReadSeeker, bool) (*logpoller.SnapshotInfo, error)); ok {
+		return rf(ctx, chainID, r, importFilters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.ReadSeeker, bool) *logpoller.SnapshotInfo); ok {
+		r0 = rf(ctx, chainID, r, importFilters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.SnapshotInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, io.ReadSeeker, bool) error); ok {
+		r1 = rf(ctx, chainID, r, importFilters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ImportLogPollerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLogPollerData'
+type Application_ImportLogPollerData_Call struct {
+	*mock.Call
+}
+
+// ImportLogPollerData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - r io.ReadSeeker
+//   - importFilters bool
+func (_e *Application_Expecter) ImportLogPollerData(ctx interface{}, chainID interface{}, r interface{}, importFilters interface{}) *Application_ImportLogPollerData_Call {
+	return &Application_ImportLogPollerData_Call{Call: _e.mock.On("ImportLogPollerData", ctx, chainID, r, importFilters)}
+}
+
+func (_c *Application_ImportLogPollerData_Call) Run(run func(ctx context.Context, chainID *big.Int, r io.ReadSeeker, importFilters bool)) *Application_ImportLogPollerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(io.ReadSeeker), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *Application_ImportLogPollerData_Call) Return(_a0 *logpoller.SnapshotInfo, _a1 error) *Application_ImportLogPollerData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ImportLogPollerData_Call) RunAndReturn(run func(context.Context, *big.Int, io.ReadSeeker, bool) (*logpoller.SnapshotInfo, error)) *Application_ImportLogPollerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobORM provides a mock function with given fields:
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -1506,6 +1682,67 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars, fixtures
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures) (*pipeline.SimulationResult, error) {
+	ret := _m.Called(ctx, jb, vars, fixtures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.SimulationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) (*pipeline.SimulationResult, error)); ok {
+		return rf(ctx, jb, vars, fixtures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) *pipeline.SimulationResult); ok {
+		r0 = rf(ctx, jb, vars, fixtures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.SimulationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) error); ok {
+		r1 = rf(ctx, jb, vars, fixtures)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+//   - vars map[string]interface{}
+//   - fixtures pipeline.SimulationFixtures
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, vars interface{}, fixtures interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, vars, fixtures)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job), args[2].(map[string]interface{}), args[3].(pipeline.SimulationFixtures))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.SimulationResult, _a1 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) (*pipeline.SimulationResult, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1676,6 +1913,100 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowEngines provides a mock function with given fields:
+func (_m *Application) WorkflowEngines() *workflows.EngineRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowEngines")
+	}
+
+	var r0 *workflows.EngineRegistry
+	if rf, ok := ret.Get(0).(func() *workflows.EngineRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflows.EngineRegistry)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowEngines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowEngines'
+type Application_WorkflowEngines_Call struct {
+	*mock.Call
+}
+
+// WorkflowEngines is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowEngines() *Application_WorkflowEngines_Call {
+	return &Application_WorkflowEngines_Call{Call: _e.mock.On("WorkflowEngines")}
+}
+
+func (_c *Application_WorkflowEngines_Call) Run(run func()) *Application_WorkflowEngines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowEngines_Call) Return(_a0 *workflows.EngineRegistry) *Application_WorkflowEngines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowEngines_Call) RunAndReturn(run func() *workflows.EngineRegistry) *Application_WorkflowEngines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowORM'
+type Application_WorkflowORM_Call struct {
+	*mock.Call
+}
+
+// WorkflowORM is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowORM() *Application_WorkflowORM_Call {
+	return &Application_WorkflowORM_Call{Call: _e.mock.On("WorkflowORM")}
+}
+
+func (_c *Application_WorkflowORM_Call) Run(run func()) *Application_WorkflowORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) Return(_a0 store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) RunAndReturn(run func() store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
@@ -51,6 +51,9 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	HTTPCredentialCreated EventID = "HTTP_CREDENTIAL_CREATED"
+	HTTPCredentialDeleted EventID = "HTTP_CREDENTIAL_DELETED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
@@ -88,6 +91,9 @@ const (
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
 
+	WorkflowExecutionCancelled EventID = "WORKFLOW_EXECUTION_CANCELLED"
+	WorkflowExecutionReplayed  EventID = "WORKFLOW_EXECUTION_REPLAYED"
+
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
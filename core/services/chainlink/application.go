@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
+	"path/filepath"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -52,6 +54,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
@@ -62,6 +65,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
@@ -104,13 +108,19 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeHealth() *bridges.HealthMonitor
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
+	WorkflowORM() workflowstore.Store
+	WorkflowEngines() *workflows.EngineRegistry
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 executes the pipeline of an unsaved job in memory, substituting fixtures for the results of tasks
+	// which would reach out to external adapters, HTTP endpoints or chains. Nothing is broadcast or persisted.
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures) (*pipeline.SimulationResult, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -130,6 +140,10 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.LogPollerBlock, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+	// ExportLogPollerData - writes a snapshot of the LogPoller state in the specified block range
+	ExportLogPollerData(ctx context.Context, chainID *big.Int, from, to int64, w io.Writer) (*logpoller.SnapshotInfo, error)
+	// ImportLogPollerData - restores the LogPoller state from a snapshot, validating its blocks against the RPC
+	ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.ReadSeeker, importFilters bool) (*logpoller.SnapshotInfo, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -142,9 +156,12 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeHealth             *bridges.HealthMonitor
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
+	workflowORM              workflowstore.Store
+	workflowEngines          *workflows.EngineRegistry
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -213,9 +230,47 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		opts.CapabilitiesRegistry = capabilities.NewRegistry(globalLogger)
 	}
 
-	// TODO: wire this up to config so we only instantiate it
-	// if a workflow registry address is provided.
-	workflowRegistrySyncer := syncer.NewWorkflowRegistry()
+	workflowFetcher := syncer.NewHTTPFetcher(restrictedHTTPClient)
+	var (
+		workflowRegistryRelayer   syncer.ContractReaderFactory
+		workflowRegistryLogPoller syncer.LogPoller
+	)
+	workflowRegistryAddress := cfg.Capabilities().WorkflowRegistry().Address()
+	if workflowRegistryAddress != "" {
+		rid := cfg.Capabilities().WorkflowRegistry().RelayID()
+		if rid.Network != relay.NetworkEVM {
+			return nil, fmt.Errorf("workflow registry is only supported on EVM chains, got network %s", rid.Network)
+		}
+		relayer, err := relayerChainInterops.Get(rid)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch relayer %s configured for workflow registry: %w", rid, err)
+		}
+		workflowRegistryRelayer = relayer
+		chain, err := relayerChainInterops.LegacyEVMChains().Get(rid.ChainID)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch chain %s configured for workflow registry: %w", rid.ChainID, err)
+		}
+		workflowRegistryLogPoller = chain.LogPoller()
+	}
+	workflowRegistrySyncer := syncer.NewWorkflowRegistry(
+		globalLogger,
+		workflowRegistryRelayer,
+		workflowRegistryLogPoller,
+		workflowRegistryAddress,
+		workflowFetcher,
+		func() (workflowkey.Key, error) {
+			keys, err := keyStore.Workflow().GetAll()
+			if err != nil {
+				return workflowkey.Key{}, err
+			}
+			if len(keys) == 0 {
+				return workflowkey.Key{}, syncer.ErrWorkflowKeyNotFound
+			}
+			return keys[0], nil
+		},
+		opts.CapabilitiesRegistry,
+		syncer.NewORM(opts.DS),
+	)
 	srvcs = append(srvcs, workflowRegistrySyncer)
 
 	var externalPeerWrapper p2ptypes.PeerWrapper
@@ -333,7 +388,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	if backupCfg.Mode() != config.DatabaseBackupModeNone && backupCfg.Frequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", backupCfg.Frequency())
 
-		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, globalLogger)
+		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, keyStore.Backup(), globalLogger)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize database backup")
 		}
@@ -391,14 +446,16 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	var (
-		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
-		bridgeORM      = bridges.NewORM(opts.DS)
-		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
-		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
-		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		pipelineORM     = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
+		bridgeORM       = bridges.NewORM(opts.DS)
+		bridgeHealth    = bridges.NewHealthMonitor(bridgeORM, cfg.JobPipeline().BridgeHealth(), unrestrictedHTTPClient, globalLogger)
+		mercuryORM      = mercury.NewORM(opts.DS)
+		pipelineRunner  = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), bridgeHealth, legacyEVMChains, keyStore.Eth(), keyStore.VRF(), keyStore.HTTPCredentials(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM          = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
+		txmORM          = txmgr.NewTxStore(opts.DS, globalLogger)
+		streamRegistry  = streams.NewRegistry(globalLogger, pipelineRunner)
+		workflowORM     = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		workflowEngines = workflows.NewEngineRegistry()
 	)
 
 	promReporter := headreporter.NewPrometheusReporter(opts.DS, legacyEVMChains)
@@ -415,6 +472,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	srvcs = append(srvcs, pipelineORM)
+	srvcs = append(srvcs, workflowstore.NewReaper(workflowORM, cfg.Capabilities().WorkflowExecutions(), clockwork.NewRealClock(), globalLogger))
 
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
@@ -479,6 +537,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		opts.CapabilitiesRegistry,
 		workflowRegistrySyncer,
 		workflowORM,
+		workflowEngines,
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -523,6 +582,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		keyStore,
 		peerWrapper,
 		opts.NewOracleFactoryFn,
+		cfg.Capabilities().ComputeQuotas(),
 	)
 
 	if cfg.OCR().Enabled() {
@@ -600,7 +660,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
 	jobSpawner := job.NewSpawner(jobORM, cfg.Database(), healthChecker, delegates, globalLogger, lbs)
-	srvcs = append(srvcs, jobSpawner, pipelineRunner)
+	srvcs = append(srvcs, jobSpawner, bridgeHealth, pipelineRunner)
+
+	workflowRegistrySyncer.AddEventHandler(syncer.NewJobHandler(globalLogger, workflowFetcher, jobSpawner, jobORM, filepath.Join(cfg.RootDir(), "workflows")))
 
 	// We start the log poller after the job spawner
 	// so jobs have a chance to apply their initial log filters.
@@ -650,9 +712,12 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeHealth:             bridgeHealth,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
+		workflowORM:              workflowORM,
+		workflowEngines:          workflowEngines,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
@@ -826,10 +891,24 @@ func (app *ChainlinkApplication) JobORM() job.ORM {
 	return app.jobORM
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
+// WorkflowEngines returns the registry of running workflow engines.
+func (app *ChainlinkApplication) WorkflowEngines() *workflows.EngineRegistry {
+	return app.workflowEngines
+}
+
 func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+// BridgeHealth returns the health monitor and circuit breakers of the bridges.
+func (app *ChainlinkApplication) BridgeHealth() *bridges.HealthMonitor {
+	return app.bridgeHealth
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -951,6 +1030,39 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+	fixtures pipeline.SimulationFixtures,
+) (*pipeline.SimulationResult, error) {
+	if jb.Pipeline.Source == "" {
+		return nil, errors.Errorf("%s job has no observationSource to simulate", jb.Type)
+	}
+	if vars == nil {
+		vars = make(map[string]interface{})
+	}
+	if _, ok := vars["jobSpec"]; !ok {
+		vars["jobSpec"] = map[string]interface{}{
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		}
+	}
+	var gasLimit *uint32
+	if jb.GasLimit.Valid {
+		gasLimit = &jb.GasLimit.Uint32
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		GasLimit:          gasLimit,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+	return app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), fixtures)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -971,7 +1083,12 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	}
 	chain.LogBroadcaster().ReplayFromBlock(int64(number), forceBroadcast)
 	if app.Config.Feature().LogPoller() {
-		chain.LogPoller().ReplayAsync(int64(number))
+		if txs := chain.Config().EVM().Transactions(); txs.ForwardersEnabled() && txs.Forwarders().DiscoveryEnabled() {
+			// the forwarder manager replays the log poller, to rescan the replayed blocks for forwarders once done
+			chain.TxManager().ReplayForwarderDiscovery(int64(number))
+		} else {
+			chain.LogPoller().ReplayAsync(int64(number))
+		}
 	}
 	return nil
 }
@@ -1042,3 +1159,48 @@ func (app *ChainlinkApplication) DeleteLogPollerDataAfter(ctx context.Context, c
 
 	return nil
 }
+
+// ExportLogPollerData - writes a snapshot of the LogPoller state in the specified block range
+func (app *ChainlinkApplication) ExportLogPollerData(ctx context.Context, chainID *big.Int, from, to int64, w io.Writer) (*logpoller.SnapshotInfo, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, fmt.Errorf("ExportLogPollerData is only available if LogPoller is enabled")
+	}
+
+	// The last block of the range is fetched from the RPC if it was not saved.
+	if err = chain.Client().Dial(ctx); err != nil {
+		return nil, fmt.Errorf("failed to dial RPC: %w", err)
+	}
+
+	info, err := chain.LogPoller().ExportSnapshot(ctx, w, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export LogPoller data: %w", err)
+	}
+
+	return info, nil
+}
+
+// ImportLogPollerData - restores the LogPoller state from a snapshot, validating its blocks against the RPC
+func (app *ChainlinkApplication) ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.ReadSeeker, importFilters bool) (*logpoller.SnapshotInfo, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, fmt.Errorf("ImportLogPollerData is only available if LogPoller is enabled")
+	}
+
+	if err = chain.Client().Dial(ctx); err != nil {
+		return nil, fmt.Errorf("failed to dial RPC: %w", err)
+	}
+
+	info, err := chain.LogPoller().ImportSnapshot(ctx, r, importFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import LogPoller data: %w", err)
+	}
+
+	return info, nil
+}
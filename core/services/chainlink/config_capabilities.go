@@ -1,6 +1,9 @@
 package chainlink
 
 import (
+	"strings"
+	"time"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
@@ -22,6 +25,24 @@ func (c *capabilitiesConfig) ExternalRegistry() config.CapabilitiesExternalRegis
 	}
 }
 
+func (c *capabilitiesConfig) WorkflowRegistry() config.CapabilitiesWorkflowRegistry {
+	return &capabilitiesWorkflowRegistry{
+		c: c.c.WorkflowRegistry,
+	}
+}
+
+func (c *capabilitiesConfig) WorkflowExecutions() config.CapabilitiesWorkflowExecutions {
+	return &capabilitiesWorkflowExecutions{
+		c: c.c.WorkflowExecutions,
+	}
+}
+
+func (c *capabilitiesConfig) ComputeQuotas() config.CapabilitiesComputeQuotas {
+	return &capabilitiesComputeQuotas{
+		c: c.c.ComputeQuotas,
+	}
+}
+
 func (c *capabilitiesConfig) Dispatcher() config.Dispatcher {
 	return &dispatcher{d: c.c.Dispatcher}
 }
@@ -88,6 +109,26 @@ func (c *capabilitiesExternalRegistry) Address() string {
 	return *c.c.Address
 }
 
+type capabilitiesWorkflowRegistry struct {
+	c toml.WorkflowRegistry
+}
+
+func (c *capabilitiesWorkflowRegistry) RelayID() types.RelayID {
+	return types.NewRelayID(c.NetworkID(), c.ChainID())
+}
+
+func (c *capabilitiesWorkflowRegistry) NetworkID() string {
+	return *c.c.NetworkID
+}
+
+func (c *capabilitiesWorkflowRegistry) ChainID() string {
+	return *c.c.ChainID
+}
+
+func (c *capabilitiesWorkflowRegistry) Address() string {
+	return *c.c.Address
+}
+
 type gatewayConnector struct {
 	c toml.GatewayConnector
 }
@@ -134,3 +175,86 @@ func (c *connectorGateway) ID() string {
 func (c *connectorGateway) URL() string {
 	return *c.c.URL
 }
+
+type capabilitiesWorkflowExecutions struct {
+	c toml.WorkflowExecutions
+}
+
+func (c *capabilitiesWorkflowExecutions) ReaperInterval() time.Duration {
+	return c.c.ReaperInterval.Duration()
+}
+
+func (c *capabilitiesWorkflowExecutions) ReaperThreshold() time.Duration {
+	return c.c.ReaperThreshold.Duration()
+}
+
+func (c *capabilitiesWorkflowExecutions) MaxPerWorkflow() uint32 {
+	return *c.c.MaxPerWorkflow
+}
+
+func (c *capabilitiesWorkflowExecutions) ReaperBatchSize() uint32 {
+	return *c.c.ReaperBatchSize
+}
+
+type capabilitiesComputeQuotas struct {
+	c toml.ComputeQuotas
+}
+
+func (c *capabilitiesComputeQuotas) Default() config.ComputeQuota {
+	return &computeQuota{c: c.c.Default}
+}
+
+func (c *capabilitiesComputeQuotas) Owners() map[string]config.ComputeQuota {
+	owners := make(map[string]config.ComputeQuota, len(c.c.Owners))
+	for _, owner := range c.c.Owners {
+		owners[strings.ToLower(*owner.Address)] = &computeQuota{c: owner.ComputeQuota}
+	}
+	return owners
+}
+
+// computeQuota treats unset limits as unlimited, since owner quotas only set some of them.
+type computeQuota struct {
+	c toml.ComputeQuota
+}
+
+func (c *computeQuota) MaxMemoryMBs() int64 {
+	if c.c.MaxMemoryMBs == nil {
+		return 0
+	}
+	return *c.c.MaxMemoryMBs
+}
+
+func (c *computeQuota) MaxFuel() uint64 {
+	if c.c.MaxFuel == nil {
+		return 0
+	}
+	return *c.c.MaxFuel
+}
+
+func (c *computeQuota) MaxTimeout() time.Duration {
+	if c.c.MaxTimeout == nil {
+		return 0
+	}
+	return c.c.MaxTimeout.Duration()
+}
+
+func (c *computeQuota) MaxFetchRequests() uint32 {
+	if c.c.MaxFetchRequests == nil {
+		return 0
+	}
+	return *c.c.MaxFetchRequests
+}
+
+func (c *computeQuota) MaxResponseBytes() uint32 {
+	if c.c.MaxResponseBytes == nil {
+		return 0
+	}
+	return *c.c.MaxResponseBytes
+}
+
+func (c *computeQuota) AllowedHosts() []string {
+	if c.c.AllowedHosts == nil {
+		return nil
+	}
+	return *c.c.AllowedHosts
+}
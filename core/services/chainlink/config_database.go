@@ -34,6 +34,86 @@ func (b *backupConfig) URL() *url.URL {
 	return b.s.BackupURL.URL()
 }
 
+func (b *backupConfig) Sink() config.DatabaseBackupSink {
+	return *b.c.Sink
+}
+
+func (b *backupConfig) MaxCount() uint32 {
+	return *b.c.MaxCount
+}
+
+func (b *backupConfig) MaxAge() time.Duration {
+	return b.c.MaxAge.Duration()
+}
+
+func (b *backupConfig) Encrypt() bool {
+	return *b.c.Encrypt
+}
+
+func (b *backupConfig) S3() config.BackupS3 {
+	return &backupS3Config{c: b.c.S3, s: b.s}
+}
+
+func (b *backupConfig) SFTP() config.BackupSFTP {
+	return &backupSFTPConfig{c: b.c.SFTP}
+}
+
+type backupS3Config struct {
+	c toml.DatabaseBackupS3
+	s toml.DatabaseSecrets
+}
+
+func (b *backupS3Config) Endpoint() *url.URL {
+	return b.c.Endpoint.URL()
+}
+
+func (b *backupS3Config) Region() string {
+	return *b.c.Region
+}
+
+func (b *backupS3Config) Bucket() string {
+	return *b.c.Bucket
+}
+
+func (b *backupS3Config) Prefix() string {
+	return *b.c.Prefix
+}
+
+func (b *backupS3Config) AccessKeyID() string {
+	return *b.c.AccessKeyID
+}
+
+func (b *backupS3Config) SecretAccessKey() string {
+	if b.s.BackupS3SecretAccessKey == nil {
+		return ""
+	}
+	return string(*b.s.BackupS3SecretAccessKey)
+}
+
+type backupSFTPConfig struct {
+	c toml.DatabaseBackupSFTP
+}
+
+func (b *backupSFTPConfig) Address() string {
+	return *b.c.Address
+}
+
+func (b *backupSFTPConfig) User() string {
+	return *b.c.User
+}
+
+func (b *backupSFTPConfig) KeyFile() string {
+	return *b.c.KeyFile
+}
+
+func (b *backupSFTPConfig) HostKey() string {
+	return *b.c.HostKey
+}
+
+func (b *backupSFTPConfig) Dir() string {
+	return *b.c.Dir
+}
+
 type lockConfig struct {
 	c toml.DatabaseLock
 }
@@ -49,3 +49,29 @@ func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 func (j *jobPipelineConfig) VerboseLogging() bool {
 	return *j.c.VerboseLogging
 }
+
+func (j *jobPipelineConfig) BridgeHealth() config.BridgeHealth {
+	return &bridgeHealthConfig{c: j.c.BridgeHealth}
+}
+
+var _ config.BridgeHealth = (*bridgeHealthConfig)(nil)
+
+type bridgeHealthConfig struct {
+	c toml.JobPipelineBridgeHealth
+}
+
+func (b *bridgeHealthConfig) CheckInterval() time.Duration {
+	return b.c.CheckInterval.Duration()
+}
+
+func (b *bridgeHealthConfig) CheckTimeout() time.Duration {
+	return b.c.CheckTimeout.Duration()
+}
+
+func (b *bridgeHealthConfig) FailureThreshold() uint32 {
+	return *b.c.FailureThreshold
+}
+
+func (b *bridgeHealthConfig) Cooldown() time.Duration {
+	return b.c.Cooldown.Duration()
+}
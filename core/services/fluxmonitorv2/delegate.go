@@ -77,10 +77,10 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 	if err != nil {
 		return nil, err
 	}
-	strategy := txmgrcommon.NewQueueingTxStrategy(jb.ExternalJobID, d.cfg.FluxMonitor().DefaultTransactionQueueDepth())
+	strategy := txmgrcommon.NewPriorityStrategy(jb.ExternalJobID, txmgr.TxPriorityReport, d.cfg.FluxMonitor().DefaultTransactionQueueDepth())
 	var checker txmgr.TransmitCheckerSpec
 	if d.cfg.FluxMonitor().SimulateTransactions() {
-		checker.CheckerType = txmgr.TransmitCheckerTypeSimulate
+		checker.Simulate = true
 	}
 
 	fm, err := NewFromJobSpec(
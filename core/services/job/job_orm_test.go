@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -1878,6 +1879,28 @@ func Test_ORM_FindJobByWorkflow(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "wf owner declared in different case",
+			fields: fields{
+				ds: pgtest.NewSqlxDB(t),
+			},
+			args: args{
+				spec: &job.WorkflowSpec{
+					ID:       1,
+					Workflow: pkgworkflows.WFYamlSpec(t, "workflow04", addr2),
+					SpecType: job.YamlSpec,
+				},
+				before: func(t *testing.T, o job.ORM, s *job.WorkflowSpec) int32 {
+					require.NoError(t, s.Validate(testutils.Context(t)))
+					var c job.WorkflowSpec
+					c.ID = s.ID
+					c.Workflow = pkgworkflows.WFYamlSpec(t, "workflow04", "0x"+strings.ToUpper(addr2[2:])) // owners are stored in lower case
+					c.SpecType = job.YamlSpec
+					return mustInsertWFJob(t, o, &c)
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "wf wrong owner",
 			fields: fields{
@@ -191,6 +191,7 @@ type Job struct {
 	SchemaVersion                 uint32        `toml:"schemaVersion"`
 	GasLimit                      clnull.Uint32 `toml:"gasLimit"`
 	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
+	MaxDailyGasSpend              *assets.Wei   `toml:"maxDailyGasSpend"`
 	Name                          null.String   `toml:"name"`
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
@@ -882,6 +883,7 @@ type WorkflowSpec struct {
 	UpdatedAt     time.Time        `toml:"-"`
 	SpecType      WorkflowSpecType `toml:"spec_type" db:"spec_type"`
 	sdkWorkflow   *sdk.WorkflowSpec
+	stepPolicies  map[string]WorkflowStepPolicy
 	rawSpec       []byte
 	config        []byte
 }
@@ -902,7 +904,9 @@ func (w *WorkflowSpec) Validate(ctx context.Context) error {
 		return err
 	}
 
-	w.WorkflowOwner = strings.TrimPrefix(s.Owner, "0x") // the json schema validation ensures it is a hex string with 0x prefix, but the database does not store the prefix
+	// the json schema validation ensures it is a hex string with 0x prefix, but the database does not store the prefix.
+	// It is stored in lower case, so that a checksummed owner matches the same owner everywhere it is looked up.
+	w.WorkflowOwner = strings.ToLower(strings.TrimPrefix(s.Owner, "0x"))
 	w.WorkflowName = s.Name
 
 	if len(w.WorkflowID) != workflowIDLen {
@@ -925,12 +929,30 @@ func (w *WorkflowSpec) SDKSpec(ctx context.Context) (sdk.WorkflowSpec, error) {
 	if err != nil {
 		return sdk.WorkflowSpec{}, err
 	}
+	if w.SpecType == WASMFile {
+		if w.stepPolicies, err = splitSDKWorkflowStepPolicies(&spec); err != nil {
+			return sdk.WorkflowSpec{}, err
+		}
+	}
 	w.sdkWorkflow = &spec
 	w.rawSpec = rawSpec
 	w.WorkflowID = cid
 	return spec, nil
 }
 
+// StepPolicies returns the retry and timeout policies declared by the steps of the spec, by step ref. YAML specs
+// declare them next to the step's config, WASM workflows in the step's config, see WorkflowStepPolicyConfigKey.
+func (w *WorkflowSpec) StepPolicies(ctx context.Context) (map[string]WorkflowStepPolicy, error) {
+	if w.SpecType == YamlSpec || w.SpecType == DefaultSpecType {
+		_, policies, err := splitWorkflowStepPolicies(w.Workflow)
+		return policies, err
+	}
+	if _, err := w.SDKSpec(ctx); err != nil {
+		return nil, err
+	}
+	return w.stepPolicies, nil
+}
+
 func (w *WorkflowSpec) RawSpec(ctx context.Context) ([]byte, error) {
 	if w.rawSpec != nil {
 		return w.rawSpec, nil
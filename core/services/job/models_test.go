@@ -305,6 +305,14 @@ func TestWorkflowSpec_Validate(t *testing.T) {
 			wantWorkflowOwner: "0123456789012345678901234567890123456789", // the workflow job spec strips the 0x prefix to limit to 40	characters
 			wantWorkflowName:  "",
 		},
+		{
+			name: "valid checksummed owner",
+			fields: fields{
+				Workflow: pkgworkflows.WFYamlSpec(t, "workflow01", "0xAbCdEf0123456789012345678901234567890123"),
+			},
+			wantWorkflowOwner: "abcdef0123456789012345678901234567890123", // owners are stored in lower case
+			wantWorkflowName:  "workflow01",
+		},
 		{
 			name: "valid no owner",
 			fields: fields{
@@ -0,0 +1,50 @@
+//go:build wasip1
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli/cmd/testdata/fixtures/capabilities/basictrigger"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+)
+
+// BuildWorkflow builds a workflow with a target which sets a step policy in its config.
+func BuildWorkflow(config []byte) *sdk.WorkflowSpecFactory {
+	params := sdk.NewWorkflowParams{}
+	if err := json.Unmarshal(config, &params); err != nil {
+		log.Fatal(err)
+	}
+
+	workflow := sdk.NewWorkflowSpecFactory(params)
+
+	triggerCfg := basictrigger.TriggerConfig{Name: "trigger", Number: 100}
+	_ = triggerCfg.New(workflow)
+
+	target := sdk.Step[struct{}]{Definition: sdk.StepDefinition{
+		ID:     "basic-test-target@1.0.0",
+		Ref:    "write",
+		Inputs: sdk.StepInputs{Mapping: map[string]any{"cool_input": "$(trigger.outputs.cool_output)"}},
+		Config: map[string]any{
+			"name": "target",
+			"stepPolicy": map[string]any{
+				"timeout": "30s",
+				"retry":   map[string]any{"max_attempts": 3, "backoff": "1s"},
+			},
+		},
+		CapabilityType: capabilities.CapabilityTypeTarget,
+	}}
+	target.AddTo(workflow)
+
+	return workflow
+}
+
+func main() {
+	runner := wasm.NewRunner()
+	workflow := BuildWorkflow(runner.Config())
+	runner.Run(workflow)
+}
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/andybalholm/brotli"
 	"github.com/stretchr/testify/assert"
@@ -78,6 +79,21 @@ func TestWasmFileSpecFactory(t *testing.T) {
 		assert.Equal(t, b.Bytes(), rawSpec)
 	})
 
+	t.Run("Step policies", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		spec := &job.WorkflowSpec{Workflow: buildTestBinary(t, "wasm_step_policies"), Config: configLocation, SpecType: job.WASMFile}
+		policies, err2 := spec.StepPolicies(ctx)
+		require.NoError(t, err2)
+		assert.Equal(t, map[string]job.WorkflowStepPolicy{
+			"write": {Timeout: 30 * time.Second, Retry: &job.WorkflowStepRetryPolicy{MaxAttempts: 3, Backoff: time.Second}},
+		}, policies)
+
+		sdkSpec, err2 := spec.SDKSpec(ctx)
+		require.NoError(t, err2)
+		require.Len(t, sdkSpec.Targets, 1)
+		assert.Equal(t, map[string]any{"name": "target"}, sdkSpec.Targets[0].Config, "the policy is not passed to the capability")
+	})
+
 	t.Run("Config", func(t *testing.T) {
 		factory := job.WasmFileSpecFactory{}
 		actual, err3 := factory.Config(testutils.Context(t), configLocation)
@@ -88,9 +104,14 @@ func TestWasmFileSpecFactory(t *testing.T) {
 }
 
 func createTestBinary(t *testing.T) string {
-	const testBinaryLocation = "testdata/wasm/testmodule.wasm"
+	return buildTestBinary(t, "wasm")
+}
+
+// buildTestBinary builds the workflow in the given directory of testdata.
+func buildTestBinary(t *testing.T, dir string) string {
+	testBinaryLocation := "testdata/" + dir + "/testmodule.wasm"
 
-	cmd := exec.Command("go", "build", "-o", testBinaryLocation, "github.com/smartcontractkit/chainlink/v2/core/services/job/testdata/wasm")
+	cmd := exec.Command("go", "build", "-o", testBinaryLocation, "github.com/smartcontractkit/chainlink/v2/core/services/job/testdata/"+dir)
 	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
 
 	output, err := cmd.CombinedOutput()
@@ -0,0 +1,228 @@
+package job
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+)
+
+const (
+	workflowStepFieldRetry   = "retry"
+	workflowStepFieldTimeout = "timeout"
+
+	// WorkflowStepPolicyConfigKey is the step config key under which WASM workflows declare the policy of a step, as
+	// the SDK has no step level field for it. It holds the same fields as a YAML step, e.g.
+	// {"timeout": "30s", "retry": {"max_attempts": 3}}, and is removed from the config passed to the capability.
+	WorkflowStepPolicyConfigKey = "stepPolicy"
+)
+
+// WorkflowStepPolicy is the execution policy of a workflow step, declared next to the step's config in a YAML spec.
+// Steps without a policy are executed once, bounded by the engine's step timeout.
+//
+// Example:
+//
+//	targets:
+//	  - id: "write_ethereum-testnet-sepolia@1.0.0"
+//	    ref: write
+//	    timeout: 30s
+//	    retry:
+//	      max_attempts: 3
+//	      backoff: 1s
+//	      max_backoff: 10s
+//	    inputs: ...
+//	    config: ...
+type WorkflowStepPolicy struct {
+	// Timeout bounds each attempt of the step. Zero means the engine's step timeout.
+	Timeout time.Duration
+	// Retry is nil if the step must not be retried.
+	Retry *WorkflowStepRetryPolicy
+}
+
+// WorkflowStepRetryPolicy controls how often a failed step is attempted again. The delay before each retry starts at
+// Backoff and doubles after every attempt, up to MaxBackoff, or the engine's default maximum if it is not set.
+type WorkflowStepRetryPolicy struct {
+	MaxAttempts int           `yaml:"max_attempts"`
+	Backoff     time.Duration `yaml:"backoff"`
+	MaxBackoff  time.Duration `yaml:"max_backoff"`
+}
+
+func (p *WorkflowStepRetryPolicy) validate() error {
+	switch {
+	case p.MaxAttempts < 1:
+		return errors.New("max_attempts must be at least 1")
+	case p.Backoff < 0:
+		return errors.New("backoff must not be negative")
+	case p.MaxBackoff < 0:
+		return errors.New("max_backoff must not be negative")
+	case p.MaxBackoff > 0 && p.MaxBackoff < p.Backoff:
+		return errors.New("max_backoff must not be less than backoff")
+	}
+	return nil
+}
+
+// splitWorkflowStepPolicies removes the step level policy fields from a YAML workflow spec, as they are not part of
+// the spec understood by the SDK, and returns them by step ref. The spec is returned unchanged if no step declares a
+// policy.
+func splitWorkflowStepPolicies(workflow string) (string, map[string]WorkflowStepPolicy, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(workflow), &doc); err != nil {
+		return "", nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return workflow, nil, nil
+	}
+
+	policies := map[string]WorkflowStepPolicy{}
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		switch root.Content[i].Value {
+		case "actions", "consensus", "targets":
+		default:
+			continue
+		}
+		steps := root.Content[i+1]
+		if steps.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, s := range steps.Content {
+			if s.Kind != yaml.MappingNode {
+				continue
+			}
+			ref := stepRef(s)
+			policy, found, err := splitWorkflowStepPolicy(s)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid policy for step %s: %w", ref, err)
+			}
+			if !found {
+				continue
+			}
+			if ref == "" {
+				return "", nil, errors.New("steps declaring a retry or timeout must have a ref")
+			}
+			policies[ref] = policy
+		}
+	}
+	if len(policies) == 0 {
+		return workflow, nil, nil
+	}
+
+	stripped, err := yaml.Marshal(&doc)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(stripped), policies, nil
+}
+
+// splitSDKWorkflowStepPolicies removes the policies declared in the step configs of a spec built by a WASM workflow, see
+// WorkflowStepPolicyConfigKey, and returns them by step ref.
+func splitSDKWorkflowStepPolicies(spec *sdk.WorkflowSpec) (map[string]WorkflowStepPolicy, error) {
+	policies := map[string]WorkflowStepPolicy{}
+	for _, steps := range [][]sdk.StepDefinition{spec.Actions, spec.Consensus, spec.Targets} {
+		for i := range steps {
+			raw, ok := steps[i].Config[WorkflowStepPolicyConfigKey]
+			if !ok {
+				continue
+			}
+			ref := steps[i].Ref
+			policy, err := decodeSDKWorkflowStepPolicy(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid policy for step %s: %w", ref, err)
+			}
+			if ref == "" {
+				return nil, errors.New("steps declaring a retry or timeout must have a ref")
+			}
+			// the config may be shared with the caller's copy of the spec
+			config := maps.Clone(steps[i].Config)
+			delete(config, WorkflowStepPolicyConfigKey)
+			steps[i].Config = config
+			policies[ref] = policy
+		}
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	return policies, nil
+}
+
+// decodeSDKWorkflowStepPolicy decodes the policy declared in a step config, which must be a map of policy fields.
+func decodeSDKWorkflowStepPolicy(raw any) (WorkflowStepPolicy, error) {
+	b, err := yaml.Marshal(raw)
+	if err != nil {
+		return WorkflowStepPolicy{}, err
+	}
+	var doc yaml.Node
+	if err = yaml.Unmarshal(b, &doc); err != nil {
+		return WorkflowStepPolicy{}, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return WorkflowStepPolicy{}, fmt.Errorf("%s must be a map", WorkflowStepPolicyConfigKey)
+	}
+	node := doc.Content[0]
+	policy, _, err := splitWorkflowStepPolicy(node)
+	if err != nil {
+		return WorkflowStepPolicy{}, err
+	}
+	if len(node.Content) > 0 {
+		return WorkflowStepPolicy{}, fmt.Errorf("unknown field %s", node.Content[0].Value)
+	}
+	return policy, nil
+}
+
+// splitWorkflowStepPolicy removes the policy fields from the step mapping node, and decodes them.
+func splitWorkflowStepPolicy(step *yaml.Node) (policy WorkflowStepPolicy, found bool, err error) {
+	var content []*yaml.Node
+	for i := 0; i+1 < len(step.Content); i += 2 {
+		key, value := step.Content[i], step.Content[i+1]
+		switch key.Value {
+		case workflowStepFieldTimeout:
+			found = true
+			if err = value.Decode(&policy.Timeout); err != nil {
+				err = fmt.Errorf("invalid %s: %w", workflowStepFieldTimeout, err)
+			} else if policy.Timeout <= 0 {
+				err = fmt.Errorf("invalid %s: must be positive", workflowStepFieldTimeout)
+			}
+		case workflowStepFieldRetry:
+			found = true
+			policy.Retry = &WorkflowStepRetryPolicy{}
+			if err = decodeStrict(value, policy.Retry); err == nil {
+				err = policy.Retry.validate()
+			}
+			if err != nil {
+				err = fmt.Errorf("invalid %s: %w", workflowStepFieldRetry, err)
+			}
+		default:
+			content = append(content, key, value)
+		}
+		if err != nil {
+			return policy, found, err
+		}
+	}
+	step.Content = content
+	return policy, found, nil
+}
+
+// decodeStrict decodes node into out, rejecting unknown fields.
+func decodeStrict(node *yaml.Node, out any) error {
+	b, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	return dec.Decode(out)
+}
+
+func stepRef(step *yaml.Node) string {
+	for i := 0; i+1 < len(step.Content); i += 2 {
+		if step.Content[i].Value == "ref" {
+			return step.Content[i+1].Value
+		}
+	}
+	return ""
+}
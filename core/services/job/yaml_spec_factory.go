@@ -14,7 +14,12 @@ type YAMLSpecFactory struct{}
 var _ WorkflowSpecFactory = (*YAMLSpecFactory)(nil)
 
 func (y YAMLSpecFactory) Spec(_ context.Context, workflow, _ string) (sdk.WorkflowSpec, []byte, string, error) {
-	spec, err := workflows.ParseWorkflowSpecYaml(workflow)
+	// step policies are validated here, but are not part of the SDK spec
+	stripped, _, err := splitWorkflowStepPolicies(workflow)
+	if err != nil {
+		return sdk.WorkflowSpec{}, nil, "", err
+	}
+	spec, err := workflows.ParseWorkflowSpecYaml(stripped)
 	return spec, []byte(workflow), fmt.Sprintf("%x", sha256.Sum256([]byte(workflow))), err
 }
 
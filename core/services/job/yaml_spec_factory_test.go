@@ -3,7 +3,9 @@ package job_test
 import (
 	"crypto/sha256"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,6 +80,64 @@ func TestYamlSpecFactory_GetSpec(t *testing.T) {
 	assert.Equal(t, anyYamlSpec, string(raw))
 }
 
+func TestYamlSpecFactory_StepPolicies(t *testing.T) {
+	t.Parallel()
+
+	withPolicy := strings.Replace(anyYamlSpec, `    ref: "evm_median"
+`, `    ref: "evm_median"
+    timeout: 30s
+    retry:
+      max_attempts: 3
+      backoff: 1s
+      max_backoff: 10s
+`, 1)
+	actual, raw, _, err := job.YAMLSpecFactory{}.Spec(testutils.Context(t), withPolicy, "")
+	require.NoError(t, err)
+	expected, err := commonworkflows.ParseWorkflowSpecYaml(anyYamlSpec)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual, "policies are not part of the SDK spec")
+	assert.Equal(t, withPolicy, string(raw))
+
+	policies, err := (&job.WorkflowSpec{Workflow: withPolicy, SpecType: job.YamlSpec}).StepPolicies(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]job.WorkflowStepPolicy{
+		"evm_median": {
+			Timeout: 30 * time.Second,
+			Retry:   &job.WorkflowStepRetryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: 10 * time.Second},
+		},
+	}, policies)
+
+	policies, err = (&job.WorkflowSpec{Workflow: anyYamlSpec, SpecType: job.YamlSpec}).StepPolicies(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Empty(t, policies, "steps are not retried by default")
+
+	for name, policy := range map[string]string{
+		"invalid timeout":           "    timeout: soon\n",
+		"zero timeout":              "    timeout: 0s\n",
+		"zero attempts":             "    retry:\n      max_attempts: 0\n",
+		"unknown retry field":       "    retry:\n      max_attempts: 2\n      jitter: true\n",
+		"negative backoff":          "    retry:\n      max_attempts: 2\n      backoff: -1s\n",
+		"max backoff below backoff": "    retry:\n      max_attempts: 2\n      backoff: 2s\n      max_backoff: 1s\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			spec := strings.Replace(anyYamlSpec, `    ref: "evm_median"
+`, `    ref: "evm_median"
+`+policy, 1)
+			_, _, _, err := job.YAMLSpecFactory{}.Spec(testutils.Context(t), spec, "")
+			require.ErrorContains(t, err, "invalid policy for step evm_median")
+		})
+	}
+
+	t.Run("policy without a ref", func(t *testing.T) {
+		spec := strings.Replace(anyYamlSpec, `  - id: "write_polygon-testnet-mumbai@3.0.0"
+`, `  - id: "write_polygon-testnet-mumbai@3.0.0"
+    timeout: 1s
+`, 1)
+		_, _, _, err := job.YAMLSpecFactory{}.Spec(testutils.Context(t), spec, "")
+		require.ErrorContains(t, err, "must have a ref")
+	})
+}
+
 func TestYamlSpecFactory_Config(t *testing.T) {
 	t.Parallel()
 
@@ -18,6 +18,7 @@ import (
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -284,6 +285,7 @@ func buildJobSpec(
 			"gasTipCap":             gasTipCap.ToInt(),
 			"gasFeeCap":             gasFeeCap.ToInt(),
 			"evmChainID":            chainID,
+			"txPriority":            txmgr.TxPriorityUpkeep.String(),
 		},
 	}
 }
@@ -72,6 +72,7 @@ func TestBuildJobSpec(t *testing.T) {
 			"gasTipCap":             gasTipCap.ToInt(),
 			"gasFeeCap":             gasFeeCap.ToInt(),
 			"evmChainID":            "250",
+			"txPriority":            "high",
 		},
 	}
 
@@ -0,0 +1,154 @@
+package keystore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
+)
+
+// ErrBackupKeyExists describes the error when the backup key already exists
+var ErrBackupKeyExists = errors.New("can only have 1 Backup key")
+
+// Backup stores the key that database backups are encrypted with. Backups taken under a key can only be restored
+// with it, so export it and keep the export outside of the node.
+type Backup interface {
+	Get(id string) (backupkey.Key, error)
+	GetAll() ([]backupkey.Key, error)
+	Create(ctx context.Context) (backupkey.Key, error)
+	Delete(ctx context.Context, id string) (backupkey.Key, error)
+	Import(ctx context.Context, keyJSON []byte, password string) (backupkey.Key, error)
+	Export(id string, password string) ([]byte, error)
+	EnsureKey(ctx context.Context) error
+}
+
+type backup struct {
+	*keyManager
+}
+
+var _ Backup = &backup{}
+
+func newBackupKeyStore(km *keyManager) *backup {
+	return &backup{
+		km,
+	}
+}
+
+func (ks *backup) Get(id string) (backupkey.Key, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return backupkey.Key{}, ErrLocked
+	}
+	return ks.getByID(id)
+}
+
+func (ks *backup) GetAll() (keys []backupkey.Key, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for _, key := range ks.keyRing.Backup {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (ks *backup) Create(ctx context.Context) (backupkey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return backupkey.Key{}, ErrLocked
+	}
+	// Backups are always encrypted with the only key, so that it is unambiguous which key they need.
+	if len(ks.keyRing.Backup) > 0 {
+		return backupkey.Key{}, ErrBackupKeyExists
+	}
+
+	key, err := backupkey.New()
+	if err != nil {
+		return backupkey.Key{}, err
+	}
+	return key, ks.safeAddKey(ctx, key)
+}
+
+func (ks *backup) Delete(ctx context.Context, id string) (backupkey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return backupkey.Key{}, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return backupkey.Key{}, err
+	}
+
+	err = ks.safeRemoveKey(ctx, key)
+
+	return key, err
+}
+
+func (ks *backup) Import(ctx context.Context, keyJSON []byte, password string) (backupkey.Key, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return backupkey.Key{}, ErrLocked
+	}
+
+	key, err := backupkey.FromEncryptedJSON(keyJSON, password)
+	if err != nil {
+		return backupkey.Key{}, errors.Wrap(err, "BackupKeyStore#ImportKey failed to decrypt key")
+	}
+	if _, found := ks.keyRing.Backup[key.ID()]; found {
+		return backupkey.Key{}, errors.Errorf("key with ID %s already exists", key.ID())
+	}
+	if len(ks.keyRing.Backup) > 0 {
+		return backupkey.Key{}, ErrBackupKeyExists
+	}
+	return key, ks.keyManager.safeAddKey(ctx, key)
+}
+
+func (ks *backup) Export(id string, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return key.ToEncryptedJSON(password, ks.scryptParams)
+}
+
+// EnsureKey verifies whether the Backup key has been seeded, if not, it creates it.
+func (ks *backup) EnsureKey(ctx context.Context) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+
+	if len(ks.keyRing.Backup) > 0 {
+		return nil
+	}
+
+	key, err := backupkey.New()
+	if err != nil {
+		return err
+	}
+
+	ks.logger.Infof("Created Backup key with ID %s. Export it with `chainlink keys backup export`, as encrypted backups cannot be restored without it.", key.ID())
+
+	return ks.safeAddKey(ctx, key)
+}
+
+func (ks *backup) getByID(id string) (backupkey.Key, error) {
+	key, found := ks.keyRing.Backup[id]
+	if !found {
+		return backupkey.Key{}, KeyNotFoundError{ID: id, KeyType: "Backup"}
+	}
+	return key, nil
+}
@@ -0,0 +1,95 @@
+package keystore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+func Test_BackupKeyStore_E2E(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(testutils.Context(t), cltest.Password))
+	ks := keyStore.Backup()
+	reset := func() {
+		ctx := context.Background() // Executed on cleanup
+		_, err := db.Exec("DELETE FROM encrypted_key_rings")
+		require.NoError(t, err)
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	}
+
+	t.Run("initializes with an empty state", func(t *testing.T) {
+		defer reset()
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Empty(t, keys)
+	})
+
+	t.Run("creates a key", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		key, err := ks.Create(ctx)
+		require.NoError(t, err)
+		retrievedKey, err := ks.Get(key.ID())
+		require.NoError(t, err)
+		require.Equal(t, key, retrievedKey)
+
+		_, err = ks.Create(ctx)
+		require.ErrorIs(t, err, keystore.ErrBackupKeyExists)
+	})
+
+	t.Run("imports and exports a key", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		key, err := ks.Create(ctx)
+		require.NoError(t, err)
+		exportJSON, err := ks.Export(key.ID(), cltest.Password)
+		require.NoError(t, err)
+
+		_, err = ks.Import(ctx, exportJSON, cltest.Password)
+		require.Error(t, err)
+		assert.Equal(t, fmt.Sprintf("key with ID %s already exists", key.ID()), err.Error())
+
+		_, err = ks.Delete(ctx, key.ID())
+		require.NoError(t, err)
+		importedKey, err := ks.Import(ctx, exportJSON, cltest.Password)
+		require.NoError(t, err)
+		require.Equal(t, key.ID(), importedKey.ID())
+	})
+
+	t.Run("ensures key", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, ks.EnsureKey(ctx))
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+
+		require.NoError(t, ks.EnsureKey(ctx))
+		again, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Equal(t, keys, again)
+	})
+
+	t.Run("persists the key in the encrypted key ring", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		key, err := ks.Create(ctx)
+		require.NoError(t, err)
+
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		retrievedKey, err := keyStore.Backup().Get(key.ID())
+		require.NoError(t, err)
+		require.Equal(t, key.ID(), retrievedKey.ID())
+	})
+}
@@ -0,0 +1,93 @@
+package keystore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
+)
+
+// HTTPCredentials stores the secrets which HTTP tasks use to authenticate, encrypted with the rest of the key ring.
+type HTTPCredentials interface {
+	Get(name string) (httpcredential.Credential, error)
+	GetAll() ([]httpcredential.Credential, error)
+	Add(ctx context.Context, cred httpcredential.Credential) error
+	Delete(ctx context.Context, name string) (httpcredential.Credential, error)
+}
+
+type httpCredentials struct {
+	*keyManager
+}
+
+var _ HTTPCredentials = &httpCredentials{}
+
+func newHTTPCredentialsKeyStore(km *keyManager) *httpCredentials {
+	return &httpCredentials{
+		km,
+	}
+}
+
+func (ks *httpCredentials) Get(name string) (httpcredential.Credential, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return httpcredential.Credential{}, ErrLocked
+	}
+	return ks.getByID(name)
+}
+
+// GetAll returns all credentials, sorted by name.
+func (ks *httpCredentials) GetAll() (creds []httpcredential.Credential, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for _, cred := range ks.keyRing.HTTPCredentials {
+		creds = append(creds, cred)
+	}
+	sort.Slice(creds, func(i, j int) bool {
+		return creds[i].Name < creds[j].Name
+	})
+	return creds, nil
+}
+
+// Add stores a new credential. To replace a credential, delete it first.
+func (ks *httpCredentials) Add(ctx context.Context, cred httpcredential.Credential) error {
+	if err := cred.Validate(); err != nil {
+		return errors.Wrap(err, "invalid HTTP credential")
+	}
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.HTTPCredentials[cred.ID()]; found {
+		return errors.Wrapf(ErrKeyExists, "HTTP credential %s", cred.ID())
+	}
+	return ks.safeAddKey(ctx, cred)
+}
+
+func (ks *httpCredentials) Delete(ctx context.Context, name string) (httpcredential.Credential, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return httpcredential.Credential{}, ErrLocked
+	}
+	cred, err := ks.getByID(name)
+	if err != nil {
+		return httpcredential.Credential{}, err
+	}
+	err = ks.safeRemoveKey(ctx, cred)
+	return cred, err
+}
+
+func (ks *httpCredentials) getByID(name string) (httpcredential.Credential, error) {
+	cred, found := ks.keyRing.HTTPCredentials[name]
+	if !found {
+		return httpcredential.Credential{}, KeyNotFoundError{ID: name, KeyType: "HTTP credential"}
+	}
+	return cred, nil
+}
@@ -0,0 +1,93 @@
+package keystore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
+)
+
+func Test_HTTPCredentialsKeyStore_E2E(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(testutils.Context(t), cltest.Password))
+	ks := keyStore.HTTPCredentials()
+	reset := func() {
+		ctx := context.Background() // Executed on cleanup
+		_, err := db.Exec("DELETE FROM encrypted_key_rings")
+		require.NoError(t, err)
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	}
+	cred := httpcredential.Credential{
+		Name:         "vendor",
+		AllowedHosts: []string{"api.vendor.com"},
+		Headers:      map[string]string{"X-Api-Key": "secret"},
+		HMAC:         &httpcredential.HMAC{Secret: "hmac-secret"},
+	}
+
+	t.Run("initializes with an empty state", func(t *testing.T) {
+		defer reset()
+		creds, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Empty(t, creds)
+	})
+
+	t.Run("errors when getting non-existent name", func(t *testing.T) {
+		defer reset()
+		_, err := ks.Get("non-existent")
+		require.Error(t, err)
+	})
+
+	t.Run("adds a credential", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, ks.Add(ctx, cred))
+		retrieved, err := ks.Get(cred.Name)
+		require.NoError(t, err)
+		require.Equal(t, cred, retrieved)
+
+		t.Run("prevents adding a credential with the same name", func(t *testing.T) {
+			err := ks.Add(testutils.Context(t), cred)
+			assert.ErrorIs(t, err, keystore.ErrKeyExists)
+		})
+
+		t.Run("persists the credential encrypted", func(t *testing.T) {
+			var encrypted []byte
+			require.NoError(t, db.Get(&encrypted, "SELECT encrypted_keys FROM encrypted_key_rings"))
+			assert.NotContains(t, string(encrypted), "hmac-secret")
+
+			keyStore.ResetXXXTestOnly()
+			require.NoError(t, keyStore.Unlock(testutils.Context(t), cltest.Password))
+			retrieved, err := keyStore.HTTPCredentials().Get(cred.Name)
+			require.NoError(t, err)
+			require.Equal(t, cred, retrieved)
+		})
+	})
+
+	t.Run("rejects invalid credentials", func(t *testing.T) {
+		defer reset()
+		err := ks.Add(testutils.Context(t), httpcredential.Credential{Name: "vendor"})
+		require.ErrorContains(t, err, "invalid HTTP credential")
+	})
+
+	t.Run("deletes a credential", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, ks.Add(ctx, cred))
+		_, err := ks.Delete(ctx, cred.Name)
+		require.NoError(t, err)
+		_, err = ks.Get(cred.Name)
+		require.Error(t, err)
+		creds, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Empty(t, creds)
+	})
+}
@@ -0,0 +1,92 @@
+package backupkey
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// keySize is the size of the secret, and of the AES-256 keys derived from it.
+const keySize = 32
+
+var hkdfInfo = []byte("chainlink database backup")
+
+type Raw []byte
+
+func (raw Raw) Key() Key {
+	var secret [keySize]byte
+	copy(secret[:], raw)
+	return Key{secret: &secret}
+}
+
+func (raw Raw) String() string {
+	return fmt.Sprintf("<%s Raw Private Key>", keyTypeIdentifier)
+}
+
+func (raw Raw) GoString() string {
+	return raw.String()
+}
+
+func (raw Raw) Bytes() []byte {
+	return ([]byte)(raw)
+}
+
+// Key is a symmetric key that database backups are encrypted with.
+type Key struct {
+	secret *[keySize]byte
+}
+
+func New() (Key, error) {
+	var secret [keySize]byte
+	if _, err := cryptorand.Read(secret[:]); err != nil {
+		return Key{}, err
+	}
+	return Key{secret: &secret}, nil
+}
+
+// ID is a fingerprint of the secret, which is recorded in encrypted backups so that the key needed to restore them
+// can be identified.
+func (k Key) ID() string {
+	h := sha256.New()
+	h.Write([]byte(keyTypeIdentifier))
+	h.Write(k.secret[:])
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+func (k Key) Raw() Raw {
+	raw := make([]byte, keySize)
+	copy(raw, k.secret[:])
+	return Raw(raw)
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%sKey{Secret: <redacted>, ID: %s}", keyTypeIdentifier, k.ID())
+}
+
+func (k Key) GoString() string {
+	return k.String()
+}
+
+// AEAD returns an AES-256-GCM cipher keyed with a key derived from the secret and salt. A fresh random salt must be
+// used for every message, so that nonces can never repeat under the same derived key.
+func (k Key) AEAD(salt []byte) (cipher.AEAD, error) {
+	if len(salt) == 0 {
+		return nil, errors.New("salt is required")
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, k.secret[:], salt, hkdfInfo), dataKey); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,63 @@
+package backupkey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	key, err := New()
+	require.NoError(t, err)
+	other, err := New()
+	require.NoError(t, err)
+
+	assert.Len(t, key.ID(), 32)
+	assert.NotEqual(t, key.ID(), other.ID())
+}
+
+func TestRaw(t *testing.T) {
+	key, err := New()
+	require.NoError(t, err)
+
+	raw := key.Raw()
+	assert.Equal(t, "<Backup Raw Private Key>", raw.String())
+	assert.Equal(t, raw.String(), raw.GoString())
+
+	restored := raw.Key()
+	assert.Equal(t, key.ID(), restored.ID())
+	assert.Equal(t, "BackupKey{Secret: <redacted>, ID: "+key.ID()+"}", restored.String())
+}
+
+func TestAEAD(t *testing.T) {
+	key, err := New()
+	require.NoError(t, err)
+	other, err := New()
+	require.NoError(t, err)
+
+	_, err = key.AEAD(nil)
+	require.Error(t, err)
+
+	salt := []byte("0123456789abcdef")
+	aead, err := key.AEAD(salt)
+	require.NoError(t, err)
+	nonce := make([]byte, aead.NonceSize())
+	sealed := aead.Seal(nil, nonce, []byte("backup"), nil)
+
+	same, err := key.Raw().Key().AEAD(salt)
+	require.NoError(t, err)
+	plain, err := same.Open(nil, nonce, sealed, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("backup"), plain)
+
+	otherSalt, err := key.AEAD([]byte("fedcba9876543210"))
+	require.NoError(t, err)
+	_, err = otherSalt.Open(nil, nonce, sealed, nil)
+	require.Error(t, err)
+
+	otherKey, err := other.AEAD(salt)
+	require.NoError(t, err)
+	_, err = otherKey.Open(nil, nonce, sealed, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package httpcredential
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const keyTypeIdentifier = "HTTPCredential"
+
+var nameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_\-.]+$`)
+
+// Raw is the JSON encoding of a Credential, as stored in the encrypted key ring.
+type Raw []byte
+
+func (raw Raw) Credential() (Credential, error) {
+	var c Credential
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return Credential{}, errors.Wrapf(err, "failed to decode %s", keyTypeIdentifier)
+	}
+	return c, nil
+}
+
+func (raw Raw) String() string {
+	return fmt.Sprintf("<%s Raw>", keyTypeIdentifier)
+}
+
+func (raw Raw) GoString() string {
+	return raw.String()
+}
+
+// Credential holds the secrets used by HTTP tasks to authenticate with an external API. Jobs reference credentials by
+// name, so that secrets never appear in job specs. Any combination of static headers, an OAuth2 client credentials
+// grant, HMAC request signing and a TLS client certificate may be configured.
+type Credential struct {
+	Name string `json:"name"`
+	// AllowedHosts restricts the hosts which credentials are sent to. At least one is required.
+	AllowedHosts []string          `json:"allowedHosts,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	OAuth2       *OAuth2           `json:"oauth2,omitempty"`
+	HMAC         *HMAC             `json:"hmac,omitempty"`
+	TLS          *TLS              `json:"tls,omitempty"`
+}
+
+// ID returns the name of the credential, which is unique per node.
+func (c Credential) ID() string {
+	return c.Name
+}
+
+func (c Credential) Raw() Raw {
+	b, err := json.Marshal(c)
+	if err != nil {
+		// only contains strings, maps and slices, so this cannot happen
+		panic(err)
+	}
+	return b
+}
+
+// Fingerprint identifies the contents of the credential, so that caches can tell when it has been replaced.
+func (c Credential) Fingerprint() string {
+	h := sha256.Sum256(c.Raw())
+	return hex.EncodeToString(h[:])
+}
+
+// Schemes lists the authentication schemes configured, for display without revealing any secrets.
+func (c Credential) Schemes() []string {
+	var schemes []string
+	if len(c.Headers) > 0 {
+		schemes = append(schemes, "headers")
+	}
+	if c.OAuth2 != nil {
+		schemes = append(schemes, "oauth2")
+	}
+	if c.HMAC != nil {
+		schemes = append(schemes, "hmac")
+	}
+	if c.TLS != nil {
+		schemes = append(schemes, "tls")
+	}
+	return schemes
+}
+
+// HeaderNames returns the sorted names of the static headers, without their values.
+func (c Credential) HeaderNames() []string {
+	names := make([]string, 0, len(c.Headers))
+	for name := range c.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsAllowedURL returns whether credentials may be sent to u, whose host must be one of AllowedHosts, with or without
+// its port. No URLs are allowed if AllowedHosts is empty.
+func (c Credential) IsAllowedURL(u *url.URL) bool {
+	for _, h := range c.AllowedHosts {
+		if strings.EqualFold(h, u.Host) || strings.EqualFold(h, u.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Credential) String() string {
+	return fmt.Sprintf("%s{Name: %s, Schemes: %v}", keyTypeIdentifier, c.Name, c.Schemes())
+}
+
+func (c Credential) GoString() string {
+	return c.String()
+}
+
+// Validate returns an error if the credential cannot be used.
+func (c Credential) Validate() error {
+	if !nameRegexp.MatchString(c.Name) {
+		return errors.Errorf("invalid name %q: must only contain letters, digits, '_', '-' and '.'", c.Name)
+	}
+	if len(c.Schemes()) == 0 {
+		return errors.New("at least one of headers, oauth2, hmac or tls is required")
+	}
+	for name := range c.Headers {
+		if name == "" {
+			return errors.Errorf("invalid header name %q", name)
+		}
+	}
+	if c.OAuth2 != nil {
+		if err := c.OAuth2.validate(); err != nil {
+			return errors.Wrap(err, "oauth2")
+		}
+	}
+	if c.HMAC != nil {
+		if err := c.HMAC.validate(); err != nil {
+			return errors.Wrap(err, "hmac")
+		}
+	}
+	if c.TLS != nil {
+		if _, err := c.TLS.ClientConfig(); err != nil {
+			return errors.Wrap(err, "tls")
+		}
+	}
+	if len(c.AllowedHosts) == 0 {
+		return errors.New("allowedHosts is required")
+	}
+	for _, h := range c.AllowedHosts {
+		if h == "" || strings.ContainsAny(h, "/?#@") {
+			return errors.Errorf("invalid allowed host %q: must be a host name, with an optional port", h)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package httpcredential
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredential_Raw(t *testing.T) {
+	cred := Credential{
+		Name:         "vendor",
+		AllowedHosts: []string{"api.vendor.com"},
+		Headers:      map[string]string{"X-Api-Key": "secret"},
+		OAuth2:       &OAuth2{TokenURL: "https://auth.vendor.com/token", ClientID: "id", ClientSecret: "secret"},
+		HMAC:         &HMAC{Secret: "secret"},
+	}
+
+	decoded, err := cred.Raw().Credential()
+	require.NoError(t, err)
+	assert.Equal(t, cred, decoded)
+	assert.Equal(t, cred.Raw(), decoded.Raw(), "encoding must be stable for the legacy key storage")
+	assert.Equal(t, cred.Fingerprint(), decoded.Fingerprint())
+
+	decoded.HMAC.Secret = "rotated"
+	assert.NotEqual(t, cred.Fingerprint(), decoded.Fingerprint())
+
+	assert.Equal(t, "<HTTPCredential Raw>", cred.Raw().String())
+	assert.Equal(t, "HTTPCredential{Name: vendor, Schemes: [headers oauth2 hmac]}", cred.String())
+	assert.NotContains(t, cred.GoString(), "secret")
+}
+
+func TestCredential_Validate(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		cred Credential
+		err  string
+	}{
+		{"valid", Credential{Name: "vendor-1.prod", AllowedHosts: []string{"api.vendor.com"}, Headers: map[string]string{"X-Api-Key": "secret"}}, ""},
+		{"invalid name", Credential{Name: "vendor 1", Headers: map[string]string{"X-Api-Key": "secret"}}, `invalid name "vendor 1"`},
+		{"no schemes", Credential{Name: "vendor"}, "at least one of headers, oauth2, hmac or tls is required"},
+		{"oauth2 without secret", Credential{Name: "vendor", OAuth2: &OAuth2{TokenURL: "https://auth.vendor.com/token", ClientID: "id"}}, "oauth2: clientSecret is required"},
+		{"oauth2 bad token URL", Credential{Name: "vendor", OAuth2: &OAuth2{TokenURL: "ftp://auth.vendor.com", ClientID: "id", ClientSecret: "secret"}}, "must be an http or https URL"},
+		{"hmac bad algorithm", Credential{Name: "vendor", HMAC: &HMAC{Secret: "secret", Algorithm: "md5"}}, `hmac: algorithm must be "sha256" or "sha512", got "md5"`},
+		{"hmac bad component", Credential{Name: "vendor", HMAC: &HMAC{Secret: "secret", Components: []string{"method", "header:"}}}, `hmac: unknown component "header:"`},
+		{"no allowed hosts", Credential{Name: "vendor", Headers: map[string]string{"X-Api-Key": "secret"}}, "allowedHosts is required"},
+		{"bad allowed host", Credential{Name: "vendor", AllowedHosts: []string{"https://api.vendor.com"}, Headers: map[string]string{"X-Api-Key": "secret"}}, `invalid allowed host "https://api.vendor.com"`},
+		{"tls bad certificate", Credential{Name: "vendor", TLS: &TLS{CertPEM: "foo", KeyPEM: "bar"}}, "tls: invalid client certificate or key"},
+		{"tls", Credential{Name: "vendor", AllowedHosts: []string{"api.vendor.com:8443"}, TLS: MustNewTLSXXXTestingOnly("vendor")}, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cred.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCredential_IsAllowedURL(t *testing.T) {
+	mustParse := func(s string) *url.URL {
+		u, err := url.Parse(s)
+		require.NoError(t, err)
+		return u
+	}
+
+	assert.False(t, Credential{}.IsAllowedURL(mustParse("https://anywhere.com")))
+
+	cred := Credential{AllowedHosts: []string{"api.vendor.com", "localhost:8080"}}
+	assert.True(t, cred.IsAllowedURL(mustParse("https://api.vendor.com/prices")))
+	assert.True(t, cred.IsAllowedURL(mustParse("https://API.vendor.com:443/prices")))
+	assert.True(t, cred.IsAllowedURL(mustParse("http://localhost:8080")))
+	assert.False(t, cred.IsAllowedURL(mustParse("http://localhost:8081")))
+	assert.False(t, cred.IsAllowedURL(mustParse("https://api.vendor.com.evil.com")))
+}
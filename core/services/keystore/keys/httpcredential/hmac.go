@@ -0,0 +1,188 @@
+package httpcredential
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Components of the HMAC signing string. A header value is included with "header:<name>".
+const (
+	ComponentMethod     = "method"
+	ComponentHost       = "host"
+	ComponentPath       = "path"
+	ComponentQuery      = "query"
+	ComponentTimestamp  = "timestamp"
+	ComponentBody       = "body"
+	ComponentBodySHA256 = "body-sha256"
+	componentHeader     = "header:"
+)
+
+const (
+	AlgorithmSHA256 = "sha256"
+	AlgorithmSHA512 = "sha512"
+
+	EncodingHex    = "hex"
+	EncodingBase64 = "base64"
+
+	TimestampUnix      = "unix"
+	TimestampUnixMilli = "unixmilli"
+	TimestampRFC3339   = "rfc3339"
+)
+
+// DefaultComponents are signed if HMAC.Components is empty.
+var DefaultComponents = []string{ComponentMethod, ComponentPath, ComponentQuery, ComponentTimestamp, ComponentBodySHA256}
+
+// HMAC configures request signing. The signing string is the configured components of the request, joined by the
+// separator, e.g. for the default components:
+//
+//	POST\n/v1/prices\nbase=ETH&quote=USD\n1700000000\n<hex sha256 of body>
+//
+// The path is URL-escaped, and the query parameters are sorted by key. The signature is sent in SignatureHeader, the
+// timestamp in TimestampHeader, and the key ID, if any, in KeyIDHeader.
+type HMAC struct {
+	Secret string `json:"secret"`
+	KeyID  string `json:"keyID,omitempty"`
+	// Algorithm is either "sha256" (default) or "sha512".
+	Algorithm string `json:"algorithm,omitempty"`
+	// Encoding of the signature, either "hex" (default) or "base64".
+	Encoding   string   `json:"encoding,omitempty"`
+	Components []string `json:"components,omitempty"`
+	// Separator between components, "\n" by default.
+	Separator string `json:"separator,omitempty"`
+	// TimestampFormat is either "unix" (default), "unixmilli" or "rfc3339".
+	TimestampFormat string `json:"timestampFormat,omitempty"`
+	// SignatureHeader is "X-Signature" by default. SignaturePrefix is prepended to the signature, e.g. "sha256=".
+	SignatureHeader string `json:"signatureHeader,omitempty"`
+	SignaturePrefix string `json:"signaturePrefix,omitempty"`
+	// TimestampHeader is "X-Timestamp" by default.
+	TimestampHeader string `json:"timestampHeader,omitempty"`
+	// KeyIDHeader is "X-Key-ID" by default.
+	KeyIDHeader string `json:"keyIDHeader,omitempty"`
+}
+
+func (h *HMAC) validate() error {
+	if h.Secret == "" {
+		return errors.New("secret is required")
+	}
+	switch h.Algorithm {
+	case "", AlgorithmSHA256, AlgorithmSHA512:
+	default:
+		return errors.Errorf("algorithm must be %q or %q, got %q", AlgorithmSHA256, AlgorithmSHA512, h.Algorithm)
+	}
+	switch h.Encoding {
+	case "", EncodingHex, EncodingBase64:
+	default:
+		return errors.Errorf("encoding must be %q or %q, got %q", EncodingHex, EncodingBase64, h.Encoding)
+	}
+	switch h.TimestampFormat {
+	case "", TimestampUnix, TimestampUnixMilli, TimestampRFC3339:
+	default:
+		return errors.Errorf("timestampFormat must be %q, %q or %q, got %q", TimestampUnix, TimestampUnixMilli, TimestampRFC3339, h.TimestampFormat)
+	}
+	for _, c := range h.Components {
+		switch c {
+		case ComponentMethod, ComponentHost, ComponentPath, ComponentQuery, ComponentTimestamp, ComponentBody, ComponentBodySHA256:
+		default:
+			if !strings.HasPrefix(c, componentHeader) || len(c) == len(componentHeader) {
+				return errors.Errorf("unknown component %q", c)
+			}
+		}
+	}
+	return nil
+}
+
+// Sign adds the signature, timestamp and key ID headers to req, whose body is body. Headers which are signed must be
+// set before calling Sign.
+func (h *HMAC) Sign(req *http.Request, body []byte, now time.Time) error {
+	timestamp := h.timestamp(now)
+	signingString := h.SigningString(req, body, timestamp)
+
+	newHash := sha256.New
+	if h.Algorithm == AlgorithmSHA512 {
+		newHash = sha512.New
+	}
+	mac := hmac.New(func() hash.Hash { return newHash() }, []byte(h.Secret))
+	if _, err := mac.Write([]byte(signingString)); err != nil {
+		return errors.Wrap(err, "failed to compute HMAC")
+	}
+	var signature string
+	if h.Encoding == EncodingBase64 {
+		signature = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	} else {
+		signature = hex.EncodeToString(mac.Sum(nil))
+	}
+
+	req.Header.Set(orDefault(h.TimestampHeader, "X-Timestamp"), timestamp)
+	if h.KeyID != "" {
+		req.Header.Set(orDefault(h.KeyIDHeader, "X-Key-ID"), h.KeyID)
+	}
+	req.Header.Set(orDefault(h.SignatureHeader, "X-Signature"), h.SignaturePrefix+signature)
+	return nil
+}
+
+// SigningString returns the canonical string which is signed for req.
+func (h *HMAC) SigningString(req *http.Request, body []byte, timestamp string) string {
+	components := h.Components
+	if len(components) == 0 {
+		components = DefaultComponents
+	}
+	parts := make([]string, len(components))
+	for i, c := range components {
+		switch c {
+		case ComponentMethod:
+			parts[i] = strings.ToUpper(req.Method)
+		case ComponentHost:
+			parts[i] = strings.ToLower(req.URL.Host)
+		case ComponentPath:
+			parts[i] = req.URL.EscapedPath()
+			if parts[i] == "" {
+				parts[i] = "/"
+			}
+		case ComponentQuery:
+			// Encode sorts by key
+			parts[i] = req.URL.Query().Encode()
+		case ComponentTimestamp:
+			parts[i] = timestamp
+		case ComponentBody:
+			parts[i] = string(body)
+		case ComponentBodySHA256:
+			sum := sha256.Sum256(body)
+			parts[i] = hex.EncodeToString(sum[:])
+		default:
+			parts[i] = strings.TrimSpace(req.Header.Get(strings.TrimPrefix(c, componentHeader)))
+		}
+	}
+	separator := h.Separator
+	if separator == "" {
+		separator = "\n"
+	}
+	return strings.Join(parts, separator)
+}
+
+func (h *HMAC) timestamp(now time.Time) string {
+	switch h.TimestampFormat {
+	case TimestampUnixMilli:
+		return strconv.FormatInt(now.UnixMilli(), 10)
+	case TimestampRFC3339:
+		return now.UTC().Format(time.RFC3339)
+	default:
+		return strconv.FormatInt(now.Unix(), 10)
+	}
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
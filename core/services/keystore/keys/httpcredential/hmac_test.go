@@ -0,0 +1,93 @@
+package httpcredential
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHMAC_SigningString(t *testing.T) {
+	body := []byte(`{"base":"ETH"}`)
+	req, err := http.NewRequest("post", "https://API.vendor.com/v1/prices%2Fspot?quote=USD&base=ETH", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Request-Id", " abc ")
+
+	bodySum := sha256.Sum256(body)
+	t.Run("default components", func(t *testing.T) {
+		h := HMAC{Secret: "secret"}
+		assert.Equal(t,
+			"POST\n/v1/prices%2Fspot\nbase=ETH&quote=USD\n1700000000\n"+hex.EncodeToString(bodySum[:]),
+			h.SigningString(req, body, "1700000000"))
+	})
+
+	t.Run("custom components and separator", func(t *testing.T) {
+		h := HMAC{Secret: "secret", Components: []string{"timestamp", "host", "header:x-request-id", "body"}, Separator: "|"}
+		assert.Equal(t, `1700000000|api.vendor.com|abc|{"base":"ETH"}`, h.SigningString(req, body, "1700000000"))
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		r, err := http.NewRequest("GET", "https://api.vendor.com", nil)
+		require.NoError(t, err)
+		h := HMAC{Secret: "secret", Components: []string{"method", "path", "query"}}
+		assert.Equal(t, "GET\n/\n", h.SigningString(r, nil, ""))
+	})
+}
+
+func TestHMAC_Sign(t *testing.T) {
+	now := time.Unix(1700000000, 123000000)
+	body := []byte(`{"base":"ETH"}`)
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest("POST", "https://api.vendor.com/v1/prices", nil)
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		h := HMAC{Secret: "secret"}
+		req := newRequest()
+		require.NoError(t, h.Sign(req, body, now))
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(h.SigningString(req, body, "1700000000")))
+		assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), req.Header.Get("X-Signature"))
+		assert.Equal(t, "1700000000", req.Header.Get("X-Timestamp"))
+		assert.Empty(t, req.Header.Get("X-Key-ID"))
+	})
+
+	t.Run("custom headers, algorithm and encoding", func(t *testing.T) {
+		h := HMAC{
+			Secret:          "secret",
+			KeyID:           "key-1",
+			Algorithm:       AlgorithmSHA512,
+			Encoding:        EncodingBase64,
+			TimestampFormat: TimestampUnixMilli,
+			SignatureHeader: "Signature",
+			SignaturePrefix: "sha512=",
+			TimestampHeader: "Request-Time",
+			KeyIDHeader:     "Key-Id",
+		}
+		req := newRequest()
+		require.NoError(t, h.Sign(req, body, now))
+
+		mac := hmac.New(sha512.New, []byte("secret"))
+		mac.Write([]byte(h.SigningString(req, body, "1700000000123")))
+		assert.Equal(t, "sha512="+base64.StdEncoding.EncodeToString(mac.Sum(nil)), req.Header.Get("Signature"))
+		assert.Equal(t, "1700000000123", req.Header.Get("Request-Time"))
+		assert.Equal(t, "key-1", req.Header.Get("Key-Id"))
+	})
+
+	t.Run("rfc3339 timestamp", func(t *testing.T) {
+		h := HMAC{Secret: "secret", TimestampFormat: TimestampRFC3339}
+		req := newRequest()
+		require.NoError(t, h.Sign(req, body, now))
+		assert.Equal(t, "2023-11-14T22:13:20Z", req.Header.Get("X-Timestamp"))
+	})
+}
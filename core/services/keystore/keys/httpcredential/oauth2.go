@@ -0,0 +1,100 @@
+package httpcredential
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// AuthStyleHeader sends the client ID and secret to the token endpoint using HTTP basic authentication.
+	AuthStyleHeader = "header"
+	// AuthStyleParams sends the client ID and secret to the token endpoint in the request body.
+	AuthStyleParams = "params"
+)
+
+// OAuth2 configures the OAuth2 client credentials grant (RFC 6749 section 4.4). The access token it returns is sent as
+// a bearer token.
+type OAuth2 struct {
+	TokenURL     string   `json:"tokenURL"`
+	ClientID     string   `json:"clientID"`
+	ClientSecret string   `json:"clientSecret"`
+	Scopes       []string `json:"scopes,omitempty"`
+	// EndpointParams are additional parameters for the token request, e.g. an audience.
+	EndpointParams map[string]string `json:"endpointParams,omitempty"`
+	// AuthStyle is either "header" (default) or "params".
+	AuthStyle string `json:"authStyle,omitempty"`
+}
+
+// Token is an access token returned by the token endpoint.
+type Token struct {
+	AccessToken string
+	TokenType   string
+	// Expiry is zero if the token endpoint did not return an expiry.
+	Expiry time.Time
+}
+
+func (o *OAuth2) validate() error {
+	u, err := url.Parse(o.TokenURL)
+	if err != nil {
+		return errors.Wrap(err, "invalid tokenURL")
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return errors.Errorf("invalid tokenURL %q: must be an http or https URL", o.TokenURL)
+	}
+	if o.ClientID == "" {
+		return errors.New("clientID is required")
+	}
+	if o.ClientSecret == "" {
+		return errors.New("clientSecret is required")
+	}
+	switch o.AuthStyle {
+	case "", AuthStyleHeader, AuthStyleParams:
+	default:
+		return errors.Errorf("authStyle must be %q or %q, got %q", AuthStyleHeader, AuthStyleParams, o.AuthStyle)
+	}
+	return nil
+}
+
+// FetchToken requests a new access token from the token endpoint with client. The expiry of the token is relative to
+// now.
+func (o *OAuth2) FetchToken(ctx context.Context, client *http.Client, now time.Time) (Token, error) {
+	cfg := clientcredentials.Config{
+		ClientID:       o.ClientID,
+		ClientSecret:   o.ClientSecret,
+		TokenURL:       o.TokenURL,
+		Scopes:         o.Scopes,
+		EndpointParams: url.Values{},
+		AuthStyle:      oauth2.AuthStyleInHeader,
+	}
+	for k, v := range o.EndpointParams {
+		cfg.EndpointParams.Set(k, v)
+	}
+	if o.AuthStyle == AuthStyleParams {
+		cfg.AuthStyle = oauth2.AuthStyleInParams
+	}
+
+	start := time.Now()
+	t, err := cfg.Token(context.WithValue(ctx, oauth2.HTTPClient, client))
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) {
+			// the response body is not included, as some token endpoints echo the request
+			if retrieveErr.ErrorCode != "" {
+				return Token{}, errors.Errorf("token request failed with status code %d: %s", retrieveErr.Response.StatusCode, retrieveErr.ErrorCode)
+			}
+			return Token{}, errors.Errorf("token request failed with status code %d", retrieveErr.Response.StatusCode)
+		}
+		return Token{}, errors.Wrap(err, "token request failed")
+	}
+	token := Token{AccessToken: t.AccessToken, TokenType: t.TokenType}
+	if !t.Expiry.IsZero() {
+		token.Expiry = now.Add(t.Expiry.Sub(start))
+	}
+	return token, nil
+}
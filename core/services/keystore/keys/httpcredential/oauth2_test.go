@@ -0,0 +1,96 @@
+package httpcredential
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuth2_FetchToken(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	t.Run("header auth style", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			id, secret, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "id", id)
+			assert.Equal(t, "s3cr%2Ft", secret, "credentials are form-encoded before basic auth, as per RFC 6749")
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "prices quotes", r.PostForm.Get("scope"))
+			assert.Equal(t, "https://api.vendor.com", r.PostForm.Get("audience"))
+			assert.Empty(t, r.PostForm.Get("client_secret"))
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"access_token":"tok","token_type":"bearer","expires_in":3600}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		o := OAuth2{
+			TokenURL:       server.URL,
+			ClientID:       "id",
+			ClientSecret:   "s3cr/t",
+			Scopes:         []string{"prices", "quotes"},
+			EndpointParams: map[string]string{"audience": "https://api.vendor.com"},
+		}
+		token, err := o.FetchToken(context.Background(), server.Client(), now)
+		require.NoError(t, err)
+		assert.Equal(t, "tok", token.AccessToken)
+		assert.Equal(t, "bearer", token.TokenType)
+		assert.WithinDuration(t, now.Add(time.Hour), token.Expiry, time.Second)
+	})
+
+	t.Run("params auth style without expiry", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _, ok := r.BasicAuth()
+			assert.False(t, ok)
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "id", r.PostForm.Get("client_id"))
+			assert.Equal(t, "secret", r.PostForm.Get("client_secret"))
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"access_token":"tok"}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		o := OAuth2{TokenURL: server.URL, ClientID: "id", ClientSecret: "secret", AuthStyle: AuthStyleParams}
+		token, err := o.FetchToken(context.Background(), server.Client(), now)
+		require.NoError(t, err)
+		assert.Equal(t, "tok", token.AccessToken)
+		assert.True(t, token.Expiry.IsZero())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			name   string
+			status int
+			body   string
+			err    string
+		}{
+			{"status code", http.StatusUnauthorized, `{"error":"invalid_client","error_description":"secret is s3cr/t"}`, "token request failed with status code 401: invalid_client"},
+			{"no token", http.StatusOK, `{"token_type":"bearer"}`, "server response missing access_token"},
+			{"bad expiry", http.StatusOK, `{"access_token":"tok","expires_in":"soon"}`, "cannot parse json"},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(tt.status)
+					_, err := w.Write([]byte(tt.body))
+					require.NoError(t, err)
+				}))
+				defer server.Close()
+
+				o := OAuth2{TokenURL: server.URL, ClientID: "id", ClientSecret: "secret"}
+				_, err := o.FetchToken(context.Background(), server.Client(), now)
+				require.ErrorContains(t, err, tt.err)
+				assert.NotContains(t, err.Error(), "s3cr/t")
+			})
+		}
+	})
+}
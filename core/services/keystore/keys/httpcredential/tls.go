@@ -0,0 +1,84 @@
+package httpcredential
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TLS configures a client certificate for mutual TLS. It is also used for the OAuth2 token endpoint.
+type TLS struct {
+	CertPEM string `json:"certPEM"`
+	KeyPEM  string `json:"keyPEM"`
+	// RootCAsPEM replaces the system root certificates for verifying the server, if set.
+	RootCAsPEM string `json:"rootCAsPEM,omitempty"`
+}
+
+// ClientConfig returns a TLS config which presents the client certificate.
+func (t *TLS) ClientConfig() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair([]byte(t.CertPEM), []byte(t.KeyPEM))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid client certificate or key")
+	}
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+	if t.RootCAsPEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(t.RootCAsPEM)) {
+			return nil, errors.New("rootCAsPEM contains no valid certificates")
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// NotAfter returns the expiry of the client certificate.
+func (t *TLS) NotAfter() (time.Time, error) {
+	cert, err := tls.X509KeyPair([]byte(t.CertPEM), []byte(t.KeyPEM))
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "invalid client certificate or key")
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "invalid client certificate")
+	}
+	return leaf.NotAfter, nil
+}
+
+// MustNewTLSXXXTestingOnly returns a self-signed client certificate for commonName, valid for a day.
+func MustNewTLSXXXTestingOnly(commonName string) *TLS {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	return &TLS{
+		CertPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		KeyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
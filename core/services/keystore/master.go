@@ -12,9 +12,11 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/aptoskey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/cosmoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
@@ -47,23 +49,27 @@ type Master interface {
 	Aptos() Aptos
 	VRF() VRF
 	Workflow() Workflow
+	HTTPCredentials() HTTPCredentials
+	Backup() Backup
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
 }
 
 type master struct {
 	*keyManager
-	cosmos   *cosmos
-	csa      *csa
-	eth      *eth
-	ocr      *ocr
-	ocr2     ocr2
-	p2p      *p2p
-	solana   *solana
-	starknet *starknet
-	aptos    *aptos
-	vrf      *vrf
-	workflow *workflow
+	cosmos    *cosmos
+	csa       *csa
+	eth       *eth
+	ocr       *ocr
+	ocr2      ocr2
+	p2p       *p2p
+	solana    *solana
+	starknet  *starknet
+	aptos     *aptos
+	vrf       *vrf
+	workflow  *workflow
+	httpCreds *httpCredentials
+	backup    *backup
 }
 
 func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) Master {
@@ -93,6 +99,8 @@ func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logg
 		aptos:      newAptosKeyStore(km),
 		vrf:        newVRFKeyStore(km),
 		workflow:   newWorkflowKeyStore(km),
+		httpCreds:  newHTTPCredentialsKeyStore(km),
+		backup:     newBackupKeyStore(km),
 	}
 }
 
@@ -140,6 +148,14 @@ func (ks *master) Workflow() Workflow {
 	return ks.workflow
 }
 
+func (ks *master) HTTPCredentials() HTTPCredentials {
+	return ks.httpCreds
+}
+
+func (ks *master) Backup() Backup {
+	return ks.backup
+}
+
 type ORM interface {
 	isEmpty(context.Context) (bool, error)
 	saveEncryptedKeyRing(context.Context, *encryptedKeyRing, ...func(sqlutil.DataSource) error) error
@@ -277,6 +293,10 @@ func GetFieldNameForKey(unknownKey Key) (string, error) {
 		return "VRF", nil
 	case workflowkey.Key:
 		return "Workflow", nil
+	case httpcredential.Credential:
+		return "HTTPCredentials", nil
+	case backupkey.Key:
+		return "Backup", nil
 	}
 	return "", fmt.Errorf("unknown key type: %T", unknownKey)
 }
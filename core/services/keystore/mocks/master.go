@@ -69,6 +69,53 @@ func (_c *Master_Aptos_Call) RunAndReturn(run func() keystore.Aptos) *Master_Apt
 	return _c
 }
 
+// Backup provides a mock function with given fields:
+func (_m *Master) Backup() keystore.Backup {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backup")
+	}
+
+	var r0 keystore.Backup
+	if rf, ok := ret.Get(0).(func() keystore.Backup); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.Backup)
+		}
+	}
+
+	return r0
+}
+
+// Master_Backup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backup'
+type Master_Backup_Call struct {
+	*mock.Call
+}
+
+// Backup is a helper method to define mock.On call
+func (_e *Master_Expecter) Backup() *Master_Backup_Call {
+	return &Master_Backup_Call{Call: _e.mock.On("Backup")}
+}
+
+func (_c *Master_Backup_Call) Run(run func()) *Master_Backup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Master_Backup_Call) Return(_a0 keystore.Backup) *Master_Backup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_Backup_Call) RunAndReturn(run func() keystore.Backup) *Master_Backup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CSA provides a mock function with given fields:
 func (_m *Master) CSA() keystore.CSA {
 	ret := _m.Called()
@@ -210,6 +257,53 @@ func (_c *Master_Eth_Call) RunAndReturn(run func() keystore.Eth) *Master_Eth_Cal
 	return _c
 }
 
+// HTTPCredentials provides a mock function with given fields:
+func (_m *Master) HTTPCredentials() keystore.HTTPCredentials {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPCredentials")
+	}
+
+	var r0 keystore.HTTPCredentials
+	if rf, ok := ret.Get(0).(func() keystore.HTTPCredentials); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.HTTPCredentials)
+		}
+	}
+
+	return r0
+}
+
+// Master_HTTPCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPCredentials'
+type Master_HTTPCredentials_Call struct {
+	*mock.Call
+}
+
+// HTTPCredentials is a helper method to define mock.On call
+func (_e *Master_Expecter) HTTPCredentials() *Master_HTTPCredentials_Call {
+	return &Master_HTTPCredentials_Call{Call: _e.mock.On("HTTPCredentials")}
+}
+
+func (_c *Master_HTTPCredentials_Call) Run(run func()) *Master_HTTPCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Master_HTTPCredentials_Call) Return(_a0 keystore.HTTPCredentials) *Master_HTTPCredentials_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_HTTPCredentials_Call) RunAndReturn(run func() keystore.HTTPCredentials) *Master_HTTPCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsEmpty provides a mock function with given fields: ctx
 func (_m *Master) IsEmpty(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -12,9 +12,11 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/aptoskey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/cosmoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
@@ -149,33 +151,37 @@ func (ks *keyStates) delete(addr common.Address) {
 }
 
 type keyRing struct {
-	CSA        map[string]csakey.KeyV2
-	Eth        map[string]ethkey.KeyV2
-	OCR        map[string]ocrkey.KeyV2
-	OCR2       map[string]ocr2key.KeyBundle
-	P2P        map[string]p2pkey.KeyV2
-	Cosmos     map[string]cosmoskey.Key
-	Solana     map[string]solkey.Key
-	StarkNet   map[string]starkkey.Key
-	Aptos      map[string]aptoskey.Key
-	VRF        map[string]vrfkey.KeyV2
-	Workflow   map[string]workflowkey.Key
-	LegacyKeys LegacyKeyStorage
+	CSA             map[string]csakey.KeyV2
+	Eth             map[string]ethkey.KeyV2
+	OCR             map[string]ocrkey.KeyV2
+	OCR2            map[string]ocr2key.KeyBundle
+	P2P             map[string]p2pkey.KeyV2
+	Cosmos          map[string]cosmoskey.Key
+	Solana          map[string]solkey.Key
+	StarkNet        map[string]starkkey.Key
+	Aptos           map[string]aptoskey.Key
+	VRF             map[string]vrfkey.KeyV2
+	Workflow        map[string]workflowkey.Key
+	HTTPCredentials map[string]httpcredential.Credential
+	Backup          map[string]backupkey.Key
+	LegacyKeys      LegacyKeyStorage
 }
 
 func newKeyRing() *keyRing {
 	return &keyRing{
-		CSA:      make(map[string]csakey.KeyV2),
-		Eth:      make(map[string]ethkey.KeyV2),
-		OCR:      make(map[string]ocrkey.KeyV2),
-		OCR2:     make(map[string]ocr2key.KeyBundle),
-		P2P:      make(map[string]p2pkey.KeyV2),
-		Cosmos:   make(map[string]cosmoskey.Key),
-		Solana:   make(map[string]solkey.Key),
-		StarkNet: make(map[string]starkkey.Key),
-		Aptos:    make(map[string]aptoskey.Key),
-		VRF:      make(map[string]vrfkey.KeyV2),
-		Workflow: make(map[string]workflowkey.Key),
+		CSA:             make(map[string]csakey.KeyV2),
+		Eth:             make(map[string]ethkey.KeyV2),
+		OCR:             make(map[string]ocrkey.KeyV2),
+		OCR2:            make(map[string]ocr2key.KeyBundle),
+		P2P:             make(map[string]p2pkey.KeyV2),
+		Cosmos:          make(map[string]cosmoskey.Key),
+		Solana:          make(map[string]solkey.Key),
+		StarkNet:        make(map[string]starkkey.Key),
+		Aptos:           make(map[string]aptoskey.Key),
+		VRF:             make(map[string]vrfkey.KeyV2),
+		Workflow:        make(map[string]workflowkey.Key),
+		HTTPCredentials: make(map[string]httpcredential.Credential),
+		Backup:          make(map[string]backupkey.Key),
 	}
 }
 
@@ -239,6 +245,12 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, vrfKey := range kr.VRF {
 		rawKeys.VRF = append(rawKeys.VRF, vrfKey.Raw())
 	}
+	for _, cred := range kr.HTTPCredentials {
+		rawKeys.HTTPCredentials = append(rawKeys.HTTPCredentials, cred.Raw())
+	}
+	for _, backupKey := range kr.Backup {
+		rawKeys.Backup = append(rawKeys.Backup, backupKey.Raw())
+	}
 	return rawKeys
 }
 
@@ -314,6 +326,20 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	if len(vrfIDs) > 0 {
 		lggr.Infow(fmt.Sprintf("Unlocked %d VRF keys", len(vrfIDs)), "keys", vrfIDs)
 	}
+	var httpCredentialNames []string
+	for _, cred := range kr.HTTPCredentials {
+		httpCredentialNames = append(httpCredentialNames, cred.ID())
+	}
+	if len(httpCredentialNames) > 0 {
+		lggr.Infow(fmt.Sprintf("Unlocked %d HTTP credentials", len(httpCredentialNames)), "names", httpCredentialNames)
+	}
+	var backupIDs []string
+	for _, backupKey := range kr.Backup {
+		backupIDs = append(backupIDs, backupKey.ID())
+	}
+	if len(backupIDs) > 0 {
+		lggr.Infow(fmt.Sprintf("Unlocked %d Backup keys", len(backupIDs)), "keys", backupIDs)
+	}
 	if len(kr.LegacyKeys.legacyRawKeys) > 0 {
 		lggr.Infow(fmt.Sprintf("%d keys stored in legacy system", kr.LegacyKeys.legacyRawKeys.len()))
 	}
@@ -323,17 +349,20 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // it holds only the essential key information to avoid adding unnecessary data
 // (like public keys) to the database
 type rawKeyRing struct {
-	Eth        []ethkey.Raw
-	CSA        []csakey.Raw
-	OCR        []ocrkey.Raw
-	OCR2       []ocr2key.Raw
-	P2P        []p2pkey.Raw
-	Cosmos     []cosmoskey.Raw
-	Solana     []solkey.Raw
-	StarkNet   []starkkey.Raw
-	Aptos      []aptoskey.Raw
-	VRF        []vrfkey.Raw
-	LegacyKeys LegacyKeyStorage `json:"-"`
+	Eth      []ethkey.Raw
+	CSA      []csakey.Raw
+	OCR      []ocrkey.Raw
+	OCR2     []ocr2key.Raw
+	P2P      []p2pkey.Raw
+	Cosmos   []cosmoskey.Raw
+	Solana   []solkey.Raw
+	StarkNet []starkkey.Raw
+	Aptos    []aptoskey.Raw
+	VRF      []vrfkey.Raw
+	// HTTPCredentials hold secrets rather than keys, but are encrypted the same way
+	HTTPCredentials []httpcredential.Raw
+	Backup          []backupkey.Raw
+	LegacyKeys      LegacyKeyStorage `json:"-"`
 }
 
 func (rawKeys rawKeyRing) keys() (*keyRing, error) {
@@ -379,6 +408,17 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		vrfKey := rawVRFKey.Key()
 		keyRing.VRF[vrfKey.ID()] = vrfKey
 	}
+	for _, rawCred := range rawKeys.HTTPCredentials {
+		cred, err := rawCred.Credential()
+		if err != nil {
+			return nil, err
+		}
+		keyRing.HTTPCredentials[cred.ID()] = cred
+	}
+	for _, rawBackupKey := range rawKeys.Backup {
+		backupKey := rawBackupKey.Key()
+		keyRing.Backup[backupKey.ID()] = backupKey
+	}
 
 	keyRing.LegacyKeys = rawKeys.LegacyKeys
 	return keyRing, nil
@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/cosmoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
@@ -40,15 +41,22 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	sol1, sol2 := solkey.MustNewInsecure(rand.Reader), solkey.MustNewInsecure(rand.Reader)
 	vrf1, vrf2 := vrfkey.MustNewV2XXXTestingOnly(big.NewInt(1)), vrfkey.MustNewV2XXXTestingOnly(big.NewInt(2))
 	tk1, tk2 := cosmoskey.MustNewInsecure(rand.Reader), cosmoskey.MustNewInsecure(rand.Reader)
+	httpCred := httpcredential.Credential{
+		Name:         "vendor",
+		AllowedHosts: []string{"api.vendor.com"},
+		Headers:      map[string]string{"X-Api-Key": "secret"},
+		HMAC:         &httpcredential.HMAC{Secret: "hmac-secret", KeyID: "key-1"},
+	}
 	originalKeyRingRaw := rawKeyRing{
-		CSA:    []csakey.Raw{csa1.Raw(), csa2.Raw()},
-		Eth:    []ethkey.Raw{eth1.Raw(), eth2.Raw()},
-		OCR:    []ocrkey.Raw{ocr[0].Raw(), ocr[1].Raw()},
-		OCR2:   ocr2_raw,
-		P2P:    []p2pkey.Raw{p2p1.Raw(), p2p2.Raw()},
-		Solana: []solkey.Raw{sol1.Raw(), sol2.Raw()},
-		VRF:    []vrfkey.Raw{vrf1.Raw(), vrf2.Raw()},
-		Cosmos: []cosmoskey.Raw{tk1.Raw(), tk2.Raw()},
+		CSA:             []csakey.Raw{csa1.Raw(), csa2.Raw()},
+		Eth:             []ethkey.Raw{eth1.Raw(), eth2.Raw()},
+		OCR:             []ocrkey.Raw{ocr[0].Raw(), ocr[1].Raw()},
+		OCR2:            ocr2_raw,
+		P2P:             []p2pkey.Raw{p2p1.Raw(), p2p2.Raw()},
+		Solana:          []solkey.Raw{sol1.Raw(), sol2.Raw()},
+		VRF:             []vrfkey.Raw{vrf1.Raw(), vrf2.Raw()},
+		Cosmos:          []cosmoskey.Raw{tk1.Raw(), tk2.Raw()},
+		HTTPCredentials: []httpcredential.Raw{httpCred.Raw()},
 	}
 	originalKeyRing, kerr := originalKeyRingRaw.keys()
 	require.NoError(t, kerr)
@@ -103,6 +111,10 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 		require.Equal(t, 2, len(decryptedKeyRing.VRF))
 		require.Equal(t, originalKeyRing.VRF[vrf1.ID()].PublicKey, decryptedKeyRing.VRF[vrf1.ID()].PublicKey)
 		require.Equal(t, originalKeyRing.VRF[vrf2.ID()].PublicKey, decryptedKeyRing.VRF[vrf2.ID()].PublicKey)
+		// compare http credentials
+		require.Equal(t, 1, len(decryptedKeyRing.HTTPCredentials))
+		require.Equal(t, httpCred, decryptedKeyRing.HTTPCredentials[httpCred.ID()])
+		require.Empty(t, decryptedKeyRing.LegacyKeys.legacyRawKeys)
 	})
 
 	t.Run("test legacy system", func(t *testing.T) {
@@ -195,11 +195,11 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 			return nil, errors.Wrap(err, "could not get contract ABI JSON")
 		}
 
-		strategy := txmgrcommon.NewQueueingTxStrategy(jb.ExternalJobID, d.cfg.OCR().DefaultTransactionQueueDepth())
+		strategy := txmgrcommon.NewPriorityStrategy(jb.ExternalJobID, txmgr.TxPriorityReport, d.cfg.OCR().DefaultTransactionQueueDepth())
 
 		var checker txmgr.TransmitCheckerSpec
 		if d.cfg.OCR().SimulateTransactions() {
-			checker.CheckerType = txmgr.TransmitCheckerTypeSimulate
+			checker.Simulate = true
 		}
 
 		if concreteSpec.TransmitterAddress == nil {
@@ -237,6 +237,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to create transmitter")
 		}
+		transmitter = ocrcommon.NewJobTransmitter(transmitter, jb.ID)
 
 		contractTransmitter := NewOCRContractTransmitter(
 			concreteSpec.ContractAddress.Address(),
@@ -54,8 +54,10 @@ func TestAdapter_Integration(t *testing.T) {
 		cfg.JobPipeline(),
 		cfg.WebServer(),
 		nil,
+		nil,
 		keystore.Eth(),
 		keystore.VRF(),
+		keystore.HTTPCredentials(),
 		logger,
 		http.DefaultClient,
 		http.DefaultClient,
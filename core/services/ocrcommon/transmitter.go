@@ -203,3 +203,26 @@ func (t *ocr2FeedsTransmitter) forwarderAddress(ctx context.Context, eoa, ocr2Ag
 
 	return forwarderAddress, nil
 }
+
+type jobTransmitter struct {
+	Transmitter
+	jobID int32
+}
+
+// NewJobTransmitter returns a Transmitter which sets the JobID of the transactions created by t, so that their gas
+// spend is accounted to the job.
+func NewJobTransmitter(t Transmitter, jobID int32) Transmitter {
+	return &jobTransmitter{Transmitter: t, jobID: jobID}
+}
+
+func (t *jobTransmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, txMeta *txmgr.TxMeta) error {
+	var meta txmgr.TxMeta
+	if txMeta != nil {
+		meta = *txMeta
+	}
+	if meta.JobID == nil {
+		jobID := t.jobID
+		meta.JobID = &jobID
+	}
+	return t.Transmitter.CreateEthTransaction(ctx, toAddress, payload, &meta)
+}
@@ -1,6 +1,7 @@
 package ocrcommon_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -169,3 +170,34 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction_No_Keystore
 	)
 	require.Error(t, err)
 }
+
+type recordingTransmitter struct {
+	ocrcommon.Transmitter
+	metas []*txmgr.TxMeta
+}
+
+func (t *recordingTransmitter) CreateEthTransaction(_ context.Context, _ common.Address, _ []byte, txMeta *txmgr.TxMeta) error {
+	t.metas = append(t.metas, txMeta)
+	return nil
+}
+
+func Test_JobTransmitter_CreateEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	recorder := &recordingTransmitter{}
+	transmitter := ocrcommon.NewJobTransmitter(recorder, 7)
+	ctx := testutils.Context(t)
+	toAddress := testutils.NewAddress()
+
+	require.NoError(t, transmitter.CreateEthTransaction(ctx, toAddress, []byte{1}, nil))
+	seqNum := uint64(3)
+	require.NoError(t, transmitter.CreateEthTransaction(ctx, toAddress, []byte{1}, &txmgr.TxMeta{SeqNumbers: []uint64{seqNum}}))
+	otherJobID := int32(8)
+	require.NoError(t, transmitter.CreateEthTransaction(ctx, toAddress, []byte{1}, &txmgr.TxMeta{JobID: &otherJobID}))
+
+	require.Len(t, recorder.metas, 3)
+	require.Equal(t, int32(7), *recorder.metas[0].JobID)
+	require.Equal(t, int32(7), *recorder.metas[1].JobID)
+	require.Equal(t, []uint64{seqNum}, recorder.metas[1].SeqNumbers)
+	require.Equal(t, otherJobID, *recorder.metas[2].JobID)
+}
@@ -3,6 +3,7 @@ package periodicbackup
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
@@ -15,12 +16,15 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
-	minBackupFrequency = time.Minute
+	filePattern            = "cl_backup_%s.dump"
+	timestampedFilePattern = "cl_backup_%s_%s.dump"
+	timestampFormat        = "20060102T150405Z"
+	minBackupFrequency     = time.Minute
 
 	excludedDataFromTables = []string{
 		"pipeline_runs",
@@ -48,6 +52,10 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		config          BackupConfig
+		keys            BackupKeyStore
+		sink            BackupSink
+		stopCh          services.StopChan
 		done            chan bool
 	}
 
@@ -56,37 +64,62 @@ type (
 		Dir() string
 		Mode() config.DatabaseBackupMode
 		Frequency() time.Duration
+		Sink() config.DatabaseBackupSink
+		MaxCount() uint32
+		MaxAge() time.Duration
+		Encrypt() bool
+		S3() config.BackupS3
+		SFTP() config.BackupSFTP
+	}
+
+	// BackupKeyStore holds the key that backups are encrypted with. It is implemented by keystore.Backup.
+	BackupKeyStore interface {
+		GetAll() ([]backupkey.Key, error)
 	}
 )
 
-// NewDatabaseBackup instantiates a *databaseBackup
-func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, lggr logger.Logger) (DatabaseBackup, error) {
+// NewDatabaseBackup instantiates a *databaseBackup.
+//
+// RunBackup always writes a local, unencrypted backup, as it is used before version upgrade migrations.
+// Periodic backups are written to the configured sink, encrypted with the backup key from keys if
+// Database.Backup.Encrypt is set. keys may be nil if only RunBackup is used.
+func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, keys BackupKeyStore, lggr logger.Logger) (DatabaseBackup, error) {
 	lggr = lggr.Named("DatabaseBackup")
 	dbBackupUrl := backupConfig.URL()
 	if dbBackupUrl != nil {
 		dbUrl = *dbBackupUrl
 	}
 
-	outputParentDir := filepath.Join(rootDir, "backup")
-	if backupConfig.Dir() != "" {
-		dir, err := filepath.Abs(backupConfig.Dir())
-		if err != nil {
-			return nil, errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
-		}
-		outputParentDir = dir
+	outputParentDir, err := backupDir(rootDir, backupConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	return &databaseBackup{
-		services.StateMachine{},
-		lggr,
-		dbUrl,
-		backupConfig.Mode(),
-		backupConfig.Frequency(),
-		outputParentDir,
-		make(chan bool),
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		mode:            backupConfig.Mode(),
+		frequency:       backupConfig.Frequency(),
+		outputParentDir: outputParentDir,
+		config:          backupConfig,
+		keys:            keys,
+		stopCh:          make(services.StopChan),
+		done:            make(chan bool),
 	}, nil
 }
 
+// backupDir returns the directory backups are staged in, and local backups are written to.
+func backupDir(rootDir string, backupConfig BackupConfig) (string, error) {
+	if backupConfig.Dir() == "" {
+		return filepath.Join(rootDir, "backup"), nil
+	}
+	dir, err := filepath.Abs(backupConfig.Dir())
+	if err != nil {
+		return "", errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
+	}
+	return dir, nil
+}
+
 // Start starts DatabaseBackup.
 func (backup *databaseBackup) Start(context.Context) error {
 	return backup.StartOnce("DatabaseBackup", func() (err error) {
@@ -99,6 +132,14 @@ func (backup *databaseBackup) Start(context.Context) error {
 			return errors.Errorf("Database backup frequency (%s=%v) is too small. Please set it to at least %s (or set to 0 to disable periodic backups)", "Database.Backup.Frequency", backup.frequency, minBackupFrequency)
 		}
 
+		if backup.config.Encrypt() && backup.keys == nil {
+			return errors.Wrap(ErrBackupKeyRequired, "Database.Backup.Encrypt is set")
+		}
+		backup.sink, err = NewBackupSink(backup.config, backup.outputParentDir)
+		if err != nil {
+			return err
+		}
+
 		go func() {
 			for {
 				select {
@@ -108,7 +149,7 @@ func (backup *databaseBackup) Start(context.Context) error {
 				case <-ticker.C:
 					backup.logger.Infow("Starting automatic database backup, this can take a while. To disable periodic backups, set Database.Backup.Frequency=0. To disable database backups entirely, set Database.Backup.Mode=none.")
 					//nolint:errcheck
-					backup.RunBackup(static.Version)
+					backup.runPeriodicBackup(static.Version)
 				}
 			}
 		}()
@@ -119,6 +160,7 @@ func (backup *databaseBackup) Start(context.Context) error {
 
 func (backup *databaseBackup) Close() error {
 	return backup.StopOnce("DatabaseBackup", func() (err error) {
+		close(backup.stopCh)
 		backup.done <- true
 		return nil
 	})
@@ -150,7 +192,121 @@ func (backup *databaseBackup) RunBackup(version string) error {
 	return nil
 }
 
+// runPeriodicBackup takes a backup and writes it to the configured sink, encrypting it and pruning old backups
+// as configured.
+func (backup *databaseBackup) runPeriodicBackup(version string) error {
+	if !backup.storesInSink() {
+		return backup.RunBackup(version)
+	}
+
+	ctx, cancel := backup.stopCh.NewCtx()
+	defer cancel()
+
+	backup.logger.Debugw("Starting backup", "mode", backup.mode, "sink", backup.sink.String())
+	startAt := time.Now()
+	name, size, err := backup.runSinkBackup(ctx, version, startAt)
+	duration := time.Since(startAt)
+	if err != nil {
+		backup.logger.Criticalw("Backup failed", "duration", duration, "sink", backup.sink.String(), "err", err)
+		backup.SvcErrBuffer.Append(err)
+		return err
+	}
+	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", size, "name", name, "sink", backup.sink.String())
+
+	deleted, err := pruneBackups(ctx, backup.sink, backup.config.MaxCount(), backup.config.MaxAge(), time.Now())
+	if len(deleted) > 0 {
+		backup.logger.Infow("Deleted old backups", "names", deleted, "sink", backup.sink.String())
+	}
+	if err != nil {
+		backup.logger.Errorw("Failed to delete old backups", "sink", backup.sink.String(), "err", err)
+		backup.SvcErrBuffer.Append(err)
+		return err
+	}
+	return nil
+}
+
+// storesInSink reports whether periodic backups need more than the legacy behaviour of overwriting
+// a single local file per version.
+func (backup *databaseBackup) storesInSink() bool {
+	return backup.config.Sink() != config.DatabaseBackupSinkLocal ||
+		backup.config.Encrypt() ||
+		backup.config.MaxCount() > 0 ||
+		backup.config.MaxAge() > 0
+}
+
+func (backup *databaseBackup) runSinkBackup(ctx context.Context, version string, now time.Time) (name string, size int64, err error) {
+	if version == "" {
+		version = "unknown"
+	}
+	name = fmt.Sprintf(timestampedFilePattern, version, now.UTC().Format(timestampFormat))
+
+	// staged under a tmp name, so that it is never listed as a backup of a local sink
+	result, err := backup.dump(filepath.Join(backup.outputParentDir, backupTmpPrefix+name))
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(result.path)
+	size = result.size
+
+	f, err := os.Open(result.path)
+	if err != nil {
+		return "", 0, errors.Wrap(err, "Failed to open the backup file")
+	}
+	defer f.Close()
+
+	var src io.Reader = f
+	if backup.config.Encrypt() {
+		key, err := backup.encryptionKey()
+		if err != nil {
+			return "", 0, err
+		}
+		name += EncryptedSuffix
+		encrypted, err := os.CreateTemp(backup.outputParentDir, backupTmpPrefix)
+		if err != nil {
+			return "", 0, errors.Wrap(err, "Failed to create a tmp file")
+		}
+		defer os.Remove(encrypted.Name())
+		defer encrypted.Close()
+		if err = encryptBackup(encrypted, f, key); err != nil {
+			return "", 0, errors.Wrap(err, "failed to encrypt backup")
+		}
+		if _, err = encrypted.Seek(0, io.SeekStart); err != nil {
+			return "", 0, err
+		}
+		info, err := encrypted.Stat()
+		if err != nil {
+			return "", 0, err
+		}
+		src, size = encrypted, info.Size()
+	}
+
+	if err = backup.sink.Put(ctx, name, src); err != nil {
+		return "", 0, errors.Wrapf(err, "failed to write backup to %s", backup.sink.String())
+	}
+	return name, size, nil
+}
+
+// encryptionKey returns the node's backup key, which is created on start when Database.Backup.Encrypt is set.
+func (backup *databaseBackup) encryptionKey() (backupkey.Key, error) {
+	keys, err := backup.keys.GetAll()
+	if err != nil {
+		return backupkey.Key{}, errors.Wrap(err, "failed to get backup key")
+	}
+	if len(keys) == 0 {
+		return backupkey.Key{}, errors.Wrap(ErrBackupKeyRequired, "no backup key found, create one with `chainlink keys backup create`")
+	}
+	return keys[0], nil
+}
+
 func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
+	if version == "" {
+		version = "unknown"
+	}
+	return backup.dump(filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version)))
+}
+
+// dump runs pg_dump and moves the result to finalFilePath once complete.
+func (backup *databaseBackup) dump(finalFilePath string) (*backupResult, error) {
 	err := os.MkdirAll(backup.outputParentDir, os.ModePerm)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to create directories on the path: %s", backup.outputParentDir)
@@ -206,10 +362,6 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return partialResult, errors.Wrap(err, "pg_dump failed")
 	}
 
-	if version == "" {
-		version = "unknown"
-	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
 	_ = os.Remove(finalFilePath)
 	err = os.Rename(tmpFile.Name(), finalFilePath)
 	if err != nil {
@@ -1,9 +1,11 @@
 package periodicbackup
 
 import (
+	"bytes"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,12 +16,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 )
 
 func mustNewDatabaseBackup(t *testing.T, url url.URL, rootDir string, config BackupConfig) *databaseBackup {
 	testutils.SkipShortDB(t)
-	b, err := NewDatabaseBackup(url, rootDir, config, logger.TestLogger(t))
+	b, err := NewDatabaseBackup(url, rootDir, config, nil, logger.TestLogger(t))
 	require.NoError(t, err)
 	return b.(*databaseBackup)
 }
@@ -120,11 +123,54 @@ func TestPeriodicBackup_AlternativeOutputDir(t *testing.T) {
 	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9.dump")
 }
 
+func TestPeriodicBackup_RunPeriodicBackupEncrypted(t *testing.T) {
+	backupDir := t.TempDir()
+	backupConfig := newTestConfig(time.Minute, nil, backupDir, config.DatabaseBackupModeLite)
+	backupConfig.encrypt = true
+	backupConfig.maxCount = 1
+	key, err := backupkey.New()
+	require.NoError(t, err)
+
+	testutils.SkipShortDB(t)
+	b, err := NewDatabaseBackup(*(must(t, string(env.DatabaseURL.Get()))), os.TempDir(), backupConfig, testBackupKeys{key}, logger.TestLogger(t))
+	require.NoError(t, err)
+	periodicBackup := b.(*databaseBackup)
+	periodicBackup.sink = &localSink{dir: backupDir}
+
+	require.NoError(t, periodicBackup.runPeriodicBackup("0.9.9"))
+	time.Sleep(time.Second) // backup names have a resolution of one second
+	require.NoError(t, periodicBackup.runPeriodicBackup("0.9.10"))
+
+	ctx := testutils.Context(t)
+	backups, err := listBackups(ctx, periodicBackup.sink)
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Contains(t, backups[0].Name, "cl_backup_0.9.10_")
+	assert.True(t, strings.HasSuffix(backups[0].Name, EncryptedSuffix))
+
+	r, err := periodicBackup.sink.Get(ctx, backups[0].Name)
+	require.NoError(t, err)
+	defer r.Close()
+	var dump bytes.Buffer
+	require.NoError(t, decryptBackup(&dump, r, StaticBackupKey(key)))
+	assert.True(t, bytes.HasPrefix(dump.Bytes(), []byte("PGDMP")))
+}
+
+type testBackupKeys []backupkey.Key
+
+func (k testBackupKeys) GetAll() ([]backupkey.Key, error) {
+	return k, nil
+}
+
 type testConfig struct {
 	frequency time.Duration
 	mode      config.DatabaseBackupMode
 	url       *url.URL
 	dir       string
+	sink      config.DatabaseBackupSink
+	maxCount  uint32
+	maxAge    time.Duration
+	encrypt   bool
 }
 
 func (t *testConfig) Frequency() time.Duration {
@@ -143,11 +189,36 @@ func (t *testConfig) Dir() string {
 	return t.dir
 }
 
+func (t *testConfig) Sink() config.DatabaseBackupSink {
+	return t.sink
+}
+
+func (t *testConfig) MaxCount() uint32 {
+	return t.maxCount
+}
+
+func (t *testConfig) MaxAge() time.Duration {
+	return t.maxAge
+}
+
+func (t *testConfig) Encrypt() bool {
+	return t.encrypt
+}
+
+func (t *testConfig) S3() config.BackupS3 {
+	return nil
+}
+
+func (t *testConfig) SFTP() config.BackupSFTP {
+	return nil
+}
+
 func newTestConfig(frequency time.Duration, databaseBackupURL *url.URL, databaseBackupDir string, mode config.DatabaseBackupMode) *testConfig {
 	return &testConfig{
 		frequency: frequency,
 		mode:      mode,
 		url:       databaseBackupURL,
 		dir:       databaseBackupDir,
+		sink:      config.DatabaseBackupSinkLocal,
 	}
 }
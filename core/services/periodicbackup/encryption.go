@@ -0,0 +1,187 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
+)
+
+// EncryptedSuffix is appended to the names of encrypted backups.
+const EncryptedSuffix = ".enc"
+
+var (
+	encryptionMagic = []byte("CLBACKUP")
+
+	// ErrBackupKeyRequired is returned when an encrypted backup is requested but no backup key is available.
+	ErrBackupKeyRequired = errors.New("a backup key is required to encrypt or decrypt backups")
+)
+
+// BackupKeyFunc returns the backup key with the given ID.
+type BackupKeyFunc func(id string) (backupkey.Key, error)
+
+// StaticBackupKey returns a BackupKeyFunc for a single key, e.g. one exported from the node's keystore.
+func StaticBackupKey(key backupkey.Key) BackupKeyFunc {
+	return func(id string) (backupkey.Key, error) {
+		if id != key.ID() {
+			return backupkey.Key{}, errors.Errorf("backup was encrypted with backup key %s, not %s", id, key.ID())
+		}
+		return key, nil
+	}
+}
+
+const (
+	encryptionVersion   = 1
+	encryptionChunkSize = 1 << 20
+	saltSize            = 16
+)
+
+// encryptBackup writes src to dst encrypted with AES-256-GCM, under a key derived from the backup key and a random
+// salt.
+//
+// The output consists of a header (magic, version, backup key ID, salt) followed by length prefixed chunks of at most
+// encryptionChunkSize plaintext bytes. Every chunk uses its index as nonce and authenticates whether it is the last
+// one, so reordered or truncated backups fail to decrypt.
+func encryptBackup(dst io.Writer, src io.Reader, key backupkey.Key) error {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return errors.Wrap(err, "failed to generate backup salt")
+	}
+	aead, err := key.AEAD(salt)
+	if err != nil {
+		return errors.Wrap(err, "failed to derive backup key")
+	}
+
+	id := key.ID()
+	header := make([]byte, 0, len(encryptionMagic)+2+len(id)+saltSize)
+	header = append(header, encryptionMagic...)
+	header = append(header, encryptionVersion, byte(len(id)))
+	header = append(header, id...)
+	header = append(header, salt...)
+	if _, err = dst.Write(header); err != nil {
+		return err
+	}
+
+	in := bufio.NewReaderSize(src, encryptionChunkSize)
+	buf := make([]byte, encryptionChunkSize)
+	var out []byte
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(in, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return errors.Wrap(err, "failed to read backup")
+		}
+		last := err != nil
+		if !last {
+			if _, perr := in.Peek(1); perr != nil {
+				if !errors.Is(perr, io.EOF) {
+					return errors.Wrap(perr, "failed to read backup")
+				}
+				last = true
+			}
+		}
+
+		out = aead.Seal(out[:0], chunkNonce(aead, index), buf[:n], chunkAdditionalData(last))
+		if _, err = dst.Write(binary.BigEndian.AppendUint32(nil, uint32(len(out)))); err != nil {
+			return err
+		}
+		if _, err = dst.Write(out); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// decryptBackup reverses encryptBackup, writing the plaintext of src to dst. The key is looked up by the ID recorded
+// in the backup.
+func decryptBackup(dst io.Writer, src io.Reader, getKey BackupKeyFunc) error {
+	if getKey == nil {
+		return ErrBackupKeyRequired
+	}
+	in := bufio.NewReader(src)
+
+	header := make([]byte, len(encryptionMagic)+2)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return errors.Wrap(err, "failed to read encrypted backup header")
+	}
+	if string(header[:len(encryptionMagic)]) != string(encryptionMagic) {
+		return errors.New("not an encrypted backup")
+	}
+	if v := header[len(encryptionMagic)]; v != encryptionVersion {
+		return errors.Errorf("unsupported encrypted backup version %d", v)
+	}
+	idAndSalt := make([]byte, int(header[len(encryptionMagic)+1])+saltSize)
+	if _, err := io.ReadFull(in, idAndSalt); err != nil {
+		return errors.Wrap(err, "failed to read encrypted backup header")
+	}
+	id, salt := string(idAndSalt[:len(idAndSalt)-saltSize]), idAndSalt[len(idAndSalt)-saltSize:]
+	key, err := getKey(id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get backup key %s", id)
+	}
+	aead, err := key.AEAD(salt)
+	if err != nil {
+		return errors.Wrap(err, "failed to derive backup key")
+	}
+
+	lenBuf := make([]byte, 4)
+	var chunk, plain []byte
+	for index := uint64(0); ; index++ {
+		if _, err = io.ReadFull(in, lenBuf); err != nil {
+			return errors.Wrap(err, "encrypted backup is truncated")
+		}
+		n := binary.BigEndian.Uint32(lenBuf)
+		if n > encryptionChunkSize+uint32(aead.Overhead()) {
+			return errors.New("invalid encrypted backup chunk")
+		}
+		if cap(chunk) < int(n) {
+			chunk = make([]byte, n)
+		}
+		chunk = chunk[:n]
+		if _, err = io.ReadFull(in, chunk); err != nil {
+			return errors.Wrap(err, "encrypted backup is truncated")
+		}
+
+		// try the regular chunk first, then the final one
+		last := false
+		plain, err = aead.Open(plain[:0], chunkNonce(aead, index), chunk, chunkAdditionalData(false))
+		if err != nil {
+			plain, err = aead.Open(plain[:0], chunkNonce(aead, index), chunk, chunkAdditionalData(true))
+			if err != nil && index == 0 {
+				return errors.Wrapf(err, "failed to decrypt backup with key %s", id)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to decrypt backup chunk %d", index)
+			}
+			last = true
+		}
+		if _, err = dst.Write(plain); err != nil {
+			return err
+		}
+		if last {
+			if _, err = in.Peek(1); !errors.Is(err, io.EOF) {
+				return errors.New("unexpected data after the end of the encrypted backup")
+			}
+			return nil
+		}
+	}
+}
+
+func chunkNonce(aead cipher.AEAD, index uint64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], index)
+	return nonce
+}
+
+func chunkAdditionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
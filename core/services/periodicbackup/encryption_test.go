@@ -0,0 +1,78 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
+)
+
+func TestEncryptBackup(t *testing.T) {
+	key, err := backupkey.New()
+	require.NoError(t, err)
+	getKey := StaticBackupKey(key)
+
+	for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, 2*encryptionChunkSize + 17} {
+		plaintext := make([]byte, size)
+		_, err := rand.Read(plaintext)
+		require.NoError(t, err)
+
+		var encrypted bytes.Buffer
+		require.NoError(t, encryptBackup(&encrypted, bytes.NewReader(plaintext), key))
+		if size >= 64 {
+			assert.False(t, bytes.Contains(encrypted.Bytes(), plaintext[:64]))
+		}
+
+		var decrypted bytes.Buffer
+		require.NoError(t, decryptBackup(&decrypted, bytes.NewReader(encrypted.Bytes()), getKey))
+		assert.Equal(t, plaintext, append([]byte{}, decrypted.Bytes()...), "size %d", size)
+	}
+
+	plaintext := make([]byte, 2*encryptionChunkSize+17)
+	var encrypted bytes.Buffer
+	require.NoError(t, encryptBackup(&encrypted, bytes.NewReader(plaintext), key))
+
+	t.Run("records the key", func(t *testing.T) {
+		assert.True(t, bytes.Contains(encrypted.Bytes()[:64], []byte(key.ID())))
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		other, err := backupkey.New()
+		require.NoError(t, err)
+		err = decryptBackup(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), StaticBackupKey(other))
+		require.ErrorContains(t, err, "backup was encrypted with backup key "+key.ID())
+	})
+
+	t.Run("salted", func(t *testing.T) {
+		var again bytes.Buffer
+		require.NoError(t, encryptBackup(&again, bytes.NewReader(plaintext), key))
+		assert.NotEqual(t, encrypted.Bytes()[:64], again.Bytes()[:64])
+	})
+
+	t.Run("truncated at a chunk boundary", func(t *testing.T) {
+		header := len(encryptionMagic) + 2 + len(key.ID()) + saltSize
+		chunk := 4 + encryptionChunkSize + 16
+		err := decryptBackup(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()[:header+chunk]), getKey)
+		require.ErrorContains(t, err, "truncated")
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := bytes.Clone(encrypted.Bytes())
+		tampered[len(tampered)-1] ^= 1
+		err := decryptBackup(&bytes.Buffer{}, bytes.NewReader(tampered), getKey)
+		require.ErrorContains(t, err, "failed to decrypt backup chunk 2")
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		err := decryptBackup(&bytes.Buffer{}, bytes.NewReader([]byte("PGDMP not encrypted")), getKey)
+		require.ErrorContains(t, err, "not an encrypted backup")
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		require.ErrorIs(t, decryptBackup(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), nil), ErrBackupKeyRequired)
+	})
+}
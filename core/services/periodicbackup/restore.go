@@ -0,0 +1,114 @@
+package periodicbackup
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ListBackups returns the backups in the sink configured by backupConfig, newest first.
+func ListBackups(ctx context.Context, rootDir string, backupConfig BackupConfig) ([]BackupObject, error) {
+	sink, err := newConfiguredSink(rootDir, backupConfig)
+	if err != nil {
+		return nil, err
+	}
+	return listBackups(ctx, sink)
+}
+
+// RestoreBackup fetches the backup called name from the sink configured by backupConfig, decrypts it if needed, and
+// restores it into the database at dbUrl with pg_restore. The most recent backup is restored if name is empty.
+// Encrypted backups are decrypted with the backup key returned by getKey, which may be nil if the backup is not
+// encrypted.
+func RestoreBackup(ctx context.Context, dbUrl url.URL, rootDir string, backupConfig BackupConfig, name string, getKey BackupKeyFunc, lggr logger.Logger) error {
+	lggr = lggr.Named("DatabaseBackup")
+	sink, err := newConfiguredSink(rootDir, backupConfig)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		backups, err := listBackups(ctx, sink)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list backups in %s", sink.String())
+		}
+		if len(backups) == 0 {
+			return errors.Errorf("no backups found in %s", sink.String())
+		}
+		name = backups[0].Name
+	}
+	encrypted := strings.HasSuffix(name, EncryptedSuffix)
+	if encrypted && getKey == nil {
+		return errors.Wrapf(ErrBackupKeyRequired, "backup %s is encrypted", name)
+	}
+
+	lggr.Infow("Fetching backup", "name", name, "sink", sink.String())
+	src, err := sink.Get(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch backup %s from %s", name, sink.String())
+	}
+	defer src.Close()
+
+	dir, err := backupDir(rootDir, backupConfig)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "Failed to create directories on the path: %s", dir)
+	}
+	tmp, err := os.CreateTemp(dir, backupTmpPrefix)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create a tmp file")
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if encrypted {
+		err = decryptBackup(tmp, src, getKey)
+	} else {
+		_, err = io.Copy(tmp, src)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch backup %s", name)
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	lggr.Infow("Restoring backup, this can take a while", "name", name, "database", dbUrl.Redacted())
+	return pgRestore(ctx, dbUrl, tmp.Name())
+}
+
+func newConfiguredSink(rootDir string, backupConfig BackupConfig) (BackupSink, error) {
+	dir, err := backupDir(rootDir, backupConfig)
+	if err != nil {
+		return nil, err
+	}
+	return NewBackupSink(backupConfig, dir)
+}
+
+// pgRestore replaces the contents of the database at dbUrl with the dump at path.
+func pgRestore(ctx context.Context, dbUrl url.URL, path string) error {
+	args := []string{
+		"-d", dbUrl.String(),
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--single-transaction",
+		path,
+	}
+	cmd := exec.CommandContext(ctx, "pg_restore", args...)
+	if _, err := cmd.Output(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore failed with output: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore failed")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package periodicbackup
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+)
+
+const backupTmpPrefix = "cl_backup_tmp_"
+
+// backupNameRegex matches the names of backups created by runSinkBackup, optionally encrypted. Legacy backups, which
+// are overwritten in place and named cl_backup_<version>.dump, don't match, so they are never listed or pruned.
+var backupNameRegex = regexp.MustCompile(`^cl_backup_.+_\d{8}T\d{6}Z\.dump(` + regexp.QuoteMeta(EncryptedSuffix) + `)?$`)
+
+// listBackups returns the backups in sink, newest first. Objects not created by the node are ignored.
+func listBackups(ctx context.Context, sink BackupSink) ([]BackupObject, error) {
+	objects, err := sink.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var backups []BackupObject
+	for _, o := range objects {
+		if !backupNameRegex.MatchString(o.Name) || strings.HasPrefix(o.Name, backupTmpPrefix) {
+			continue
+		}
+		backups = append(backups, o)
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		if backups[i].ModTime.Equal(backups[j].ModTime) {
+			return backups[i].Name > backups[j].Name
+		}
+		return backups[i].ModTime.After(backups[j].ModTime)
+	})
+	return backups, nil
+}
+
+// pruneBackups deletes backups beyond the newest maxCount, and those older than maxAge. Zero values disable the
+// respective limit. The names of deleted backups are returned.
+func pruneBackups(ctx context.Context, sink BackupSink, maxCount uint32, maxAge time.Duration, now time.Time) (deleted []string, err error) {
+	if maxCount == 0 && maxAge == 0 {
+		return nil, nil
+	}
+	backups, err := listBackups(ctx, sink)
+	if err != nil {
+		return nil, err
+	}
+	for i, b := range backups {
+		expired := maxAge > 0 && now.Sub(b.ModTime) > maxAge
+		excess := maxCount > 0 && i >= int(maxCount)
+		if !expired && !excess {
+			continue
+		}
+		if derr := sink.Delete(ctx, b.Name); derr != nil {
+			err = multierr.Append(err, derr)
+			continue
+		}
+		deleted = append(deleted, b.Name)
+	}
+	return deleted, err
+}
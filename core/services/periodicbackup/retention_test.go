@@ -0,0 +1,77 @@
+package periodicbackup
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestPruneBackups(t *testing.T) {
+	now := time.Now()
+	setup := func(t *testing.T) (*localSink, string) {
+		dir := t.TempDir()
+		for i, name := range []string{"cl_backup_2.0.0_20240104T000000Z.dump", "cl_backup_2.0.0_20240103T000000Z.dump.enc", "cl_backup_1.0.0_20240102T000000Z.dump", "cl_backup_2.0.0_20240101T000000Z.dump"} {
+			p := filepath.Join(dir, name)
+			require.NoError(t, os.WriteFile(p, []byte(name), 0o600))
+			age := time.Duration(i) * 24 * time.Hour
+			require.NoError(t, os.Chtimes(p, now.Add(-age), now.Add(-age)))
+		}
+		// files not created as backups, including legacy backups overwritten per version, are never pruned
+		old := now.Add(-365 * 24 * time.Hour)
+		for _, name := range []string{"cl_backup_1.0.0.dump", "cl_backup_tmp_123", "notes.txt"} {
+			p := filepath.Join(dir, name)
+			require.NoError(t, os.WriteFile(p, nil, 0o600))
+			require.NoError(t, os.Chtimes(p, old, old))
+		}
+		return &localSink{dir: dir}, dir
+	}
+	remaining := func(t *testing.T, dir string) []string {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		sink, dir := setup(t)
+		deleted, err := pruneBackups(testutils.Context(t), sink, 0, 0, now)
+		require.NoError(t, err)
+		assert.Empty(t, deleted)
+		assert.Len(t, remaining(t, dir), 7)
+	})
+
+	t.Run("max count", func(t *testing.T) {
+		sink, dir := setup(t)
+		deleted, err := pruneBackups(testutils.Context(t), sink, 2, 0, now)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"cl_backup_1.0.0_20240102T000000Z.dump", "cl_backup_2.0.0_20240101T000000Z.dump"}, deleted)
+		assert.Equal(t, []string{"cl_backup_1.0.0.dump", "cl_backup_2.0.0_20240103T000000Z.dump.enc", "cl_backup_2.0.0_20240104T000000Z.dump", "cl_backup_tmp_123", "notes.txt"}, remaining(t, dir))
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		sink, dir := setup(t)
+		deleted, err := pruneBackups(testutils.Context(t), sink, 0, 36*time.Hour, now)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"cl_backup_1.0.0_20240102T000000Z.dump", "cl_backup_2.0.0_20240101T000000Z.dump"}, deleted)
+		assert.Len(t, remaining(t, dir), 5)
+	})
+
+	t.Run("both", func(t *testing.T) {
+		sink, dir := setup(t)
+		deleted, err := pruneBackups(testutils.Context(t), sink, 1, 36*time.Hour, now)
+		require.NoError(t, err)
+		assert.Len(t, deleted, 3)
+		assert.Equal(t, []string{"cl_backup_1.0.0.dump", "cl_backup_2.0.0_20240104T000000Z.dump", "cl_backup_tmp_123", "notes.txt"}, remaining(t, dir))
+	})
+}
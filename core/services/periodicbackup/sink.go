@@ -0,0 +1,139 @@
+package periodicbackup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// BackupObject describes a backup stored in a BackupSink.
+type BackupObject struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
+
+// BackupSink is a destination database backups are written to.
+type BackupSink interface {
+	// Put stores the contents of r under name, replacing any existing backup with the same name.
+	Put(ctx context.Context, name string, r io.Reader) error
+	// Get returns a reader for the backup stored under name.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	// List returns all backups in the sink.
+	List(ctx context.Context) ([]BackupObject, error)
+	// Delete removes the backup stored under name.
+	Delete(ctx context.Context, name string) error
+	// String describes the sink for logging.
+	String() string
+}
+
+// NewBackupSink returns the BackupSink selected by the backup config. Local backups are written to outputDir.
+func NewBackupSink(backupConfig BackupConfig, outputDir string) (BackupSink, error) {
+	switch backupConfig.Sink() {
+	case config.DatabaseBackupSinkLocal:
+		return &localSink{dir: outputDir}, nil
+	case config.DatabaseBackupSinkS3:
+		return newS3Sink(backupConfig.S3())
+	case config.DatabaseBackupSinkSFTP:
+		return newSFTPSink(backupConfig.SFTP())
+	default:
+		return nil, errors.Errorf("unknown Database.Backup.Sink: %s", backupConfig.Sink())
+	}
+}
+
+type localSink struct {
+	dir string
+}
+
+var _ BackupSink = (*localSink)(nil)
+
+func (s *localSink) Put(_ context.Context, name string, r io.Reader) error {
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "Failed to create directories on the path: %s", s.dir)
+	}
+	tmp, err := os.CreateTemp(s.dir, "cl_backup_tmp_")
+	if err != nil {
+		return errors.Wrap(err, "Failed to create a tmp file")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "Failed to write the backup file")
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	finalPath, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), finalPath)
+}
+
+func (s *localSink) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	p, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
+
+func (s *localSink) List(_ context.Context) ([]BackupObject, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var objects []BackupObject
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, BackupObject{Name: e.Name(), Size: info.Size(), ModTime: info.ModTime()})
+	}
+	return objects, nil
+}
+
+func (s *localSink) Delete(_ context.Context, name string) error {
+	p, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	return os.Remove(p)
+}
+
+func (s *localSink) String() string {
+	return fmt.Sprintf("local directory %s", s.dir)
+}
+
+func (s *localSink) path(name string) (string, error) {
+	if err := validateObjectName(name); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, name), nil
+}
+
+// validateObjectName ensures backup names cannot escape the sink's directory or prefix.
+func validateObjectName(name string) error {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return errors.Errorf("invalid backup name %q", name)
+	}
+	return nil
+}
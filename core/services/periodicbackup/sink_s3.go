@@ -0,0 +1,152 @@
+package periodicbackup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const s3DefaultRegion = "us-east-1"
+
+// s3Sink stores backups in an S3-compatible bucket, using path-style addressing so that it also works with
+// self-hosted services such as MinIO.
+type s3Sink struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+var _ BackupSink = (*s3Sink)(nil)
+
+func newS3Sink(cfg config.BackupS3) (*s3Sink, error) {
+	endpoint := cfg.Endpoint()
+	if endpoint == nil || endpoint.Host == "" {
+		return nil, errors.New("Database.Backup.S3.Endpoint is required")
+	}
+	if cfg.Bucket() == "" {
+		return nil, errors.New("Database.Backup.S3.Bucket is required")
+	}
+	region := cfg.Region()
+	if region == "" {
+		region = s3DefaultRegion
+	}
+	client := s3.New(s3.Options{
+		Region:       region,
+		BaseEndpoint: aws.String(endpoint.String()),
+		UsePathStyle: true,
+		Credentials:  credentials.NewStaticCredentialsProvider(cfg.AccessKeyID(), cfg.SecretAccessKey(), ""),
+	})
+	return &s3Sink{
+		client: client,
+		bucket: cfg.Bucket(),
+		prefix: cfg.Prefix(),
+	}, nil
+}
+
+func (s *s3Sink) Put(ctx context.Context, name string, r io.Reader) error {
+	if err := validateObjectName(name); err != nil {
+		return err
+	}
+	// S3 requires the content length up front, so anything but a file is spooled to disk first.
+	f, ok := r.(*os.File)
+	if !ok {
+		tmp, err := os.CreateTemp("", backupTmpPrefix)
+		if err != nil {
+			return errors.Wrap(err, "Failed to create a tmp file")
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+		if _, err = io.Copy(tmp, r); err != nil {
+			return errors.Wrap(err, "Failed to write the backup file")
+		}
+		if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		f = tmp
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(s.prefix + name),
+		Body:          f,
+		ContentLength: aws.Int64(info.Size()),
+	})
+	return errors.Wrapf(err, "failed to upload %s", name)
+}
+
+func (s *s3Sink) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	if err := validateObjectName(name); err != nil {
+		return nil, err
+	}
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + name),
+	})
+	if err != nil {
+		return nil, s3Error(err, name)
+	}
+	return out.Body, nil
+}
+
+func (s *s3Sink) List(ctx context.Context) ([]BackupObject, error) {
+	input := &s3.ListObjectsV2Input{Bucket: aws.String(s.bucket)}
+	if s.prefix != "" {
+		input.Prefix = aws.String(s.prefix)
+	}
+	var objects []BackupObject
+	pages := s3.NewListObjectsV2Paginator(s.client, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", s)
+		}
+		for _, c := range page.Contents {
+			name := strings.TrimPrefix(aws.ToString(c.Key), s.prefix)
+			if validateObjectName(name) != nil {
+				continue
+			}
+			objects = append(objects, BackupObject{Name: name, Size: aws.ToInt64(c.Size), ModTime: aws.ToTime(c.LastModified)})
+		}
+	}
+	return objects, nil
+}
+
+func (s *s3Sink) Delete(ctx context.Context, name string) error {
+	if err := validateObjectName(name); err != nil {
+		return err
+	}
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + name),
+	})
+	return errors.Wrapf(err, "failed to delete %s", name)
+}
+
+func (s *s3Sink) String() string {
+	return fmt.Sprintf("s3 bucket %s/%s", s.bucket, s.prefix)
+}
+
+// s3Error maps missing objects to os.ErrNotExist, like the other sinks.
+func s3Error(err error, name string) error {
+	var noSuchKey *types.NoSuchKey
+	var respErr *smithyhttp.ResponseError
+	if errors.As(err, &noSuchKey) || (errors.As(err, &respErr) && respErr.HTTPStatusCode() == 404) {
+		return errors.Wrap(os.ErrNotExist, err.Error())
+	}
+	return errors.Wrapf(err, "failed to fetch %s", name)
+}
@@ -0,0 +1,222 @@
+package periodicbackup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pkg/sftp"
+	"go.uber.org/multierr"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const sftpDialTimeout = 30 * time.Second
+
+// sftpSink stores backups in a directory on a remote host over SFTP. A new connection is made for every operation,
+// since backups are infrequent.
+type sftpSink struct {
+	address string
+	dir     string
+	dial    func(ctx context.Context) (*sftpConn, error)
+}
+
+var _ BackupSink = (*sftpSink)(nil)
+
+func newSFTPSink(cfg config.BackupSFTP) (*sftpSink, error) {
+	if cfg.Address() == "" {
+		return nil, errors.New("Database.Backup.SFTP.Address is required")
+	}
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cfg.HostKey()))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Database.Backup.SFTP.HostKey")
+	}
+	keyFile := cfg.KeyFile()
+	if keyFile == "" {
+		return nil, errors.New("Database.Backup.SFTP.KeyFile is required")
+	}
+
+	sshConfig := &ssh.ClientConfig{
+		User:            cfg.User(),
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+	}
+	address := cfg.Address()
+	return &sftpSink{
+		address: address,
+		dir:     cfg.Dir(),
+		dial: func(ctx context.Context) (*sftpConn, error) {
+			// the key is read on every dial so that it can be rotated without a restart
+			pem, err := os.ReadFile(keyFile)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read Database.Backup.SFTP.KeyFile")
+			}
+			signer, err := ssh.ParsePrivateKey(pem)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse Database.Backup.SFTP.KeyFile")
+			}
+			c := *sshConfig
+			c.Auth = []ssh.AuthMethod{ssh.PublicKeys(signer)}
+			return dialSFTP(ctx, address, &c)
+		},
+	}, nil
+}
+
+// sftpConn is an SFTP session, together with the SSH connection it runs over if any.
+type sftpConn struct {
+	*sftp.Client
+	ssh io.Closer
+}
+
+func (c *sftpConn) Close() error {
+	err := c.Client.Close()
+	if c.ssh != nil {
+		err = multierr.Append(err, c.ssh.Close())
+	}
+	return err
+}
+
+func dialSFTP(ctx context.Context, address string, sshConfig *ssh.ClientConfig) (*sftpConn, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	if err = conn.SetDeadline(time.Now().Add(sftpDialTimeout)); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, address, sshConfig)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		_ = sshConn.Close()
+		return nil, err
+	}
+	sshClient := ssh.NewClient(sshConn, chans, reqs)
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		_ = sshClient.Close()
+		return nil, errors.Wrap(err, "failed to start sftp subsystem")
+	}
+	return &sftpConn{Client: client, ssh: sshClient}, nil
+}
+
+func (s *sftpSink) Put(ctx context.Context, name string, r io.Reader) error {
+	if err := validateObjectName(name); err != nil {
+		return err
+	}
+	c, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	// upload to a temporary name so that a partial upload is never mistaken for a backup
+	tmp := s.path(fmt.Sprintf("%s%d", backupTmpPrefix, time.Now().UnixNano()))
+	if err = writeSFTPFile(c, tmp, r); err != nil {
+		_ = c.Remove(tmp)
+		return err
+	}
+	final := s.path(name)
+	// SFTP v3 does not overwrite on rename
+	_ = c.Remove(final)
+	if err = c.Rename(tmp, final); err != nil {
+		_ = c.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+func (s *sftpSink) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	if err := validateObjectName(name); err != nil {
+		return nil, err
+	}
+	c, err := s.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f, err := c.Open(s.path(name))
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return &sftpReader{File: f, conn: c}, nil
+}
+
+func (s *sftpSink) List(ctx context.Context) ([]BackupObject, error) {
+	c, err := s.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	dir := s.dir
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := c.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var objects []BackupObject
+	for _, e := range entries {
+		if !e.Mode().IsRegular() || validateObjectName(e.Name()) != nil {
+			continue
+		}
+		objects = append(objects, BackupObject{Name: e.Name(), Size: e.Size(), ModTime: e.ModTime()})
+	}
+	return objects, nil
+}
+
+func (s *sftpSink) Delete(ctx context.Context, name string) error {
+	if err := validateObjectName(name); err != nil {
+		return err
+	}
+	c, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	return c.Remove(s.path(name))
+}
+
+func (s *sftpSink) String() string {
+	return fmt.Sprintf("sftp %s:%s", s.address, s.dir)
+}
+
+func (s *sftpSink) path(name string) string {
+	if s.dir == "" {
+		return name
+	}
+	return path.Join(s.dir, name)
+}
+
+func writeSFTPFile(c *sftpConn, name string, r io.Reader) error {
+	f, err := c.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+	if _, err = f.ReadFrom(r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// sftpReader closes the connection a backup was fetched over once it has been read.
+type sftpReader struct {
+	*sftp.File
+	conn *sftpConn
+}
+
+func (r *sftpReader) Close() error {
+	return multierr.Combine(r.File.Close(), r.conn.Close())
+}
@@ -0,0 +1,187 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// fakeS3 is an in-memory stand-in for the subset of the S3 API used by s3Sink.
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	p := strings.TrimPrefix(r.URL.Path, "/"+f.bucket)
+	if (p == "" || p == "/") && r.Method == http.MethodGet {
+		type content struct {
+			Key          string
+			LastModified time.Time
+			Size         int64
+		}
+		var result struct {
+			XMLName     xml.Name `xml:"ListBucketResult"`
+			Contents    []content
+			IsTruncated bool
+		}
+		for k, v := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				result.Contents = append(result.Contents, content{Key: k, LastModified: time.Now(), Size: int64(len(v))})
+			}
+		}
+		_ = xml.NewEncoder(w).Encode(result)
+		return
+	}
+
+	key := strings.TrimPrefix(p, "/")
+	switch r.Method {
+	case http.MethodPut:
+		if r.ContentLength < 0 {
+			w.WriteHeader(http.StatusLengthRequired)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		f.objects[key] = b
+	case http.MethodGet:
+		b, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+			return
+		}
+		_, _ = w.Write(b)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+type testS3Config struct {
+	endpoint *url.URL
+}
+
+func (c *testS3Config) Endpoint() *url.URL      { return c.endpoint }
+func (c *testS3Config) Region() string          { return "" }
+func (c *testS3Config) Bucket() string          { return "backups" }
+func (c *testS3Config) Prefix() string          { return "node 1/" }
+func (c *testS3Config) AccessKeyID() string     { return "AKID" }
+func (c *testS3Config) SecretAccessKey() string { return "secret" }
+
+func TestBackupSinks(t *testing.T) {
+	s3 := &fakeS3{bucket: "backups", objects: map[string][]byte{"other/cl_backup_x.dump": []byte("other prefix")}}
+	srv := httptest.NewServer(s3)
+	t.Cleanup(srv.Close)
+	endpoint, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s3Sink, err := newS3Sink(&testS3Config{endpoint: endpoint})
+	require.NoError(t, err)
+
+	sftpDir := t.TempDir()
+	sftpSink := &sftpSink{dir: sftpDir, dial: func(context.Context) (*sftpConn, error) {
+		return newFakeSFTPConn(t)
+	}}
+
+	for _, sink := range []BackupSink{&localSink{dir: t.TempDir()}, s3Sink, sftpSink} {
+		t.Run(sink.String(), func(t *testing.T) {
+			ctx := testutils.Context(t)
+
+			backups, err := sink.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, backups)
+
+			large := bytes.Repeat([]byte("0123456789abcdef"), 200_000)
+			require.NoError(t, sink.Put(ctx, "cl_backup_1.dump", bytes.NewReader(large)))
+			require.NoError(t, sink.Put(ctx, "cl_backup_2.dump", strings.NewReader("first")))
+			require.NoError(t, sink.Put(ctx, "cl_backup_2.dump", strings.NewReader("second")))
+
+			backups, err = sink.List(ctx)
+			require.NoError(t, err)
+			sort.Slice(backups, func(i, j int) bool { return backups[i].Name < backups[j].Name })
+			require.Len(t, backups, 2)
+			assert.Equal(t, "cl_backup_1.dump", backups[0].Name)
+			assert.Equal(t, int64(len(large)), backups[0].Size)
+			assert.Equal(t, "cl_backup_2.dump", backups[1].Name)
+
+			r, err := sink.Get(ctx, "cl_backup_1.dump")
+			require.NoError(t, err)
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, large, got)
+
+			r, err = sink.Get(ctx, "cl_backup_2.dump")
+			require.NoError(t, err)
+			got, err = io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, "second", string(got))
+
+			require.NoError(t, sink.Delete(ctx, "cl_backup_1.dump"))
+			_, err = sink.Get(ctx, "cl_backup_1.dump")
+			require.ErrorIs(t, err, os.ErrNotExist)
+
+			backups, err = sink.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, backups, 1)
+
+			require.Error(t, sink.Put(ctx, "../escape.dump", strings.NewReader("")))
+		})
+	}
+
+	assert.Contains(t, s3.objects, "node 1/cl_backup_2.dump")
+	_, err = os.Stat(filepath.Join(sftpDir, "cl_backup_2.dump"))
+	require.NoError(t, err)
+}
+
+// newFakeSFTPConn returns a client connected to an in-process SFTP server serving the local filesystem.
+func newFakeSFTPConn(t *testing.T) (*sftpConn, error) {
+	clientR, serverW := io.Pipe()
+	serverR, clientW := io.Pipe()
+	server, err := sftp.NewServer(struct {
+		io.Reader
+		io.WriteCloser
+	}{serverR, serverW})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := server.Serve(); err != nil && !errors.Is(err, io.EOF) {
+			t.Logf("sftp server: %v", err)
+		}
+		_ = server.Close()
+	}()
+	client, err := sftp.NewClientPipe(clientR, clientW)
+	if err != nil {
+		return nil, err
+	}
+	return &sftpConn{Client: client}, nil
+}
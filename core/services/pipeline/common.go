@@ -108,6 +108,8 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// elementRuns are the task runs of the subgraph of a foreach task, for every element
+	elementRuns TaskRunResults
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -208,6 +210,8 @@ type TaskRunResult struct {
 	FinishedAt null.Time
 	// runInfo is never persisted
 	runInfo RunInfo
+	// inputs are only recorded by simulations
+	inputs []Result
 }
 
 func (result *TaskRunResult) IsPending() bool {
@@ -301,6 +305,7 @@ const (
 	TaskTypeBridge           TaskType = "bridge"
 	TaskTypeCBORParse        TaskType = "cborparse"
 	TaskTypeConditional      TaskType = "conditional"
+	TaskTypeDeviation        TaskType = "deviation"
 	TaskTypeDivide           TaskType = "divide"
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
 	TaskTypeETHABIDecodeLog  TaskType = "ethabidecodelog"
@@ -309,6 +314,7 @@ const (
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeForEach          TaskType = "foreach"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
@@ -322,11 +328,14 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeRejectOutliers   TaskType = "rejectoutliers"
 	TaskTypeSum              TaskType = "sum"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -367,6 +376,14 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeRejectOutliers:
+		task = &RejectOutliersTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeDeviation:
+		task = &DeviationTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
@@ -387,6 +404,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &VRFTaskV2Plus{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeEstimateGasLimit:
 		task = &EstimateGasLimitTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeForEach:
+		task = &ForEachTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHCall:
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
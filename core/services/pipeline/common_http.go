@@ -23,10 +23,13 @@ func makeHTTPRequest(
 	requestData MapParam,
 	client *http.Client,
 	httpLimit int64,
+	authenticate func(request *http.Request, body []byte) error,
 ) ([]byte, int, http.Header, time.Duration, error) {
 	var bodyReader io.Reader
+	var bodyBytes []byte
 	if requestData != nil {
-		bodyBytes, err := json.Marshal(requestData)
+		var err error
+		bodyBytes, err = json.Marshal(requestData)
 		if err != nil {
 			return nil, 0, nil, 0, errors.Wrap(err, "failed to encode request body as JSON")
 		}
@@ -44,6 +47,11 @@ func makeHTTPRequest(
 	for i := 0; i+1 < len(reqHeaders); i += 2 {
 		request.Header.Set(reqHeaders[i], reqHeaders[i+1])
 	}
+	if authenticate != nil {
+		if err = authenticate(request, bodyBytes); err != nil {
+			return nil, 0, nil, 0, errors.Wrap(err, "failed to authenticate http.Request")
+		}
+	}
 
 	httpRequest := clhttp.HTTPRequest{
 		Client:  client,
@@ -0,0 +1,171 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
+)
+
+// tokenExpiryDelta is how long before their expiry cached OAuth2 access tokens are refreshed.
+const tokenExpiryDelta = 30 * time.Second
+
+// maxCredentialRedirects mirrors the default redirect limit of http.Client.
+const maxCredentialRedirects = 10
+
+// HTTPCredentialStore looks up the named credentials which HTTP tasks authenticate with.
+type HTTPCredentialStore interface {
+	Get(name string) (httpcredential.Credential, error)
+}
+
+// httpAuthenticator authenticates HTTP task requests with named credentials. It is shared by all runs, so that OAuth2
+// access tokens are cached until they expire and TLS connections are reused.
+type httpAuthenticator struct {
+	store HTTPCredentialStore
+	now   func() time.Time
+
+	mu      sync.Mutex
+	tokens  map[string]*cachedToken
+	clients map[credentialClientKey]*credentialClient
+}
+
+type cachedToken struct {
+	// mu serializes refreshes, so that concurrent runs wait for a single token request
+	mu          sync.Mutex
+	fingerprint string
+	token       httpcredential.Token
+}
+
+type credentialClientKey struct {
+	name string
+	base *http.Client
+}
+
+type credentialClient struct {
+	fingerprint string
+	client      *http.Client
+}
+
+func newHTTPAuthenticator(store HTTPCredentialStore) *httpAuthenticator {
+	return &httpAuthenticator{
+		store:   store,
+		now:     time.Now,
+		tokens:  make(map[string]*cachedToken),
+		clients: make(map[credentialClientKey]*credentialClient),
+	}
+}
+
+func (a *httpAuthenticator) credential(name string) (httpcredential.Credential, error) {
+	if a == nil || a.store == nil {
+		return httpcredential.Credential{}, errors.New("HTTP credentials are not available")
+	}
+	return a.store.Get(name)
+}
+
+// client returns a client derived from base, which presents the TLS client certificate of cred, if any, and does not
+// follow redirects to other hosts, so that credentials are not leaked.
+func (a *httpAuthenticator) client(cred httpcredential.Credential, base *http.Client) (*http.Client, error) {
+	key := credentialClientKey{cred.Name, base}
+	fingerprint := cred.Fingerprint()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if cc, ok := a.clients[key]; ok && cc.fingerprint == fingerprint {
+		return cc.client, nil
+	}
+
+	client := *base
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxCredentialRedirects {
+			return errors.Errorf("stopped after %d redirects", maxCredentialRedirects)
+		}
+		if req.URL.Host != via[0].URL.Host {
+			return errors.Errorf("refusing to follow redirect to %s with credential %s", req.URL.Host, cred.Name)
+		}
+		return nil
+	}
+	if cred.TLS != nil {
+		tlsConfig, err := cred.TLS.ClientConfig()
+		if err != nil {
+			return nil, errors.Wrapf(err, "credential %s", cred.Name)
+		}
+		transport, ok := base.Transport.(*http.Transport)
+		if !ok {
+			return nil, errors.Errorf("credential %s: TLS client certificates are not supported by transport %T", cred.Name, base.Transport)
+		}
+		transport = transport.Clone()
+		transport.TLSClientConfig = tlsConfig
+		client.Transport = transport
+	}
+	// replaces any client of a previous version of the credential
+	a.clients[key] = &credentialClient{fingerprint, &client}
+	return &client, nil
+}
+
+// token returns a cached OAuth2 access token for cred, or fetches a new one with client if it has expired.
+func (a *httpAuthenticator) token(ctx context.Context, cred httpcredential.Credential, client *http.Client) (string, error) {
+	fingerprint := cred.Fingerprint()
+
+	a.mu.Lock()
+	cached, ok := a.tokens[cred.Name]
+	if !ok {
+		cached = &cachedToken{}
+		a.tokens[cred.Name] = cached
+	}
+	a.mu.Unlock()
+
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+	now := a.now()
+	if cached.fingerprint == fingerprint && cached.token.AccessToken != "" &&
+		(cached.token.Expiry.IsZero() || now.Add(tokenExpiryDelta).Before(cached.token.Expiry)) {
+		return cached.token.AccessToken, nil
+	}
+	token, err := cred.OAuth2.FetchToken(ctx, client, now)
+	if err != nil {
+		return "", errors.Wrapf(err, "credential %s: failed to fetch OAuth2 access token", cred.Name)
+	}
+	cached.fingerprint = fingerprint
+	cached.token = token
+	return token.AccessToken, nil
+}
+
+// invalidateToken drops the cached access token of cred, e.g. after it has been rejected.
+func (a *httpAuthenticator) invalidateToken(cred httpcredential.Credential) {
+	a.mu.Lock()
+	cached, ok := a.tokens[cred.Name]
+	a.mu.Unlock()
+	if !ok {
+		return
+	}
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+	cached.token = httpcredential.Token{}
+}
+
+// authenticator returns a function which adds the headers of cred to a request: its static headers, then the OAuth2
+// bearer token, then the HMAC signature, so that the signature can cover the other headers.
+func (a *httpAuthenticator) authenticator(cred httpcredential.Credential, tokenClient *http.Client) func(*http.Request, []byte) error {
+	return func(req *http.Request, body []byte) error {
+		for name, value := range cred.Headers {
+			req.Header.Set(name, value)
+		}
+		if cred.OAuth2 != nil {
+			token, err := a.token(req.Context(), cred, tokenClient)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if cred.HMAC != nil {
+			if err := cred.HMAC.Sign(req, body, a.now()); err != nil {
+				return errors.Wrapf(err, "credential %s", cred.Name)
+			}
+		}
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/encoding"
 	"gonum.org/v1/gonum/graph/encoding/dot"
+	dotformat "gonum.org/v1/gonum/graph/formats/dot"
+	"gonum.org/v1/gonum/graph/formats/dot/ast"
 	"gonum.org/v1/gonum/graph/simple"
 	"gonum.org/v1/gonum/graph/topo"
 )
@@ -19,6 +22,9 @@ import (
 // for us to `dot.Unmarshal(...)` a DOT string directly into it.
 type Graph struct {
 	*simple.DirectedGraph
+
+	// subgraphs are the sources of the named subgraphs executed by foreach tasks, keyed by name.
+	subgraphs map[string]string
 }
 
 func NewGraph() *Graph {
@@ -44,6 +50,7 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	}()
 	bs = append([]byte("digraph {\n"), bs...)
 	bs = append(bs, []byte("\n}")...)
+	bs, g.subgraphs = extractSubgraphs(bs)
 	err = dot.Unmarshal(bs, g)
 	if err != nil {
 		return errors.Wrap(err, "could not unmarshal DOT into a pipeline.Graph")
@@ -52,6 +59,73 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	return nil
 }
 
+// extractSubgraphs removes the named subgraphs which are referenced by the pipeline attribute of a foreach task from
+// the top level of a DOT graph, and returns their statements keyed by name. These are only executed by foreach
+// tasks, while any other subgraph is merged into the enclosing graph as usual. If the graph cannot be parsed it is
+// returned unchanged, so that dot.Unmarshal reports the error.
+func extractSubgraphs(bs []byte) ([]byte, map[string]string) {
+	file, err := dotformat.ParseBytes(bs)
+	if err != nil || len(file.Graphs) != 1 {
+		return bs, nil
+	}
+	graph := file.Graphs[0]
+
+	referenced := make(map[string]struct{})
+	collectForEachPipelines(graph.Stmts, referenced)
+
+	var stmts []ast.Stmt
+	subgraphs := make(map[string]string)
+	for _, stmt := range graph.Stmts {
+		subgraph, is := stmt.(*ast.Subgraph)
+		if !is {
+			stmts = append(stmts, stmt)
+			continue
+		}
+		if _, ok := referenced[subgraph.ID]; !ok {
+			stmts = append(stmts, stmt)
+			continue
+		}
+		var source strings.Builder
+		source.WriteString(subgraphs[subgraph.ID])
+		for _, s := range subgraph.Stmts {
+			source.WriteString(s.String())
+			source.WriteString("\n")
+		}
+		subgraphs[subgraph.ID] = source.String()
+	}
+	if len(subgraphs) == 0 {
+		return bs, nil
+	}
+	graph.Stmts = stmts
+	return []byte(file.String()), subgraphs
+}
+
+// collectForEachPipelines adds the pipeline attribute of every foreach task declared in stmts to names.
+func collectForEachPipelines(stmts []ast.Stmt, names map[string]struct{}) {
+	for _, stmt := range stmts {
+		switch stmt := stmt.(type) {
+		case *ast.NodeStmt:
+			attrs := make(map[string]string, len(stmt.Attrs))
+			for _, attr := range stmt.Attrs {
+				attrs[attr.Key] = unquoteDOTID(attr.Val)
+			}
+			if TaskType(strings.ToLower(attrs["type"])) == TaskTypeForEach && attrs["pipeline"] != "" {
+				names[attrs["pipeline"]] = struct{}{}
+			}
+		case *ast.Subgraph:
+			collectForEachPipelines(stmt.Stmts, names)
+		}
+	}
+}
+
+// unquoteDOTID returns the value of a quoted DOT ID, or the ID itself if it isn't quoted.
+func unquoteDOTID(id string) string {
+	if unquoted, err := strconv.Unquote(id); err == nil {
+		return unquoted
+	}
+	return id
+}
+
 // Looks at node attributes and searches for implicit dependencies on other nodes
 // expressed as attribute values. Adds those dependencies as implicit edges in the graph.
 func (g *Graph) AddImplicitDependenciesAsEdges() {
@@ -60,8 +134,16 @@ func (g *Graph) AddImplicitDependenciesAsEdges() {
 
 		params := make(map[string]bool)
 		// Walk through all attributes and find all params which this node depends on
+		values := make([]string, 0, len(graphNode.attrs))
 		for _, attr := range graphNode.Attributes() {
-			for _, item := range variableRegexp.FindAll([]byte(attr.Value), -1) {
+			values = append(values, attr.Value)
+		}
+		// A foreach task also depends on everything its subgraph depends on
+		if TaskType(strings.ToLower(graphNode.attrs["type"])) == TaskTypeForEach {
+			values = append(values, g.subgraphs[graphNode.attrs["pipeline"]])
+		}
+		for _, value := range values {
+			for _, item := range variableRegexp.FindAll([]byte(value), -1) {
 				expr := strings.TrimSpace(string(item[2 : len(item)-1]))
 				param := strings.Split(expr, ".")[0]
 				params[param] = true
@@ -253,6 +335,16 @@ func Parse(text string) (*Pipeline, error) {
 			return nil, err
 		}
 
+		if forEach, is := task.(*ForEachTask); is {
+			source, exists := g.subgraphs[forEach.Pipeline]
+			if !exists {
+				return nil, errors.Errorf("foreach task '%v' references unknown subgraph '%v'", node.dotID, forEach.Pipeline)
+			}
+			if err = forEach.setSubgraph(source); err != nil {
+				return nil, errors.Wrapf(err, "foreach task '%v' subgraph '%v'", node.dotID, forEach.Pipeline)
+			}
+		}
+
 		if task.OutputIndex() > 0 {
 			_, exists := resultIdxs[task.OutputIndex()]
 			if exists {
@@ -642,6 +642,67 @@ func (_c *Runner_Run_Call) RunAndReturn(run func(context.Context, *pipeline.Run,
 	return _c
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, fixtures
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, fixtures pipeline.SimulationFixtures) (*pipeline.SimulationResult, error) {
+	ret := _m.Called(ctx, spec, vars, fixtures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateRun")
+	}
+
+	var r0 *pipeline.SimulationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationFixtures) (*pipeline.SimulationResult, error)); ok {
+		return rf(ctx, spec, vars, fixtures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationFixtures) *pipeline.SimulationResult); ok {
+		r0 = rf(ctx, spec, vars, fixtures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.SimulationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationFixtures) error); ok {
+		r1 = rf(ctx, spec, vars, fixtures)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Runner_SimulateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateRun'
+type Runner_SimulateRun_Call struct {
+	*mock.Call
+}
+
+// SimulateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - fixtures pipeline.SimulationFixtures
+func (_e *Runner_Expecter) SimulateRun(ctx interface{}, spec interface{}, vars interface{}, fixtures interface{}) *Runner_SimulateRun_Call {
+	return &Runner_SimulateRun_Call{Call: _e.mock.On("SimulateRun", ctx, spec, vars, fixtures)}
+}
+
+func (_c *Runner_SimulateRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, fixtures pipeline.SimulationFixtures)) *Runner_SimulateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(pipeline.SimulationFixtures))
+	})
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) Return(_a0 *pipeline.SimulationResult, _a1 error) *Runner_SimulateRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationFixtures) (*pipeline.SimulationResult, error)) *Runner_SimulateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
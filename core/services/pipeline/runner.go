@@ -53,6 +53,10 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+
+	// SimulateRun executes a new run in-memory according to a spec, substituting fixtures for the results of tasks
+	// which would reach out to external adapters, HTTP endpoints or chains. Nothing is broadcast or persisted.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, fixtures SimulationFixtures) (*SimulationResult, error)
 }
 
 type runner struct {
@@ -61,9 +65,11 @@ type runner struct {
 	btORM                  bridges.ORM
 	config                 Config
 	bridgeConfig           BridgeConfig
+	bridgeHealth           *bridges.HealthMonitor
 	legacyEVMChains        legacyevm.LegacyChainContainer
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
+	httpAuth               *httpAuthenticator
 	runReaperWorker        *commonutils.SleeperTask
 	lggr                   logger.Logger
 	httpClient             *http.Client
@@ -111,9 +117,11 @@ func NewRunner(
 	btORM bridges.ORM,
 	cfg Config,
 	bridgeCfg BridgeConfig,
+	bridgeHealth *bridges.HealthMonitor,
 	legacyChains legacyevm.LegacyChainContainer,
 	ethks ETHKeyStore,
 	vrfks VRFKeyStore,
+	httpCredentials HTTPCredentialStore,
 	lggr logger.Logger,
 	httpClient, unrestrictedHTTPClient *http.Client,
 ) *runner {
@@ -124,9 +132,11 @@ func NewRunner(
 		btORM:                  bridges.NewCache(btORM, lggr, bridges.DefaultUpsertInterval),
 		config:                 cfg,
 		bridgeConfig:           bridgeCfg,
+		bridgeHealth:           bridgeHealth,
 		legacyEVMChains:        legacyChains,
 		ethKeyStore:            ethks,
 		vrfKeyStore:            vrfks,
+		httpAuth:               newHTTPAuthenticator(httpCredentials),
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
 		runFinished:            func(*Run) {},
@@ -313,7 +323,7 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	}
 
 	run := NewRun(spec, vars)
-	taskRunResults := r.run(ctx, pipeline, run, vars)
+	taskRunResults := r.run(ctx, pipeline, run, vars, nil, false)
 
 	if run.Pending {
 		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ExecuteRun", spec.ID)
@@ -323,11 +333,20 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 }
 
 func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
+	return r.initializePipeline(spec, nil)
+}
+
+func (r *runner) initializePipeline(spec Spec, sim *simulation) (pipeline *Pipeline, err error) {
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
 		return
 	}
+	r.initializeTasks(spec, pipeline, sim)
+	return pipeline, nil
+}
 
+// initializeTasks sets the dependencies of tasks which interact with the node, including those of foreach subgraphs.
+func (r *runner) initializeTasks(spec Spec, pipeline *Pipeline, sim *simulation) {
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
 		task.Base().uuid = uuid.New()
@@ -337,6 +356,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).auth = r.httpAuth
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -347,6 +367,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).health = r.bridgeHealth
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -368,14 +389,22 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeForEach:
+			forEach := task.(*ForEachTask)
+			subgraph, subgraphSim := forEach.subgraph, sim.subgraph(forEach.DotID())
+			r.initializeTasks(spec, subgraph, subgraphSim)
+			forEach.runSubgraph = func(ctx context.Context, vars Vars) (*Run, TaskRunResults) {
+				run := NewRun(spec, vars)
+				return run, r.run(ctx, subgraph, run, vars, subgraphSim, true)
+			}
 		default:
 		}
 	}
-
-	return pipeline, nil
 }
 
-func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+// run executes the pipeline. If sim is set, tasks are stubbed by its fixtures and no metrics are recorded. The run of
+// a foreach subgraph records no run metrics, as its task runs are part of the enclosing run.
+func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, sim *simulation, subgraph bool) TaskRunResults {
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
@@ -396,9 +425,11 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		taskRun := taskRun
 		// execute
 		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l, sim)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if sim == nil {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -425,12 +456,21 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime = run.FinishedAt.Time.Sub(run.CreatedAt)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if sim == nil && !subgraph {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
+	}
+
+	// the task runs of foreach elements follow the task run of their foreach task
+	var results TaskRunResults
+	for _, result := range scheduler.results {
+		results = append(results, result)
+		results = append(results, result.runInfo.elementRuns...)
 	}
 
 	// Update run results
 	run.PipelineTaskRuns = nil
-	for _, result := range scheduler.results {
+	for _, result := range results {
 		output := result.Result.OutputDB()
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
@@ -475,17 +515,16 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			if sim == nil && !subgraph {
+				PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
 	}
 
 	// TODO: drop this once we stop using TaskRunResults
-	var taskRunResults TaskRunResults
-	for _, result := range scheduler.results {
-		taskRunResults = append(taskRunResults, result)
-	}
+	taskRunResults := results
 
 	var idxs []int32
 	for i := range taskRunResults {
@@ -525,7 +564,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
-func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
+func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger, sim *simulation) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
@@ -552,7 +591,11 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	result, stubbed := sim.stub(taskRun.task)
+	var runInfo RunInfo
+	if !stubbed {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -573,7 +616,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	if !runInfo.IsPending {
 		finishedAt = null.TimeFrom(now)
 	}
-	return TaskRunResult{
+	trr := TaskRunResult{
 		ID:         taskRun.task.Base().uuid,
 		Task:       taskRun.task,
 		Result:     result,
@@ -581,6 +624,10 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		FinishedAt: finishedAt,
 		runInfo:    runInfo,
 	}
+	if sim != nil {
+		trr.inputs = taskRun.inputs
+	}
+	return trr
 }
 
 func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
@@ -633,6 +680,9 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 
 	// retain old UUID values
 	for _, taskRun := range run.PipelineTaskRuns {
+		if isForEachElementDotID(pipeline, taskRun.DotID) {
+			continue
+		}
 		task := pipeline.ByDotID(taskRun.DotID)
 		if task == nil || task.Base() == nil {
 			return false, pkgerrors.Errorf("failed to match a pipeline task for dot ID: %v", taskRun.DotID)
@@ -676,7 +726,7 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})))
+		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), nil, false)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
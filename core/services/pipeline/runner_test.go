@@ -42,7 +42,7 @@ func newRunner(t testing.TB, db *sqlx.DB, bridgeORM bridges.ORM, cfg chainlink.G
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 	orm := mocks.NewORM(t)
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
-	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, logger.TestLogger(t), c, c)
+	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, nil, logger.TestLogger(t), c, c)
 	return r, orm
 }
 
@@ -510,7 +510,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		ID: 1,
@@ -549,7 +549,7 @@ func Test_PipelineRunner_ExecuteAndInsertFinishedRun_SavingTheSpec(t *testing.T)
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		DotDagSource: `
@@ -1018,7 +1018,7 @@ func Test_PipelineRunner_ExecuteRun(t *testing.T) {
 		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 		legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 		lggr := logger.TestLogger(t)
-		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 		template := `
 succeed             [type=memo value=%d]
@@ -0,0 +1,174 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+// SimulatedTaskTypes are the types of tasks which reach out to external adapters, HTTP endpoints or chains, or sign
+// with the node's VRF keys, and are never executed in a simulation. Their results must be supplied as fixtures instead.
+var SimulatedTaskTypes = []TaskType{
+	TaskTypeHTTP,
+	TaskTypeBridge,
+	TaskTypeETHCall,
+	TaskTypeETHTx,
+	TaskTypeEstimateGasLimit,
+	TaskTypeVRF,
+	TaskTypeVRFV2,
+	TaskTypeVRFV2Plus,
+}
+
+// ErrMissingFixture is the error result of a stubbed task without a fixture.
+var ErrMissingFixture = errors.New("no simulation fixture for task")
+
+// SimulationFixture is the stubbed result of a single task.
+type SimulationFixture struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+// SimulationFixtures are stubbed task results, keyed by task DOT ID. Tasks of foreach subgraphs are keyed by the DOT
+// ID of the foreach task and their own, separated by a dot, e.g. "prices.fetch", and are stubbed for every element.
+type SimulationFixtures map[string]SimulationFixture
+
+// SimulatedTaskRun is the record of a single task executed during a simulation.
+type SimulatedTaskRun struct {
+	DotID      string
+	Type       TaskType
+	Stubbed    bool
+	Inputs     []Result
+	Result     Result
+	CreatedAt  time.Time
+	FinishedAt null.Time
+}
+
+// Duration is the time the task took to complete.
+func (tr SimulatedTaskRun) Duration() time.Duration {
+	if !tr.FinishedAt.Valid {
+		return 0
+	}
+	return tr.FinishedAt.Time.Sub(tr.CreatedAt)
+}
+
+// SimulationResult is the outcome of a simulated run. Run is never persisted.
+type SimulationResult struct {
+	Run      *Run
+	TaskRuns []SimulatedTaskRun
+}
+
+func isSimulatedTaskType(t TaskType) bool {
+	for _, st := range SimulatedTaskTypes {
+		if t == st {
+			return true
+		}
+	}
+	return false
+}
+
+// simulation substitutes fixtures for the results of tasks of SimulatedTaskTypes. A nil *simulation executes all
+// tasks normally.
+type simulation struct {
+	fixtures SimulationFixtures
+}
+
+// stub returns the fixture result of task if it must not be executed.
+func (s *simulation) stub(task Task) (Result, bool) {
+	if s == nil {
+		return Result{}, false
+	}
+	if !isSimulatedTaskType(task.Type()) {
+		return Result{}, false
+	}
+	fixture, ok := s.fixtures[task.DotID()]
+	if !ok {
+		return Result{Error: errors.Wrapf(ErrMissingFixture, "%s task %q", task.Type(), task.DotID())}, true
+	}
+	if fixture.Error != "" {
+		return Result{Error: errors.New(fixture.Error)}, true
+	}
+	return Result{Value: fixture.Value}, true
+}
+
+// subgraph returns the simulation of the subgraph of the foreach task with dotID.
+func (s *simulation) subgraph(dotID string) *simulation {
+	if s == nil {
+		return nil
+	}
+	sub := &simulation{fixtures: make(SimulationFixtures)}
+	for id, fixture := range s.fixtures {
+		if rest, found := strings.CutPrefix(id, dotID+"."); found {
+			sub.fixtures[rest] = fixture
+		}
+	}
+	return sub
+}
+
+// fixtureTask returns the task stubbed by the fixture with dotID, which may be within a foreach subgraph.
+func fixtureTask(pipeline *Pipeline, dotID string) (Task, error) {
+	ids := strings.Split(dotID, ".")
+	for i, id := range ids {
+		task := pipeline.ByDotID(id)
+		if task == nil {
+			return nil, errors.Errorf("fixture for unknown task %q", dotID)
+		}
+		if i == len(ids)-1 {
+			return task, nil
+		}
+		forEach, is := task.(*ForEachTask)
+		if !is {
+			return nil, errors.Errorf("fixture for unknown task %q, %s task %q has no subgraph", dotID, task.Type(), id)
+		}
+		pipeline = forEach.subgraph
+	}
+	return nil, errors.Errorf("fixture for unknown task %q", dotID)
+}
+
+// SimulateRun executes a new run in-memory according to spec, substituting fixtures for the results of tasks of
+// SimulatedTaskTypes, so that nothing is fetched, broadcast or persisted.
+func (r *runner) SimulateRun(ctx context.Context, spec Spec, vars Vars, fixtures SimulationFixtures) (*SimulationResult, error) {
+	sim := &simulation{fixtures: fixtures}
+	// always parse a new pipeline, since the simulation is bound to its foreach tasks
+	spec.Pipeline = nil
+	pipeline, err := r.initializePipeline(spec, sim)
+	if err != nil {
+		return nil, err
+	}
+	for dotID := range fixtures {
+		task, err := fixtureTask(pipeline, dotID)
+		if err != nil {
+			return nil, err
+		}
+		if !isSimulatedTaskType(task.Type()) {
+			return nil, errors.Errorf("fixture for %s task %q, only %v tasks can be stubbed", task.Type(), dotID, SimulatedTaskTypes)
+		}
+	}
+
+	run := NewRun(spec, vars)
+	trrs := r.run(ctx, pipeline, run, vars, sim, false)
+	if run.Pending {
+		return nil, fmt.Errorf("unexpected async run for simulation of job %q", spec.JobName)
+	}
+
+	result := &SimulationResult{Run: run}
+	for _, trr := range trrs {
+		result.TaskRuns = append(result.TaskRuns, SimulatedTaskRun{
+			DotID:      trr.Task.DotID(),
+			Type:       trr.Task.Type(),
+			Stubbed:    isSimulatedTaskType(trr.Task.Type()),
+			Inputs:     trr.inputs,
+			Result:     trr.Result,
+			CreatedAt:  trr.CreatedAt,
+			FinishedAt: trr.FinishedAt,
+		})
+	}
+	sort.SliceStable(result.TaskRuns, func(i, j int) bool {
+		return result.TaskRuns[i].CreatedAt.Before(result.TaskRuns[j].CreatedAt)
+	})
+	return result, nil
+}
@@ -0,0 +1,155 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+ds          [type=http method=GET url="https://chain.link/price"]
+ds_parse    [type=jsonparse path="data,price"]
+ds_multiply [type=multiply times=100]
+submit      [type=ethtx to="0x0000000000000000000000000000000000000001" data="0x"]
+
+ds -> ds_parse -> ds_multiply -> submit
+`}
+	vars := pipeline.NewVarsFrom(nil)
+
+	t.Run("stubs external tasks", func(t *testing.T) {
+		result, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"ds":     {Value: `{"data":{"price":1.23}}`},
+			"submit": {Value: "0xdeadbeef"},
+		})
+		require.NoError(t, err)
+		require.False(t, result.Run.HasFatalErrors())
+		require.Len(t, result.TaskRuns, 4)
+
+		byID := make(map[string]pipeline.SimulatedTaskRun)
+		for _, tr := range result.TaskRuns {
+			byID[tr.DotID] = tr
+		}
+
+		assert.True(t, byID["ds"].Stubbed)
+		assert.Empty(t, byID["ds"].Inputs)
+		assert.False(t, byID["ds_parse"].Stubbed)
+		require.Len(t, byID["ds_parse"].Inputs, 1)
+		assert.Equal(t, `{"data":{"price":1.23}}`, byID["ds_parse"].Inputs[0].Value)
+		assert.Equal(t, "123", byID["ds_multiply"].Result.Value.(decimal.Decimal).String())
+		assert.True(t, byID["submit"].Stubbed)
+		assert.Equal(t, "0xdeadbeef", byID["submit"].Result.Value)
+		require.Len(t, byID["submit"].Inputs, 1)
+		for _, tr := range result.TaskRuns {
+			assert.True(t, tr.FinishedAt.Valid)
+			assert.GreaterOrEqual(t, tr.Duration(), time.Duration(0))
+		}
+	})
+
+	t.Run("fixture errors are task errors", func(t *testing.T) {
+		result, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"ds": {Error: "connection refused"},
+		})
+		require.NoError(t, err)
+		require.True(t, result.Run.HasErrors())
+		for _, tr := range result.TaskRuns {
+			if tr.DotID == "ds" {
+				require.EqualError(t, tr.Result.Error, "connection refused")
+			}
+		}
+	})
+
+	t.Run("missing fixture", func(t *testing.T) {
+		result, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"ds": {Value: `{"data":{"price":1.23}}`},
+		})
+		require.NoError(t, err)
+		for _, tr := range result.TaskRuns {
+			if tr.DotID == "submit" {
+				require.ErrorIs(t, tr.Result.Error, pipeline.ErrMissingFixture)
+			}
+		}
+	})
+
+	t.Run("stubs gas estimation and vrf tasks", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+estimate [type=estimategaslimit to="0x0000000000000000000000000000000000000001" data="0x"]
+vrf      [type=vrfv2 publicKey="0x" requestBlockHash="0x" requestBlockNumber="1" topics="[]"]
+
+estimate -> vrf
+`}
+		result, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"estimate": {Value: 21000},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.TaskRuns, 2)
+		for _, tr := range result.TaskRuns {
+			assert.True(t, tr.Stubbed)
+			if tr.DotID == "vrf" {
+				require.ErrorIs(t, tr.Result.Error, pipeline.ErrMissingFixture)
+			}
+		}
+	})
+
+	t.Run("stubs foreach subgraph tasks", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+each [type=foreach input="$(assets)" pipeline=price]
+
+subgraph price {
+	fetch [type=http method=GET url="https://chain.link/price/$(element)"]
+	parse [type=jsonparse path="price"]
+	fetch -> parse
+}
+`}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"assets": []interface{}{"BTC", "ETH"}})
+		result, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"each.fetch": {Value: `{"price":1.23}`},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.TaskRuns, 1+2*2)
+		require.Equal(t, "each", result.TaskRuns[0].DotID)
+		require.NoError(t, result.TaskRuns[0].Result.Error)
+		assert.Equal(t, []interface{}{1.23, 1.23}, result.TaskRuns[0].Result.Value)
+
+		byID := make(map[string]pipeline.SimulatedTaskRun)
+		for _, tr := range result.TaskRuns {
+			byID[tr.DotID] = tr
+		}
+		for _, dotID := range []string{"each[0]", "each[1]"} {
+			assert.True(t, byID[dotID+".fetch"].Stubbed)
+			assert.False(t, byID[dotID+".parse"].Stubbed)
+			require.Len(t, byID[dotID+".parse"].Inputs, 1)
+			assert.Equal(t, `{"price":1.23}`, byID[dotID+".parse"].Inputs[0].Value)
+		}
+
+		_, err = r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"each.missing": {Value: "1"},
+		})
+		require.ErrorContains(t, err, `fixture for unknown task "each.missing"`)
+	})
+
+	t.Run("invalid fixtures", func(t *testing.T) {
+		_, err := r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"unknown": {Value: "1"},
+		})
+		require.ErrorContains(t, err, `fixture for unknown task "unknown"`)
+
+		_, err = r.SimulateRun(testutils.Context(t), spec, vars, pipeline.SimulationFixtures{
+			"ds_parse": {Value: "1"},
+		})
+		require.ErrorContains(t, err, `fixture for jsonparse task "ds_parse"`)
+	})
+}
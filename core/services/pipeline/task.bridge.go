@@ -69,6 +69,7 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+	health       *bridges.HealthMonitor
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -112,9 +113,9 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.orm.FindBridge(overtimeCtx, bridges.BridgeName(name))
 	if err != nil {
-		return Result{Error: err}, runInfo
+		return Result{Error: errors.Wrapf(err, "could not find bridge with name '%s'", name)}, runInfo
 	}
 
 	var metaMap MapParam
@@ -156,12 +157,9 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 	lggr.Tracew("Bridge task: sending request",
 		"requestData", string(requestDataJSON),
-		"url", url.String(),
+		"url", bt.URL.String(),
 	)
 
-	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
-	defer cancel()
-
 	// cacheTTL should not exceed stalenessCap.
 	cacheDuration := time.Duration(cacheTTL) * time.Second
 	if cacheDuration > stalenessCap {
@@ -170,12 +168,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
-
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
-	}
+	url, responseBytes, statusCode, headers, elapsed, err := t.send(ctx, lggr, bt, reqHeaders, requestData)
 
 	if err != nil || statusCode != http.StatusOK {
 		if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
@@ -248,12 +241,58 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
-	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
-	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+// send posts the request to the URL of the bridge, then to each of its fallback URLs until an adapter answers without
+// a server error. URLs whose circuit breaker is open are skipped. Client errors are returned as is, since another
+// instance of the same adapter would reject the request too.
+func (t *BridgeTask) send(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, reqHeaders []string, requestData MapParam) (
+	url URLParam, responseBytes []byte, statusCode int, headers http.Header, elapsed time.Duration, err error,
+) {
+	url = URLParam(bt.URL)
+	var attempted bool
+	for _, u := range bt.URLs() {
+		if t.health != nil && !t.health.Allow(bt.Name, u) {
+			lggr.Debugw("Bridge task: circuit breaker open, skipping URL", "url", u.String())
+			continue
+		}
+		if attempted {
+			lggr.Debugw("Bridge task: request failed, trying fallback URL", "url", u.String(), "err", err)
+		}
+		attempted = true
+
+		url = URLParam(u)
+		requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
+		start := time.Now()
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit(), nil)
+		latency := time.Since(start)
+		cancel()
+
+		if ctx.Err() != nil {
+			// the run itself was interrupted, which says nothing about the health of the bridge
+			return
+		}
+		// Only transport errors, timeouts and server errors count against the URL. The status reported in the body of
+		// the response is about the data provider behind the adapter, which its other instances share.
+		answered := statusCode != 0 && statusCode < http.StatusInternalServerError
+		if t.health != nil {
+			if answered {
+				t.health.RecordSuccess(bt.Name, u, latency)
+			} else {
+				t.health.RecordFailure(bt.Name, u, err)
+			}
+		}
+
+		// check for external adapter response object status
+		if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
+			statusCode = code
+		}
+		if answered {
+			return
+		}
+	}
+	if !attempted {
+		err = errors.Errorf("bridge %s is unavailable: circuit breaker is open for all of its URLs", bt.Name)
 	}
-	return URLParam(bt.URL), nil
+	return
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -19,12 +19,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -1280,3 +1282,111 @@ ds [type=bridge name="adapter-error-bridge" timeout="50ms" requestData="{\"data\
 	require.ErrorContains(t, finalResult.Result.Error, "AdapterLWBAError: bid ask violation detected")
 	require.Nil(t, finalResult.Result.Value)
 }
+
+func TestBridgeTask_FallbackURLs(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.WebServer.BridgeCacheTTL = commonconfig.MustNewDuration(0)
+		c.JobPipeline.BridgeHealth.FailureThreshold = ptr[uint32](2)
+		c.JobPipeline.BridgeHealth.Cooldown = commonconfig.MustNewDuration(time.Hour)
+	})
+
+	newServer := func(status int, hits *atomic.Int32) *httptest.Server {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			w.WriteHeader(status)
+			_, err := w.Write([]byte(fmt.Sprintf(`{"data":{"result":%d}}`, status)))
+			assert.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+		return s
+	}
+	newBridge := func(servers ...*httptest.Server) bridges.BridgeType {
+		bt := bridges.BridgeType{Name: "adapter", URL: models.WebURL(*testutils.MustParseURL(t, servers[0].URL))}
+		for _, s := range servers[1:] {
+			bt.FallbackURLs = append(bt.FallbackURLs, models.WebURL(*testutils.MustParseURL(t, s.URL)))
+		}
+		return bt
+	}
+	newTask := func(bt bridges.BridgeType) (*pipeline.BridgeTask, *bridges.HealthMonitor) {
+		orm := bridgesMocks.NewORM(t)
+		orm.On("FindBridge", mock.Anything, bt.Name).Return(bt, nil)
+		health := bridges.NewHealthMonitor(orm, cfg.JobPipeline().BridgeHealth(), http.DefaultClient, logger.TestLogger(t))
+		task := &pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bt.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		task.HelperSetHealth(health)
+		return task, health
+	}
+	run := func(task *pipeline.BridgeTask) pipeline.Result {
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("falls back on server errors and skips open breakers", func(t *testing.T) {
+		var primaryHits, fallbackHits atomic.Int32
+		bt := newBridge(newServer(http.StatusBadGateway, &primaryHits), newServer(http.StatusOK, &fallbackHits))
+		task, health := newTask(bt)
+
+		for i := 0; i < 3; i++ {
+			result := run(task)
+			require.NoError(t, result.Error)
+			assert.Equal(t, `{"data":{"result":200}}`, result.Value)
+		}
+		assert.Equal(t, int32(2), primaryHits.Load(), "the primary URL is skipped once its breaker opens")
+		assert.Equal(t, int32(3), fallbackHits.Load())
+
+		status := health.Status(bt)
+		require.Len(t, status, 2)
+		assert.Equal(t, bridges.BreakerOpen, status[0].State)
+		assert.Equal(t, bridges.BreakerClosed, status[1].State)
+		assert.Equal(t, 3, status[1].Latency.Samples)
+	})
+
+	t.Run("fails fast when all breakers are open", func(t *testing.T) {
+		var hits atomic.Int32
+		bt := newBridge(newServer(http.StatusInternalServerError, &hits))
+		task, _ := newTask(bt)
+
+		require.Error(t, run(task).Error)
+		require.Error(t, run(task).Error)
+		result := run(task)
+		require.ErrorContains(t, result.Error, "bridge adapter is unavailable: circuit breaker is open for all of its URLs")
+		assert.Equal(t, int32(2), hits.Load())
+	})
+
+	t.Run("does not fall back on client errors", func(t *testing.T) {
+		var primaryHits, fallbackHits atomic.Int32
+		bt := newBridge(newServer(http.StatusBadRequest, &primaryHits), newServer(http.StatusOK, &fallbackHits))
+		task, health := newTask(bt)
+
+		require.Error(t, run(task).Error)
+		assert.Equal(t, int32(1), primaryHits.Load())
+		assert.Zero(t, fallbackHits.Load())
+		assert.Equal(t, bridges.BreakerClosed, health.Status(bt)[0].State)
+	})
+	t.Run("ignores the status reported by the adapter", func(t *testing.T) {
+		var primaryHits, fallbackHits atomic.Int32
+		primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			primaryHits.Add(1)
+			_, err := w.Write([]byte(`{"status":"errored","statusCode":500,"providerStatusCode":503,"error":{"name":"AdapterError","message":"provider is down"}}`))
+			assert.NoError(t, err)
+		}))
+		t.Cleanup(primary.Close)
+		bt := newBridge(primary, newServer(http.StatusOK, &fallbackHits))
+		task, health := newTask(bt)
+
+		for i := 0; i < 3; i++ {
+			require.ErrorContains(t, run(task).Error, "provider is down")
+		}
+		assert.Equal(t, int32(3), primaryHits.Load())
+		assert.Zero(t, fallbackHits.Load(), "other instances of the adapter share its provider")
+		status := health.Status(bt)
+		assert.Equal(t, bridges.BreakerClosed, status[0].State)
+		assert.Zero(t, status[0].ConsecutiveFailures)
+	})
+}
@@ -0,0 +1,89 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var ErrDeviationTooHigh = errors.New("values deviate too much")
+
+// DeviationTask fails if the values disagree by more than maxDeviation percent, measured as the spread between the
+// highest and lowest values relative to their median, e.g. maxDeviation=1.5 fails for values of 100 and 102 (a spread
+// of 2 around a median of 101 is 1.98%). Otherwise, it returns the values in their original order, e.g. for
+// values="$(deviation)" of a median task.
+//
+// Return types:
+//
+//	[]interface{} (of decimal.Decimal)
+type DeviationTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	MaxDeviation  string `json:"maxDeviation"`
+}
+
+var _ Task = (*DeviationTask)(nil)
+
+func (t *DeviationTask) Type() TaskType {
+	return TaskTypeDeviation
+}
+
+func (t *DeviationTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maxDeviation       DecimalParam
+		valuesAndErrs      SliceParam
+		decimalValues      DecimalSliceParam
+		allowedFaults      int
+		faults             int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maxDeviation, From(VarExpr(t.MaxDeviation, vars), NonemptyString(t.MaxDeviation))), "maxDeviation"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if maxDeviation.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "maxDeviation must not be negative, got %v", maxDeviation.Decimal())}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	values, faults := valuesAndErrs.FilterErrors()
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to deviation task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
+	}
+
+	err = decimalValues.UnmarshalPipelineParam(values)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+	}
+
+	median := medianOf(decimalValues)
+	lowest, highest := decimalValues[0], decimalValues[0]
+	out := make([]interface{}, len(decimalValues))
+	for i, v := range decimalValues {
+		lowest, highest = decimal.Min(lowest, v), decimal.Max(highest, v)
+		out[i] = v
+	}
+	// highest - lowest > |median| * maxDeviation / 100, which also holds for any spread if the median is 0
+	limit := median.Abs().Mul(maxDeviation.Decimal()).Div(decimal.NewFromInt(100))
+	if spread := highest.Sub(lowest); spread.GreaterThan(limit) {
+		return Result{Error: errors.Wrapf(ErrDeviationTooHigh, "values between %v and %v deviate around median %v by more than %v%%", lowest, highest, median, maxDeviation.Decimal())}, runInfo
+	}
+	return Result{Value: out}, runInfo
+}
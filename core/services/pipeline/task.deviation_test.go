@@ -0,0 +1,110 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestDeviationTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		maxDeviation  string
+		allowedFaults string
+		wantErr       error
+	}{
+		{
+			"within max deviation",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "101")}, {Value: mustDecimal(t, "99")}},
+			"2",
+			"",
+			nil,
+		},
+		{
+			"spread within max deviation",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "101")}},
+			"1.5",
+			"",
+			nil,
+		},
+		{
+			"spread exceeds max deviation",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "102")}},
+			"1.5",
+			"",
+			pipeline.ErrDeviationTooHigh,
+		},
+		{
+			"exceeds max deviation",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "102")}, {Value: mustDecimal(t, "99")}},
+			"1.5",
+			"",
+			pipeline.ErrDeviationTooHigh,
+		},
+		{
+			"zero median",
+			[]pipeline.Result{{Value: mustDecimal(t, "0")}, {Value: mustDecimal(t, "0")}, {Value: mustDecimal(t, "0.1")}},
+			"50",
+			"",
+			pipeline.ErrDeviationTooHigh,
+		},
+		{
+			"faults are ignored",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Error: errors.New("bridge timed out")}, {Value: mustDecimal(t, "100.5")}},
+			"1",
+			"1",
+			nil,
+		},
+		{
+			"too many faults",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Error: errors.New("bridge timed out")}, {Error: errors.New("bridge timed out")}},
+			"1",
+			"1",
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"missing max deviation",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}},
+			"",
+			"",
+			pipeline.ErrParameterEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.DeviationTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				MaxDeviation:  test.maxDeviation,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.ErrorIs(t, output.Error, test.wantErr)
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			var want []interface{}
+			for _, input := range test.inputs {
+				if input.Error == nil {
+					want = append(want, *input.Value.(*decimal.Decimal))
+				}
+			}
+			require.Equal(t, want, output.Value)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-viper/mapstructure/v2"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
@@ -17,6 +18,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -39,6 +41,12 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Priority is the priority class of the transaction among the unstarted
+	// transactions of its sender, one of "low", "normal" or "high"
+	Priority string `json:"priority"`
+	// SimulateTransaction, if set, simulates the transaction against the pending block right before it is
+	// broadcast, after any transmitChecker passes, and fatally errors it if it would revert.
+	SimulateTransaction string `json:"simulateTransaction"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
@@ -95,6 +103,8 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		priorityName          StringParam
+		simulateTransaction   BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -105,6 +115,8 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&priorityName, From(VarExpr(t.Priority, vars), NonemptyString(t.Priority), txmgrtypes.TxPriorityNormal.String())), "priority"),
+		errors.Wrap(ResolveParam(&simulateTransaction, From(VarExpr(t.SimulateTransaction, vars), NonemptyString(t.SimulateTransaction), false)), "simulateTransaction"),
 	)
 	if err != nil {
 		return Result{Error: err}, RunInfo{}
@@ -122,6 +134,17 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	if err != nil {
 		return Result{Error: err}, RunInfo{}
 	}
+	if simulateTransaction {
+		transmitChecker.Simulate = true
+		if err = txmgr.ValidateCheckerSpec(transmitChecker); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "simulateTransaction: %v", err)}, RunInfo{}
+		}
+	}
+
+	priority, err := txmgrtypes.ParseTxPriority(string(priorityName))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "priority: %v", err)}, RunInfo{}
+	}
 
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
 	if err != nil {
@@ -132,6 +155,9 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 
 	// TODO(sc-55115): Allow job specs to pass in the strategy that they want
 	strategy := txmgrcommon.NewSendEveryStrategy()
+	if priority != txmgrtypes.TxPriorityNormal {
+		strategy = txmgrcommon.NewPriorityStrategy(uuid.Nil, priority, 0)
+	}
 
 	var forwarderAddress common.Address
 	if t.forwardingAllowed {
@@ -603,4 +603,78 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_SimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	coordinator := common.HexToAddress("0x2E396ecbc8223Ebc16EC45136228AE5EDB649943")
+
+	for _, test := range []struct {
+		name            string
+		simulate        string
+		transmitChecker string
+		expected        txmgr.TransmitCheckerSpec
+	}{
+		{"not set", "", "", txmgr.TransmitCheckerSpec{}},
+		{"disabled", "false", "", txmgr.TransmitCheckerSpec{}},
+		{"enabled", "true", "", txmgr.TransmitCheckerSpec{Simulate: true}},
+		{"combined with transmit checker", "true", `{"CheckerType": "vrf_v2", "VRFCoordinatorAddress": "0x2E396ecbc8223Ebc16EC45136228AE5EDB649943"}`,
+			txmgr.TransmitCheckerSpec{CheckerType: txmgr.TransmitCheckerTypeVRFV2, VRFCoordinatorAddress: &coordinator, Simulate: true}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHTxTask{
+				BaseTask:            pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:                from.Hex(),
+				To:                  "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+				Data:                "foobar",
+				MinConfirmations:    "0",
+				EVMChainID:          "0",
+				TransmitChecker:     test.transmitChecker,
+				SimulateTransaction: test.simulate,
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewGeneralConfig(t, nil)
+			legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+				TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+			txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgr.TxRequest) bool {
+				return assert.Equal(t, test.expected, req.Checker)
+			})).Return(txmgr.Tx{}, nil)
+			task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+		})
+	}
+
+	t.Run("conflicting transmit checker", func(t *testing.T) {
+		task := pipeline.ETHTxTask{
+			BaseTask:            pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:                from.Hex(),
+			To:                  "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:                "foobar",
+			MinConfirmations:    "0",
+			EVMChainID:          "0",
+			TransmitChecker:     `{"CheckerType": "simulate"}`,
+			SimulateTransaction: "true",
+		}
+
+		keyStore := keystoremocks.NewEth(t)
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewGeneralConfig(t, nil)
+		legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+			TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		require.ErrorContains(t, result.Error, "Simulate cannot be combined with checker type simulate")
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
@@ -0,0 +1,236 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// ForEachElementKey is the variable holding the current element within a foreach subgraph.
+	ForEachElementKey = "element"
+	// ForEachIndexKey is the variable holding the index of the current element within a foreach subgraph.
+	ForEachIndexKey = "index"
+
+	// DefaultForEachMaxConcurrency is the number of elements executed at once when maxConcurrency is not set.
+	DefaultForEachMaxConcurrency = 10
+)
+
+// ForEachElementError is the result of an element whose subgraph failed.
+type ForEachElementError struct {
+	Index int
+	Err   error
+}
+
+func (e ForEachElementError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+func (e ForEachElementError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON keeps the error message of failed elements in the persisted task run output.
+func (e ForEachElementError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"error": e.Error()})
+}
+
+// ForEachTask executes the named subgraph once per element of its input array, and collects the output of each
+// execution into an array, in input order. Within the subgraph, $(element) and $(index) are the current element and
+// its index, alongside the variables of the enclosing pipeline.
+//
+//	assets [type=jsonparse path="data,assets"]
+//	prices [type=foreach pipeline=price maxConcurrency=4]
+//	median [type=median values="$(prices)"]
+//	assets -> prices -> median
+//
+//	subgraph price {
+//	    fetch [type=http method=GET url="https://example.com/price/$(element.symbol)"]
+//	    parse [type=jsonparse path="price"]
+//	    fetch -> parse
+//	}
+//
+// Elements whose subgraph fails are returned as ForEachElementError values, which aggregation tasks count as faults.
+// The task itself fails once more than allowedFaults elements failed.
+//
+// Return types:
+//
+//	[]interface{}
+type ForEachTask struct {
+	BaseTask       `mapstructure:",squash"`
+	Input          string `json:"input"`
+	Pipeline       string `json:"pipeline"`
+	MaxConcurrency string `json:"maxConcurrency"`
+	AllowedFaults  string `json:"allowedFaults"`
+
+	subgraph    *Pipeline
+	runSubgraph func(ctx context.Context, vars Vars) (*Run, TaskRunResults)
+}
+
+var _ Task = (*ForEachTask)(nil)
+
+func (t *ForEachTask) Type() TaskType {
+	return TaskTypeForEach
+}
+
+// Subgraph is the parsed pipeline executed for every element.
+func (t *ForEachTask) Subgraph() *Pipeline {
+	return t.subgraph
+}
+
+// setSubgraph parses the subgraph source referenced by the task's pipeline param.
+func (t *ForEachTask) setSubgraph(source string) error {
+	subgraph, err := Parse(source)
+	if err != nil {
+		return err
+	}
+	var terminals int
+	for _, task := range subgraph.Tasks {
+		if task.DotID() == ForEachElementKey || task.DotID() == ForEachIndexKey {
+			return errors.Errorf("'%v' is a reserved keyword that cannot be used as a task's name in a subgraph", task.DotID())
+		}
+		if len(task.Outputs()) == 0 {
+			terminals++
+		}
+	}
+	if terminals != 1 {
+		return errors.Errorf("must have exactly one terminal task, got %d", terminals)
+	}
+	if subgraph.RequiresPreInsert() {
+		return errors.New("cannot contain ethtx tasks or async bridge tasks")
+	}
+	t.subgraph = subgraph
+	return nil
+}
+
+func (t *ForEachTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		elements            SliceParam
+		maybeMaxConcurrency MaybeUint64Param
+		maybeAllowedFaults  MaybeUint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&elements, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybeMaxConcurrency, From(t.MaxConcurrency)), "maxConcurrency"),
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	maxConcurrency := DefaultForEachMaxConcurrency
+	if n, isSet := maybeMaxConcurrency.Uint64(); isSet {
+		if n == 0 {
+			return Result{Error: errors.Wrap(ErrBadInput, "maxConcurrency must be greater than 0")}, runInfo
+		}
+		maxConcurrency = int(n)
+	}
+
+	results := make([]interface{}, len(elements))
+	elementRuns := make([]TaskRunResults, len(elements))
+	var eg errgroup.Group
+	eg.SetLimit(maxConcurrency)
+	for i, element := range elements {
+		eg.Go(func() error {
+			results[i], elementRuns[i] = t.runElement(ctx, vars, i, element)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	for _, trrs := range elementRuns {
+		runInfo.elementRuns = append(runInfo.elementRuns, trrs...)
+	}
+
+	allowedFaults := len(elements) - 1
+	if n, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(n)
+	}
+	var faults error
+	var nFaults int
+	for _, r := range results {
+		if err, is := r.(error); is {
+			faults = multierr.Append(faults, err)
+			nFaults++
+		}
+	}
+	if nFaults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "number of failed elements %v > number allowed faults %v: %v", nFaults, allowedFaults, faults)}, runInfo
+	}
+
+	return Result{Value: results}, runInfo
+}
+
+// runElement executes the subgraph for a single element, and returns its output or a ForEachElementError, and the
+// task runs of the subgraph as task runs of the element.
+func (t *ForEachTask) runElement(ctx context.Context, vars Vars, index int, element interface{}) (interface{}, TaskRunResults) {
+	if t.runSubgraph == nil {
+		return ForEachElementError{Index: index, Err: errors.New("foreach task was not initialized")}, nil
+	}
+
+	vars = vars.Copy()
+	vars.vars[ForEachElementKey] = element
+	vars.vars[ForEachIndexKey] = index
+
+	run, trrs := t.runSubgraph(ctx, vars)
+	terminals := trrs.Terminals()
+	elementRuns := make(TaskRunResults, len(trrs))
+	for i, trr := range trrs {
+		trr.ID = uuid.New()
+		trr.Task = &forEachElementTask{Task: trr.Task, forEach: t, dotID: forEachElementDotID(t.DotID(), index, trr.Task.DotID())}
+		elementRuns[i] = trr
+	}
+
+	if run.Pending {
+		return ForEachElementError{Index: index, Err: errors.New("unexpected async run")}, elementRuns
+	}
+	if len(terminals) != 1 {
+		return ForEachElementError{Index: index, Err: errors.Errorf("expected one terminal task result, got %d", len(terminals))}, elementRuns
+	}
+	if terminals[0].Result.Error != nil {
+		return ForEachElementError{Index: index, Err: terminals[0].Result.Error}, elementRuns
+	}
+	return terminals[0].Result.Value, elementRuns
+}
+
+// forEachElementTask is a task of a foreach subgraph, as run for a single element. Its task runs are recorded in the
+// enclosing run under an element-indexed DOT ID, e.g. "prices[0].fetch", as inputs of the foreach task.
+type forEachElementTask struct {
+	Task
+	forEach *ForEachTask
+	dotID   string
+}
+
+func (t *forEachElementTask) DotID() string {
+	return t.dotID
+}
+
+func (t *forEachElementTask) Outputs() []Task {
+	return []Task{t.forEach}
+}
+
+func forEachElementDotID(forEachDotID string, index int, dotID string) string {
+	return fmt.Sprintf("%s[%d].%s", forEachDotID, index, dotID)
+}
+
+// isForEachElementDotID returns true if dotID is the DOT ID of the task run of a foreach element in pipeline.
+func isForEachElementDotID(pipeline *Pipeline, dotID string) bool {
+	forEachDotID, _, found := strings.Cut(dotID, "[")
+	if !found {
+		return false
+	}
+	_, is := pipeline.ByDotID(forEachDotID).(*ForEachTask)
+	return is
+}
@@ -0,0 +1,237 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestForEachTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		p, err := pipeline.Parse(`
+base   [type=memo value=10]
+each   [type=foreach input="$(assets)" pipeline=scale]
+median [type=median values="$(each)"]
+
+each -> median
+
+subgraph scale {
+	mult [type=multiply input="$(element)" times="$(base)"]
+	add  [type=sum values=<[ $(mult), $(index) ]>]
+	mult -> add
+}
+`)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 3)
+
+		each := p.ByDotID("each").(*pipeline.ForEachTask)
+		require.Len(t, each.Subgraph().Tasks, 2)
+		assert.NotNil(t, each.Subgraph().ByDotID("mult"))
+		assert.Nil(t, p.ByDotID("mult"), "subgraph tasks are not part of the enclosing pipeline")
+
+		// the subgraph's dependency on base is an implicit dependency of the foreach task
+		require.Len(t, each.Inputs(), 1)
+		assert.Equal(t, "base", each.Inputs()[0].InputTask.DotID())
+	})
+
+	t.Run("quoted attributes", func(t *testing.T) {
+		p, err := pipeline.Parse(`
+each [type="foreach" input="$(assets)" pipeline="scale"]
+subgraph scale { mult [type=multiply input="$(element)" times=2] }
+`)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 1)
+		require.Len(t, p.ByDotID("each").(*pipeline.ForEachTask).Subgraph().Tasks, 1)
+	})
+
+	t.Run("subgraphs not referenced by a foreach task are merged", func(t *testing.T) {
+		p, err := pipeline.Parse(`
+memo [type=memo value=1]
+subgraph grouped {
+	a [type=memo value=1]
+	b [type=memo value=2]
+}
+memo -> a
+`)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 3)
+		assert.NotNil(t, p.ByDotID("a"))
+		assert.NotNil(t, p.ByDotID("b"))
+	})
+
+	for _, tt := range []struct {
+		name     string
+		pipeline string
+		err      string
+	}{
+		{
+			"unknown subgraph",
+			`each [type=foreach pipeline=missing]`,
+			"foreach task 'each' references unknown subgraph 'missing'",
+		},
+		{
+			"multiple terminal tasks",
+			`each [type=foreach pipeline=sub]
+			subgraph sub { a [type=memo value=1]; b [type=memo value=2] }`,
+			"must have exactly one terminal task, got 2",
+		},
+		{
+			"reserved task name",
+			`each [type=foreach pipeline=sub]
+			subgraph sub { element [type=memo value=1] }`,
+			"'element' is a reserved keyword",
+		},
+		{
+			"ethtx task",
+			`each [type=foreach pipeline=sub]
+			subgraph sub { tx [type=ethtx] }`,
+			"cannot contain ethtx tasks or async bridge tasks",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pipeline.Parse(tt.pipeline)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestForEachTask_Run(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	specWithAllowedFaults := func(allowedFaults int) pipeline.Spec {
+		return pipeline.Spec{DotDagSource: fmt.Sprintf(`
+base   [type=memo value=10]
+each   [type=foreach input="$(assets)" pipeline=scale maxConcurrency=2 allowedFaults=%d]
+median [type=median values="$(each)" allowedFaults=1]
+
+each -> median
+
+subgraph scale {
+	mult [type=multiply input="$(element.value)" times="$(base)"]
+	add  [type=sum values=<[ $(mult), $(index) ]> allowedFaults=0]
+	mult -> add
+}
+`, allowedFaults)}
+	}
+
+	t.Run("collects results in input order", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"assets": []interface{}{
+				map[string]interface{}{"value": 1},
+				map[string]interface{}{"value": 2},
+				map[string]interface{}{"value": 3},
+			},
+		})
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), specWithAllowedFaults(0), vars)
+		require.NoError(t, err)
+
+		each := taskRunResult(t, trrs, "each")
+		require.NoError(t, each.Result.Error)
+		require.Equal(t, []interface{}{
+			decimal.NewFromInt(10),
+			decimal.NewFromInt(21),
+			decimal.NewFromInt(32),
+		}, each.Result.Value)
+
+		median := taskRunResult(t, trrs, "median")
+		require.NoError(t, median.Result.Error)
+		assert.Equal(t, "21", median.Result.Value.(decimal.Decimal).String())
+
+		// the subgraph task runs of every element are part of the run, but none of its outputs
+		add := taskRunResult(t, trrs, "each[1].add")
+		assert.Equal(t, "21", add.Result.Value.(decimal.Decimal).String())
+		require.Len(t, trrs, 3+3*2)
+		require.Len(t, run.PipelineTaskRuns, 3+3*2)
+		ids := make(map[uuid.UUID]struct{})
+		for _, tr := range run.PipelineTaskRuns {
+			ids[tr.ID] = struct{}{}
+		}
+		assert.Len(t, ids, 3+3*2)
+		assert.NotNil(t, run.ByDotID("each[2].mult"))
+		assert.Len(t, run.Outputs.Val, 1)
+		assert.Len(t, trrs.FinalResult().Values, 1)
+	})
+
+	t.Run("surfaces element errors", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"assets": []interface{}{
+				map[string]interface{}{"value": 1},
+				map[string]interface{}{"value": "foo"},
+				map[string]interface{}{"value": 3},
+			},
+		})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), specWithAllowedFaults(1), vars)
+		require.NoError(t, err)
+
+		each := taskRunResult(t, trrs, "each")
+		require.NoError(t, each.Result.Error)
+		values := each.Result.Value.([]interface{})
+		require.Len(t, values, 3)
+		var elementErr pipeline.ForEachElementError
+		require.ErrorAs(t, values[1].(error), &elementErr)
+		assert.Equal(t, 1, elementErr.Index)
+
+		b, err := json.Marshal(each.Result.OutputDB())
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `{"error":"element 1: `)
+
+		// median tolerates the failed element
+		median := taskRunResult(t, trrs, "median")
+		require.NoError(t, median.Result.Error)
+		assert.Equal(t, "21", median.Result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("too many failed elements", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"assets": []interface{}{
+				map[string]interface{}{"value": 1},
+				map[string]interface{}{"value": "foo"},
+			},
+		})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), specWithAllowedFaults(0), vars)
+		require.NoError(t, err)
+
+		each := taskRunResult(t, trrs, "each")
+		require.ErrorIs(t, each.Result.Error, pipeline.ErrTooManyErrors)
+		assert.ErrorContains(t, each.Result.Error, "element 1:")
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"assets": []interface{}{},
+		})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), specWithAllowedFaults(0), vars)
+		require.NoError(t, err)
+
+		each := taskRunResult(t, trrs, "each")
+		require.NoError(t, each.Result.Error)
+		assert.Equal(t, []interface{}{}, each.Result.Value)
+	})
+}
+
+func taskRunResult(t *testing.T, trrs pipeline.TaskRunResults, dotID string) pipeline.TaskRunResult {
+	t.Helper()
+	for _, trr := range trrs {
+		if trr.Task.DotID() == dotID {
+			return trr
+		}
+	}
+	t.Fatalf("no result for task %q", dotID)
+	return pipeline.TaskRunResult{}
+}
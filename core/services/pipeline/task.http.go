@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -11,9 +12,14 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
+// HTTPTask authenticates with the named credential given in credentialName, if any, which is stored encrypted in the
+// node's keystore. It must be a literal name, so that requesters cannot choose credentials, and tasks with variable
+// URLs may only use credentials which restrict their allowed hosts.
+//
 // Return types:
 //
 //	string
@@ -24,10 +30,12 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	CredentialName                 string `json:"credentialName"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	auth                   *httpAuthenticator
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -92,6 +100,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		"method", method,
 		"reqHeaders", reqHeaders,
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
+		"credentialName", t.CredentialName,
 	)
 
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
@@ -103,7 +112,31 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	var cred *httpcredential.Credential
+	var authenticate func(*http.Request, []byte) error
+	if t.CredentialName != "" {
+		c, err2 := t.credential(url)
+		if err2 != nil {
+			return Result{Error: err2}, runInfo
+		}
+		cred = &c
+		if client, err2 = t.auth.client(c, client); err2 != nil {
+			return Result{Error: err2}, runInfo
+		}
+		// the token URL comes from the credential, so is as safe as the node's own configuration
+		tokenClient, err2 := t.auth.client(c, t.unrestrictedHTTPClient)
+		if err2 != nil {
+			return Result{Error: err2}, runInfo
+		}
+		authenticate = t.auth.authenticator(c, tokenClient)
+	}
+	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit(), authenticate)
+	if statusCode == http.StatusUnauthorized && cred != nil && cred.OAuth2 != nil {
+		// the access token may have been revoked before its expiry, so retry once with a new one
+		lggr.Debugw("HTTP task: access token rejected, retrying with a new one", "credentialName", cred.Name, "dotID", t.DotID())
+		t.auth.invalidateToken(*cred)
+		responseBytes, statusCode, respHeaders, elapsed, err = makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit(), authenticate)
+	}
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
@@ -127,3 +160,19 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	// value instead.
 	return Result{Value: string(responseBytes)}, runInfo
 }
+
+// credential returns the credential named by the task, if it may be sent to target.
+func (t *HTTPTask) credential(target URLParam) (httpcredential.Credential, error) {
+	cred, err := t.auth.credential(t.CredentialName)
+	if err != nil {
+		return httpcredential.Credential{}, errors.Wrap(err, "credentialName")
+	}
+	if len(cred.AllowedHosts) == 0 {
+		return httpcredential.Credential{}, errors.Wrapf(ErrBadInput, "credential %s must restrict allowedHosts", cred.Name)
+	}
+	u := url.URL(target)
+	if !cred.IsAllowedURL(&u) {
+		return httpcredential.Credential{}, errors.Wrapf(ErrBadInput, "credential %s is not allowed for host %s", cred.Name, u.Host)
+	}
+	return cred, nil
+}
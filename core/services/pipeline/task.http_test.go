@@ -1,11 +1,19 @@
 package pipeline_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,6 +32,7 @@ import (
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -412,3 +421,160 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+type testHTTPCredentials map[string]httpcredential.Credential
+
+func (s testHTTPCredentials) Get(name string) (httpcredential.Credential, error) {
+	cred, ok := s[name]
+	if !ok {
+		return httpcredential.Credential{}, errors.Errorf("unable to find HTTP credential key with id %s", name)
+	}
+	return cred, nil
+}
+
+func TestHTTPTask_Credentials(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	newTask := func(url string, creds testHTTPCredentials) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask:       pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:         "POST",
+			URL:            url,
+			RequestData:    ethUSDPairing,
+			CredentialName: "vendor",
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		task.HelperSetCredentials(creds)
+		return task
+	}
+
+	t.Run("sends headers and signs requests", func(t *testing.T) {
+		hmacCfg := &httpcredential.HMAC{Secret: "hmac-secret", KeyID: "key-1"}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "api-secret", r.Header.Get("X-Api-Key"))
+			assert.Equal(t, "key-1", r.Header.Get("X-Key-ID"))
+
+			mac := hmac.New(sha256.New, []byte("hmac-secret"))
+			mac.Write([]byte(hmacCfg.SigningString(r, body, r.Header.Get("X-Timestamp"))))
+			assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), r.Header.Get("X-Signature"))
+			_, err = w.Write([]byte(`{"result": 1}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		task := newTask(server.URL+"/prices?quote=USD&base=ETH", testHTTPCredentials{"vendor": {
+			Name:         "vendor",
+			AllowedHosts: []string{"127.0.0.1"},
+			Headers:      map[string]string{"X-Api-Key": "api-secret"},
+			HMAC:         hmacCfg,
+		}})
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"result": 1}`, result.Value)
+	})
+
+	t.Run("caches OAuth2 access tokens and refetches rejected ones", func(t *testing.T) {
+		var tokenRequests atomic.Int32
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := tokenRequests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(fmt.Sprintf(`{"access_token":"tok-%d","expires_in":3600}`, n)))
+			require.NoError(t, err)
+		}))
+		defer tokenServer.Close()
+		var revoked atomic.Value
+		revoked.Store("")
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth := r.Header.Get("Authorization")
+			if auth == "" || auth == "Bearer "+revoked.Load().(string) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, err := w.Write([]byte(auth))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		task := newTask(server.URL, testHTTPCredentials{"vendor": {
+			Name:         "vendor",
+			AllowedHosts: []string{"127.0.0.1"},
+			OAuth2:       &httpcredential.OAuth2{TokenURL: tokenServer.URL, ClientID: "id", ClientSecret: "secret"},
+		}})
+		for i := 0; i < 2; i++ {
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+			assert.Equal(t, "Bearer tok-1", result.Value)
+		}
+		assert.Equal(t, int32(1), tokenRequests.Load())
+
+		revoked.Store("tok-1")
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "Bearer tok-2", result.Value)
+		assert.Equal(t, int32(2), tokenRequests.Load())
+	})
+
+	t.Run("presents TLS client certificate", func(t *testing.T) {
+		clientTLS := httpcredential.MustNewTLSXXXTestingOnly("node")
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Len(t, r.TLS.PeerCertificates, 1)
+			_, err := w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+			require.NoError(t, err)
+		}))
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+		server.StartTLS()
+		defer server.Close()
+		clientTLS.RootCAsPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+		task := newTask(server.URL, testHTTPCredentials{"vendor": {Name: "vendor", AllowedHosts: []string{"127.0.0.1"}, TLS: clientTLS}})
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "node", result.Value)
+
+		task = newTask(server.URL, testHTTPCredentials{"vendor": {Name: "vendor", AllowedHosts: []string{"127.0.0.1"}, Headers: map[string]string{"X-Api-Key": "secret"}}})
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+	})
+
+	t.Run("does not follow redirects to other hosts", func(t *testing.T) {
+		other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("credentials were sent to another host")
+		}))
+		defer other.Close()
+		server := httptest.NewServer(http.RedirectHandler(other.URL, http.StatusFound))
+		defer server.Close()
+
+		task := newTask(server.URL, testHTTPCredentials{"vendor": {Name: "vendor", AllowedHosts: []string{"127.0.0.1"}, Headers: map[string]string{"X-Api-Key": "secret"}}})
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "refusing to follow redirect")
+	})
+
+	t.Run("restricts hosts", func(t *testing.T) {
+		creds := testHTTPCredentials{
+			"vendor":     {Name: "vendor", Headers: map[string]string{"X-Api-Key": "secret"}},
+			"restricted": {Name: "restricted", AllowedHosts: []string{"api.vendor.com"}, Headers: map[string]string{"X-Api-Key": "secret"}},
+		}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"url": "http://127.0.0.1:1"})
+
+		task := newTask("http://127.0.0.1:1", creds)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		assert.ErrorContains(t, result.Error, "credential vendor must restrict allowedHosts")
+
+		task = newTask("$(url)", creds)
+		task.CredentialName = "restricted"
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		assert.ErrorContains(t, result.Error, "credential restricted is not allowed for host 127.0.0.1:1")
+	})
+
+	t.Run("unknown credential", func(t *testing.T) {
+		task := newTask("http://127.0.0.1:1", testHTTPCredentials{})
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "credentialName: unable to find HTTP credential key with id vendor")
+	})
+}
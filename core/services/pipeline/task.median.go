@@ -60,13 +60,19 @@ func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs [
 		return Result{Error: err}, runInfo
 	}
 
-	sort.Slice(decimalValues, func(i, j int) bool {
-		return decimalValues[i].LessThan(decimalValues[j])
+	return Result{Value: medianOf(decimalValues)}, runInfo
+}
+
+// medianOf returns the median of a non-empty slice of values, without modifying it.
+func medianOf(values []decimal.Decimal) decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
 	})
-	k := len(decimalValues) / 2
-	if len(decimalValues)%2 == 1 {
-		return Result{Value: decimalValues[k]}, runInfo
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
 	}
-	median := decimalValues[k].Add(decimalValues[k-1]).Div(decimal.NewFromInt(2))
-	return Result{Value: median}, runInfo
+	return sorted[k].Add(sorted[k-1]).Div(decimal.NewFromInt(2))
 }
@@ -0,0 +1,137 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	OutlierMethodMAD    = "mad"
+	OutlierMethodStdDev = "stddev"
+)
+
+// madScale makes the median absolute deviation a consistent estimator of the standard deviation of normally
+// distributed values, so that thresholds mean the same for both methods.
+var madScale = decimal.RequireFromString("1.4826")
+
+// RejectOutliersTask removes the values which lie more than threshold deviations away from the centre of all values,
+// and returns the remaining values in their original order, e.g. for values="$(reject_outliers)" of a median task.
+//
+// With method=mad (default), deviations are scaled median absolute deviations from the median, so that up to half of
+// the values can be arbitrarily wrong without skewing the result. If more than half of the values are equal, all other
+// values are rejected. With method=stddev, deviations are standard deviations from the mean.
+//
+// Return types:
+//
+//	[]interface{} (of decimal.Decimal)
+type RejectOutliersTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Method        string `json:"method"`
+	Threshold     string `json:"threshold"`
+}
+
+var _ Task = (*RejectOutliersTask)(nil)
+
+func (t *RejectOutliersTask) Type() TaskType {
+	return TaskTypeRejectOutliers
+}
+
+func (t *RejectOutliersTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		method             StringParam
+		threshold          DecimalParam
+		valuesAndErrs      SliceParam
+		decimalValues      DecimalSliceParam
+		allowedFaults      int
+		faults             int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), OutlierMethodMAD)), "method"),
+		errors.Wrap(ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), "3")), "threshold"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if !threshold.Decimal().IsPositive() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "threshold must be positive, got %v", threshold.Decimal())}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	values, faults := valuesAndErrs.FilterErrors()
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to reject outliers task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
+	}
+
+	err = decimalValues.UnmarshalPipelineParam(values)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+	}
+
+	var isOutlier func(decimal.Decimal) bool
+	switch string(method) {
+	case OutlierMethodMAD:
+		isOutlier = madOutliers(decimalValues, threshold.Decimal())
+	case OutlierMethodStdDev:
+		isOutlier = stdDevOutliers(decimalValues, threshold.Decimal())
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "method must be %q or %q, got %q", OutlierMethodMAD, OutlierMethodStdDev, method)}, runInfo
+	}
+
+	kept := []interface{}{}
+	for _, v := range decimalValues {
+		if !isOutlier(v) {
+			kept = append(kept, v)
+		}
+	}
+	return Result{Value: kept}, runInfo
+}
+
+func madOutliers(values []decimal.Decimal, threshold decimal.Decimal) func(decimal.Decimal) bool {
+	median := medianOf(values)
+	deviations := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		deviations[i] = v.Sub(median).Abs()
+	}
+	limit := medianOf(deviations).Mul(madScale).Mul(threshold)
+	return func(v decimal.Decimal) bool {
+		return v.Sub(median).Abs().GreaterThan(limit)
+	}
+}
+
+func stdDevOutliers(values []decimal.Decimal, threshold decimal.Decimal) func(decimal.Decimal) bool {
+	n := decimal.NewFromInt(int64(len(values)))
+	total := decimal.NewFromInt(0)
+	for _, v := range values {
+		total = total.Add(v)
+	}
+	mean := total.Div(n)
+	squares := decimal.NewFromInt(0)
+	for _, v := range values {
+		d := v.Sub(mean)
+		squares = squares.Add(d.Mul(d))
+	}
+	// compare squared deviations with the squared limit, to avoid square roots
+	limit := squares.Div(n).Mul(threshold).Mul(threshold)
+	return func(v decimal.Decimal) bool {
+		d := v.Sub(mean)
+		return d.Mul(d).GreaterThan(limit)
+	}
+}
@@ -0,0 +1,147 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestRejectOutliersTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		values        []interface{}
+		method        string
+		threshold     string
+		allowedFaults string
+		want          []string
+		wantErr       error
+	}{
+		{
+			"mad rejects outlier",
+			[]interface{}{"100", "101", "99", "100.5", "150"},
+			"",
+			"",
+			"",
+			[]string{"100", "101", "99", "100.5"},
+			nil,
+		},
+		{
+			"mad keeps everything within threshold",
+			[]interface{}{"100", "101", "99", "100.5", "102"},
+			"mad",
+			"",
+			"",
+			[]string{"100", "101", "99", "100.5", "102"},
+			nil,
+		},
+		{
+			"mad with a majority of equal values",
+			[]interface{}{"100", "100", "100", "100.1"},
+			"mad",
+			"",
+			"",
+			[]string{"100", "100", "100"},
+			nil,
+		},
+		{
+			"mad threshold",
+			[]interface{}{"100", "101", "99", "100.5", "102"},
+			"mad",
+			"1",
+			"",
+			[]string{"100", "101", "100.5"},
+			nil,
+		},
+		{
+			"stddev rejects outlier",
+			[]interface{}{"10", "10", "10", "10", "10", "10", "10", "10", "10", "1000"},
+			"stddev",
+			"2",
+			"",
+			[]string{"10", "10", "10", "10", "10", "10", "10", "10", "10"},
+			nil,
+		},
+		{
+			"stddev with equal values",
+			[]interface{}{"10", "10"},
+			"stddev",
+			"",
+			"",
+			[]string{"10", "10"},
+			nil,
+		},
+		{
+			"faults are dropped",
+			[]interface{}{"100", errors.New("bridge timed out"), "101", "99", "150"},
+			"",
+			"",
+			"1",
+			[]string{"100", "101", "99"},
+			nil,
+		},
+		{
+			"too many faults",
+			[]interface{}{"100", errors.New("bridge timed out"), errors.New("bridge timed out")},
+			"",
+			"",
+			"1",
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"unknown method",
+			[]interface{}{"100"},
+			"iqr",
+			"",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"zero threshold",
+			[]interface{}{"100"},
+			"",
+			"0",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			vars := pipeline.NewVarsFrom(map[string]interface{}{"values": test.values})
+			task := pipeline.RejectOutliersTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Values:        "$(values)",
+				Method:        test.method,
+				Threshold:     test.threshold,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			var got []string
+			for _, v := range output.Value.([]interface{}) {
+				got = append(got, v.(decimal.Decimal).String())
+			}
+			require.Equal(t, test.want, got)
+		})
+	}
+}
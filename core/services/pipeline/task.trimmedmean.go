@@ -0,0 +1,92 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// TrimmedMeanTask discards the lowest and highest values before averaging the rest. Trim is the fraction of values
+// discarded from each end, rounded down, e.g. trim=0.2 discards the lowest and highest 2 of 10 values.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type TrimmedMeanTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Trim          string `json:"trim"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*TrimmedMeanTask)(nil)
+
+func (t *TrimmedMeanTask) Type() TaskType {
+	return TaskTypeTrimmedMean
+}
+
+func (t *TrimmedMeanTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		trim               DecimalParam
+		valuesAndErrs      SliceParam
+		decimalValues      DecimalSliceParam
+		allowedFaults      int
+		faults             int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), NonemptyString(t.Trim), "0.1")), "trim"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if trim.Decimal().IsNegative() || trim.Decimal().GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trim must be at least 0 and less than 0.5, got %v", trim.Decimal())}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	values, faults := valuesAndErrs.FilterErrors()
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to trimmed mean task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
+	}
+
+	err = decimalValues.UnmarshalPipelineParam(values)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+	}
+
+	sort.Slice(decimalValues, func(i, j int) bool {
+		return decimalValues[i].LessThan(decimalValues[j])
+	})
+	k := int(trim.Decimal().Mul(decimal.NewFromInt(int64(len(decimalValues)))).IntPart())
+	kept := decimalValues[k : len(decimalValues)-k]
+
+	total := decimal.NewFromInt(0)
+	for _, val := range kept {
+		total = total.Add(val)
+	}
+	numValues := decimal.NewFromInt(int64(len(kept)))
+
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return Result{Value: total.DivRound(numValues, precision)}, runInfo
+	}
+	return Result{Value: total.Div(numValues)}, runInfo
+}
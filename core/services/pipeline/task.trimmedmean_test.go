@@ -0,0 +1,121 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestTrimmedMeanTask(t *testing.T) {
+	t.Parallel()
+
+	values := func(vs ...string) (results []pipeline.Result) {
+		for _, v := range vs {
+			results = append(results, pipeline.Result{Value: mustDecimal(t, v)})
+		}
+		return
+	}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		trim          string
+		allowedFaults string
+		precision     string
+		want          pipeline.Result
+	}{
+		{
+			"default trim",
+			values("1", "2", "3", "4", "5", "6", "7", "8", "9", "1000"),
+			"",
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "5.5")},
+		},
+		{
+			"trim rounds down",
+			values("1", "2", "3", "1000"),
+			"0.3",
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+		},
+		{
+			"no trim",
+			values("1", "2", "3", "1000"),
+			"0",
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "251.5")},
+		},
+		{
+			"precision",
+			values("1", "1", "2", "100"),
+			"0.25",
+			"",
+			"2",
+			pipeline.Result{Value: mustDecimal(t, "1.50")},
+		},
+		{
+			"fewer errors than threshold",
+			append(values("1", "2", "3", "1000"), pipeline.Result{Error: errors.New("")}),
+			"0.25",
+			"1",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+		},
+		{
+			"more errors than threshold",
+			append(values("1", "2", "3", "1000"), pipeline.Result{Error: errors.New("")}, pipeline.Result{Error: errors.New("")}),
+			"0.25",
+			"1",
+			"",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"trim too high",
+			values("1", "2"),
+			"0.5",
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"zero inputs",
+			nil,
+			"",
+			"0",
+			"",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.TrimmedMeanTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:          test.trim,
+				AllowedFaults: test.allowedFaults,
+				Precision:     test.precision,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		})
+	}
+}
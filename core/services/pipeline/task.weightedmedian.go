@@ -0,0 +1,111 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// WeightedMedianTask returns the value at which the cumulative weight of the sorted values reaches half of the total,
+// or the mean of the two values either side of it if it is reached exactly. Weights are given in the same order as
+// values, typically from vars, e.g. weights="$(jobSpec.weights)". The weight of a faulty value is discarded with it.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type WeightedMedianTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+		allowedFaults      int
+		faults             int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false))), "weights"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if len(weights) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrWrongInputCardinality, "got %v weights for %v values", len(weights), len(valuesAndErrs))}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	type weightedValue struct {
+		value  decimal.Decimal
+		weight decimal.Decimal
+	}
+	var weighted []weightedValue
+	total := decimal.NewFromInt(0)
+	for i, v := range valuesAndErrs {
+		if _, is := v.(error); is {
+			faults++
+			continue
+		}
+		var value DecimalParam
+		if err = value.UnmarshalPipelineParam(v); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+		}
+		if weights[i].IsNegative() {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weights: weight %v of value %v is negative", weights[i], i)}, runInfo
+		}
+		weighted = append(weighted, weightedValue{value.Decimal(), weights[i]})
+		total = total.Add(weights[i])
+	}
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to weighted median task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(weighted) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to medianize")}, runInfo
+	} else if !total.IsPositive() {
+		return Result{Error: errors.Wrap(ErrBadInput, "weights: total weight of values must be positive")}, runInfo
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].value.LessThan(weighted[j].value)
+	})
+	half := total.Div(decimal.NewFromInt(2))
+	cumulative := decimal.NewFromInt(0)
+	for i, wv := range weighted {
+		cumulative = cumulative.Add(wv.weight)
+		if cumulative.LessThan(half) {
+			continue
+		}
+		if cumulative.Equal(half) {
+			// the median lies between this value and the next one with any weight
+			for _, next := range weighted[i+1:] {
+				if next.weight.IsPositive() {
+					return Result{Value: wv.value.Add(next.value).Div(decimal.NewFromInt(2))}, runInfo
+				}
+			}
+		}
+		return Result{Value: wv.value}, runInfo
+	}
+	return Result{Value: weighted[len(weighted)-1].value}, runInfo
+}
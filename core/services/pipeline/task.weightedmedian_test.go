@@ -0,0 +1,120 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestWeightedMedianTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		values        []interface{}
+		weights       []interface{}
+		allowedFaults string
+		want          pipeline.Result
+	}{
+		{
+			"equal weights",
+			[]interface{}{"3", "1", "2"},
+			[]interface{}{1, 1, 1},
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"heavy value",
+			[]interface{}{"1", "2", "3", "100"},
+			[]interface{}{1, 1, 1, 4},
+			"",
+			pipeline.Result{Value: mustDecimal(t, "100")},
+		},
+		{
+			"exactly half",
+			[]interface{}{"1", "2", "3", "4"},
+			[]interface{}{1, 2, 0, 3},
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"faulty values discard their weight",
+			[]interface{}{"1", errors.New("bridge timed out"), "2", "3"},
+			[]interface{}{1, 10, 1, 1},
+			"1",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"too many faults",
+			[]interface{}{"1", errors.New("bridge timed out"), errors.New("bridge timed out")},
+			[]interface{}{1, 1, 1},
+			"1",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"weights mismatch",
+			[]interface{}{"1", "2"},
+			[]interface{}{1},
+			"",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"negative weight",
+			[]interface{}{"1", "2"},
+			[]interface{}{1, -1},
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"zero total weight",
+			[]interface{}{"1", "2"},
+			[]interface{}{0, 0},
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			vars := pipeline.NewVarsFrom(map[string]interface{}{
+				"values":  test.values,
+				"weights": test.weights,
+			})
+			task := pipeline.WeightedMedianTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Values:        "$(values)",
+				Weights:       "$(weights)",
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		})
+	}
+
+	t.Run("json weights", func(t *testing.T) {
+		task := pipeline.WeightedMedianTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Weights:  "[1, 3]",
+		}
+		inputs := []pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+		require.NoError(t, output.Error)
+		require.Equal(t, "2", output.Value.(decimal.Decimal).String())
+	})
+}
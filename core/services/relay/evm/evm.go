@@ -36,6 +36,7 @@ import (
 	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -721,6 +722,8 @@ type configTransmitterOpts struct {
 	pluginGasLimit *uint32
 	// subjectID overrides the queueing subject id (the job external id will be used by default).
 	subjectID *uuid.UUID
+	// priority overrides the priority class of the transmissions (txm.TxPriorityReport will be used by default).
+	priority *txmgrtypes.TxPriority
 }
 
 // newOnChainContractTransmitter creates a new contract transmitter.
@@ -775,11 +778,15 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 	if opts.subjectID != nil {
 		subject = *opts.subjectID
 	}
-	strategy := txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth)
+	priority := txm.TxPriorityReport
+	if opts.priority != nil {
+		priority = *opts.priority
+	}
+	strategy := txmgrcommon.NewPriorityStrategy(subject, priority, relayConfig.DefaultTransactionQueueDepth)
 
 	var checker txm.TransmitCheckerSpec
 	if relayConfig.SimulateTransactions {
-		checker.CheckerType = txm.TransmitCheckerTypeSimulate
+		checker.Simulate = true
 	}
 
 	gasLimit := configWatcher.chain.Config().EVM().GasEstimator().LimitDefault()
@@ -833,7 +840,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 	if err != nil {
 		return nil, pkgerrors.Wrap(err, "failed to create transmitter")
 	}
-	return transmitter, nil
+	return ocrcommon.NewJobTransmitter(transmitter, rargs.JobID), nil
 }
 
 func (r *Relayer) NewChainWriter(_ context.Context, config []byte) (commontypes.ChainWriter, error) {
@@ -1058,8 +1065,10 @@ func (r *Relayer) NewCCIPExecProvider(ctx context.Context, rargs commontypes.Rel
 		return nil, err
 	}
 	subjectID := chainToUUID(configWatcher.chain.ID())
+	priority := txm.TxPriorityExecution
 	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{
 		subjectID: &subjectID,
+		priority:  &priority,
 	}, OCR2AggregatorTransmissionContractABI, WithReportToEthMetadata(fn), WithRetention(0))
 	if err != nil {
 		return nil, err
@@ -168,11 +168,11 @@ func newFunctionsContractTransmitter(ctx context.Context, contractVersion uint32
 		fromAddresses = append(fromAddresses, common.HexToAddress(s))
 	}
 
-	strategy := txmgrcommon.NewQueueingTxStrategy(rargs.ExternalJobID, relayConfig.DefaultTransactionQueueDepth)
+	strategy := txmgrcommon.NewPriorityStrategy(rargs.ExternalJobID, txm.TxPriorityReport, relayConfig.DefaultTransactionQueueDepth)
 
 	var checker txm.TransmitCheckerSpec
 	if relayConfig.SimulateTransactions {
-		checker.CheckerType = txm.TransmitCheckerTypeSimulate
+		checker.Simulate = true
 	}
 
 	gasLimit := configWatcher.chain.Config().EVM().GasEstimator().LimitDefault()
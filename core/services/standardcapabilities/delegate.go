@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
 	webapitarget "github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/target"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/trigger"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -49,6 +50,7 @@ type Delegate struct {
 	ks                      keystore.Master
 	peerWrapper             *ocrcommon.SingletonPeerWrapper
 	newOracleFactoryFn      func(generic.OracleFactoryParams) (core.OracleFactory, error)
+	computeQuotas           config.CapabilitiesComputeQuotas
 
 	isNewlyCreatedJob bool
 }
@@ -74,6 +76,7 @@ func NewDelegate(
 	ks keystore.Master,
 	peerWrapper *ocrcommon.SingletonPeerWrapper,
 	newOracleFactoryFn NewOracleFactoryFn,
+	computeQuotas config.CapabilitiesComputeQuotas,
 ) *Delegate {
 	return &Delegate{
 		logger:                  logger,
@@ -89,6 +92,7 @@ func NewDelegate(
 		ks:                      ks,
 		peerWrapper:             peerWrapper,
 		newOracleFactoryFn:      newOracleFactoryFn,
+		computeQuotas:           computeQuotas,
 	}
 }
 
@@ -242,6 +246,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		if err != nil {
 			return nil, err
 		}
+		cfg.Quotas = compute.NewQuotasConfig(d.computeQuotas)
 		lggr := d.logger.Named("ComputeAction")
 
 		handler, err := webapi.NewOutgoingConnectorHandler(d.gatewayConnectorWrapper.GetGatewayConnector(), cfg.ServiceConfig, capabilities.MethodComputeAction, lggr)
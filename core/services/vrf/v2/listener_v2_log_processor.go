@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
@@ -604,7 +605,7 @@ func (lsn *listenerV2) enqueueForceFulfillment(
 		ToAddress:      lsn.vrfOwner.Address(),
 		EncodedPayload: txData,
 		FeeLimit:       estimateGasLimit,
-		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Strategy:       txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgr.TxPriorityVRFFulfillment, 0),
 		Meta: &txmgr.TxMeta{
 			RequestID:     &requestID,
 			SubID:         ptr(subID.Uint64()),
@@ -821,7 +822,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						GlobalSubID:   txMetaGlobalSubID,
 						RequestTxHash: &requestTxHash,
 					},
-					Strategy: txmgrcommon.NewSendEveryStrategy(),
+					Strategy: txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgr.TxPriorityVRFFulfillment, 0),
 					Checker: txmgr.TransmitCheckerSpec{
 						CheckerType:           lsn.transmitCheckerType(),
 						VRFCoordinatorAddress: &coordinatorAddress,
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 	heaps "github.com/theodesp/go-heaps"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -241,7 +242,7 @@ func (lsn *listenerV2) processBatch(
 			ToAddress:      lsn.batchCoordinator.Address(),
 			EncodedPayload: payload,
 			FeeLimit:       uint64(totalGasLimitBumped),
-			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Strategy:       txmgrcommon.NewPriorityStrategy(uuid.Nil, txmgr.TxPriorityVRFFulfillment, 0),
 			Meta: &txmgr.TxMeta{
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLink,
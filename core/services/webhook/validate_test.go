@@ -47,6 +47,22 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.Equal(t, "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46", s.ExternalJobID.String())
 			},
 		},
+		{
+			name: "with max daily gas spend",
+			toml: `
+			type              = "webhook"
+			schemaVersion     = 1
+			maxDailyGasSpend  = "0.5 ether"
+			observationSource = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.MaxDailyGasSpend)
+				assert.Equal(t, "500000000000000000", s.MaxDailyGasSpend.ToInt().String())
+			},
+		},
 		{
 			name: "invalid job name",
 			toml: `
@@ -22,6 +22,7 @@ type Delegate struct {
 	secretsFetcher secretsFetcher
 	logger         logger.Logger
 	store          store.Store
+	engines        *EngineRegistry
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -59,9 +60,16 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		return nil, err
 	}
 
+	stepPolicies, err := spec.WorkflowSpec.StepPolicies(ctx)
+	if err != nil {
+		logCustMsg(ctx, cma, fmt.Sprintf("failed to start workflow engine: failed to get workflow step policies: %v", err), d.logger)
+		return nil, err
+	}
+
 	cfg := Config{
 		Lggr:           d.logger,
 		Workflow:       sdkSpec,
+		StepPolicies:   stepPolicies,
 		WorkflowID:     spec.WorkflowSpec.WorkflowID,
 		WorkflowOwner:  spec.WorkflowSpec.WorkflowOwner,
 		WorkflowName:   spec.WorkflowSpec.WorkflowName,
@@ -70,6 +78,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		Config:         config,
 		Binary:         binary,
 		SecretsFetcher: d.secretsFetcher,
+		EngineRegistry: d.engines,
 	}
 	engine, err := NewEngine(ctx, cfg)
 	if err != nil {
@@ -83,8 +92,9 @@ func NewDelegate(
 	registry core.CapabilitiesRegistry,
 	secretsFetcher secretsFetcher,
 	store store.Store,
+	engines *EngineRegistry,
 ) *Delegate {
-	return &Delegate{logger: logger, registry: registry, secretsFetcher: secretsFetcher, store: store}
+	return &Delegate{logger: logger, registry: registry, secretsFetcher: secretsFetcher, store: store, engines: engines}
 }
 
 func ValidatedWorkflowJobSpec(ctx context.Context, tomlString string) (job.Job, error) {
@@ -115,7 +125,15 @@ func ValidatedWorkflowJobSpec(ctx context.Context, tomlString string) (job.Job,
 	}
 
 	// ensure the embedded workflow graph is valid
-	if _, err = Parse(sdkSpec); err != nil {
+	wf, err := Parse(sdkSpec)
+	if err != nil {
+		return jb, fmt.Errorf("failed to parse workflow graph: %w", err)
+	}
+	stepPolicies, err := spec.StepPolicies(ctx)
+	if err != nil {
+		return jb, fmt.Errorf("failed to parse workflow step policies: %w", err)
+	}
+	if err = wf.applyStepPolicies(stepPolicies); err != nil {
 		return jb, fmt.Errorf("failed to parse workflow graph: %w", err)
 	}
 
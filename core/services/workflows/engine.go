@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/platform"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 )
 
@@ -35,6 +36,9 @@ type stepRequest struct {
 type stepUpdateChannel struct {
 	executionID string
 	ch          chan store.WorkflowExecutionStep
+	// done is closed when the execution's stepUpdateLoop should stop. ch is never closed, so that
+	// workers racing with the end of an execution can't send on a closed channel.
+	done chan struct{}
 }
 
 type stepUpdateManager struct {
@@ -54,6 +58,9 @@ func (sucm *stepUpdateManager) add(executionID string, ch stepUpdateChannel) (ad
 	if _, ok = sucm.m[executionID]; ok {
 		return false
 	}
+	if ch.done == nil {
+		ch.done = make(chan struct{})
+	}
 	sucm.m[executionID] = ch
 	return true
 }
@@ -62,7 +69,7 @@ func (sucm *stepUpdateManager) remove(executionID string) {
 	sucm.mu.Lock()
 	defer sucm.mu.Unlock()
 	if _, ok := sucm.m[executionID]; ok {
-		close(sucm.m[executionID].ch)
+		close(sucm.m[executionID].done)
 		delete(sucm.m, executionID)
 	}
 }
@@ -78,11 +85,20 @@ func (sucm *stepUpdateManager) send(ctx context.Context, executionID string, ste
 	select {
 	case <-ctx.Done():
 		return fmt.Errorf("context canceled before step update could be issued: %w", context.Cause(ctx))
+	case <-stepUpdateCh.done:
+		return fmt.Errorf("execution %s finished, dropping step update", executionID)
 	case stepUpdateCh.ch <- stepUpdate:
 		return nil
 	}
 }
 
+func (sucm *stepUpdateManager) get(executionID string) (stepUpdateChannel, bool) {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	ch, ok := sucm.m[executionID]
+	return ch, ok
+}
+
 func (sucm *stepUpdateManager) len() int64 {
 	sucm.mu.RLock()
 	defer sucm.mu.RUnlock()
@@ -108,6 +124,8 @@ type Engine struct {
 	pendingStepRequests  chan stepRequest
 	triggerEvents        chan capabilities.TriggerResponse
 	stepUpdatesChMap     stepUpdateManager
+	cancellations        executionCancellations
+	engineRegistry       *EngineRegistry
 	wg                   sync.WaitGroup
 	stopCh               services.StopChan
 	newWorkerTimeout     time.Duration
@@ -153,6 +171,10 @@ func (e *Engine) Start(_ context.Context) error {
 		e.wg.Add(1)
 		go e.heartbeat(ctx)
 
+		if e.engineRegistry != nil {
+			e.engineRegistry.add(e.workflow.id, e)
+		}
+
 		return nil
 	})
 }
@@ -396,13 +418,13 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 			}
 
 			for _, sd := range sds {
-				ch := make(chan store.WorkflowExecutionStep)
 				added := e.stepUpdatesChMap.add(execution.ExecutionID, stepUpdateChannel{
-					ch:          ch,
+					ch:          make(chan store.WorkflowExecutionStep),
 					executionID: execution.ExecutionID,
 				})
 				if added {
 					// We trigger the `stepUpdateLoop` for this execution, since the loop is not running atm.
+					ch, _ := e.stepUpdatesChMap.get(execution.ExecutionID)
 					e.wg.Add(1)
 					go e.stepUpdateLoop(ctx, execution.ExecutionID, ch, execution.CreatedAt)
 				}
@@ -491,7 +513,7 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 // This is important to avoid data races, and any accesses of `executionState` by any other
 // goroutine should happen via a `stepRequest` message containing a copy of the latest
 // `executionState`.
-func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpdateCh chan store.WorkflowExecutionStep, workflowCreatedAt *time.Time) {
+func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpdateCh stepUpdateChannel, workflowCreatedAt *time.Time) {
 	defer e.wg.Done()
 	lggr := e.logger.With(platform.KeyWorkflowExecutionID, executionID)
 	e.logger.Debugf("running stepUpdateLoop for execution %s", executionID)
@@ -500,11 +522,10 @@ func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpd
 		case <-ctx.Done():
 			lggr.Debug("shutting down stepUpdateLoop")
 			return
-		case stepUpdate, open := <-stepUpdateCh:
-			if !open {
-				lggr.Debug("stepUpdate channel closed, shutting down stepUpdateLoop")
-				return
-			}
+		case <-stepUpdateCh.done:
+			lggr.Debug("execution finished, shutting down stepUpdateLoop")
+			return
+		case stepUpdate := <-stepUpdateCh.ch:
 			// Executed synchronously to ensure we correctly schedule subsequent tasks.
 			e.logger.Debugw(fmt.Sprintf("received step update for execution %s", stepUpdate.ExecutionID),
 				platform.KeyWorkflowExecutionID, stepUpdate.ExecutionID, platform.KeyStepRef, stepUpdate.Ref)
@@ -552,11 +573,6 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event *
 		Status:      store.StatusStarted,
 	}
 
-	dbWex, err := e.executionStates.Add(ctx, ec)
-	if err != nil {
-		return err
-	}
-
 	// Find the tasks we need to fire when a trigger has fired and enqueue them.
 	// This consists of a) nodes without a dependency and b) nodes which depend
 	// on a trigger
@@ -565,21 +581,31 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event *
 		return err
 	}
 
-	ch := make(chan store.WorkflowExecutionStep)
-	added := e.stepUpdatesChMap.add(executionID, stepUpdateChannel{
-		ch:          ch,
-		executionID: executionID,
+	return e.runExecution(ctx, lggr, ec, triggerDependents)
+}
+
+// runExecution persists a new execution, starts its stepUpdateLoop, and enqueues the given steps.
+func (e *Engine) runExecution(ctx context.Context, lggr logger.Logger, ec *store.WorkflowExecution, steps []*step) error {
+	dbWex, err := e.executionStates.Add(ctx, ec)
+	if err != nil {
+		return err
+	}
+
+	added := e.stepUpdatesChMap.add(ec.ExecutionID, stepUpdateChannel{
+		ch:          make(chan store.WorkflowExecutionStep),
+		executionID: ec.ExecutionID,
 	})
 	if !added {
 		// skip this execution since there's already a stepUpdateLoop running for the execution ID
-		lggr.Debugf("won't start execution for execution %s, execution was already started", executionID)
+		lggr.Debugf("won't start execution for execution %s, execution was already started", ec.ExecutionID)
 		return nil
 	}
+	ch, _ := e.stepUpdatesChMap.get(ec.ExecutionID)
 	e.wg.Add(1)
-	go e.stepUpdateLoop(ctx, executionID, ch, dbWex.CreatedAt)
+	go e.stepUpdateLoop(ctx, ec.ExecutionID, ch, dbWex.CreatedAt)
 
-	for _, td := range triggerDependents {
-		e.queueIfReady(*ec, td)
+	for _, s := range steps {
+		e.queueIfReady(*ec, s)
 	}
 
 	return nil
@@ -589,6 +615,11 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 	l := e.logger.With(platform.KeyWorkflowExecutionID, stepUpdate.ExecutionID, platform.KeyStepRef, stepUpdate.Ref)
 	cma := e.cma.With(platform.KeyWorkflowExecutionID, stepUpdate.ExecutionID, platform.KeyStepRef, stepUpdate.Ref)
 
+	if e.cancellations.isCancelled(stepUpdate.ExecutionID) {
+		l.Debug("execution was cancelled; dropping step update")
+		return nil
+	}
+
 	// If we've been executing for too long, let's time the workflow step out and continue.
 	if workflowCreatedAt != nil && e.clock.Since(*workflowCreatedAt) > e.maxExecutionDuration {
 		l.Info("execution timed out; setting step status to timeout")
@@ -673,6 +704,12 @@ func (e *Engine) finishExecution(ctx context.Context, executionID string, status
 	e.logger.With(platform.KeyWorkflowExecutionID, executionID, "status", status).Info("finishing execution")
 	metrics := e.metrics.with("status", status)
 	err := e.executionStates.UpdateStatus(ctx, executionID, status)
+	if errors.Is(err, store.ErrExecutionFinished) {
+		// The execution was cancelled while its last steps were being processed; CancelExecution has already
+		// cleaned it up.
+		e.logger.With(platform.KeyWorkflowExecutionID, executionID).Debug("execution already finished; keeping its status")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -687,6 +724,7 @@ func (e *Engine) finishExecution(ctx context.Context, executionID string, status
 	e.stepUpdatesChMap.remove(executionID)
 	metrics.updateTotalWorkflowsGauge(ctx, e.stepUpdatesChMap.len())
 	metrics.updateWorkflowExecutionLatencyGauge(ctx, executionDuration)
+	e.cancellations.finish(executionID)
 	e.onExecutionFinished(executionID)
 	return nil
 }
@@ -756,10 +794,16 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	// TODO ks-462 inputs
 	logCustMsg(ctx, cma, "executing step", l)
 
-	stepCtx, cancel := context.WithTimeout(ctx, e.stepTimeoutDuration)
+	// Each attempt of the step is bounded by the step's timeout; stepCtx is only cancelled if the execution is.
+	stepCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	if _, ok := e.stepUpdatesChMap.get(msg.state.ExecutionID); !ok || !e.cancellations.track(msg.state.ExecutionID, msg.stepRef, cancel) {
+		l.Debug("execution was cancelled or has finished; dropping step request")
+		return
+	}
+	defer e.cancellations.untrack(msg.state.ExecutionID, msg.stepRef)
 
-	inputs, outputs, err := e.executeStep(stepCtx, l, msg)
+	inputs, outputs, attempts, err := e.executeStepWithPolicy(stepCtx, l, msg)
 	var stepStatus string
 	switch {
 	case errors.Is(capabilities.ErrStopExecution, err):
@@ -784,6 +828,7 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	stepState.Outputs.Value = outputs
 	stepState.Outputs.Err = err
 	stepState.Inputs = inputs
+	stepState.Attempts = attempts
 
 	// Let's try and emit the stepUpdate.
 	// If the context is canceled, we'll just drop the update.
@@ -1064,6 +1109,9 @@ func (e *Engine) heartbeat(ctx context.Context) {
 func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
 		e.logger.Info("shutting down engine")
+		if e.engineRegistry != nil {
+			e.engineRegistry.remove(e.workflow.id, e)
+		}
 		ctx := context.Background()
 		// To shut down the engine, we'll start by deregistering
 		// any triggers to ensure no new executions are triggered,
@@ -1127,6 +1175,7 @@ func (e *Engine) Close() error {
 
 type Config struct {
 	Workflow             sdk.WorkflowSpec
+	StepPolicies         map[string]job.WorkflowStepPolicy
 	WorkflowID           string
 	WorkflowOwner        string
 	WorkflowName         string
@@ -1142,6 +1191,8 @@ type Config struct {
 	SecretsFetcher       secretsFetcher
 	HeartbeatCadence     time.Duration
 	StepTimeout          time.Duration
+	// EngineRegistry, if set, tracks the engine while it is running so that its executions can be managed.
+	EngineRegistry *EngineRegistry
 
 	// For testing purposes only
 	maxRetries          int
@@ -1223,6 +1274,10 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		logCustMsg(ctx, cma, fmt.Sprintf("failed to parse workflow: %s", err), cfg.Lggr)
 		return nil, err
 	}
+	if err = workflow.applyStepPolicies(cfg.StepPolicies); err != nil {
+		logCustMsg(ctx, cma, fmt.Sprintf("failed to parse workflow: %s", err), cfg.Lggr)
+		return nil, err
+	}
 
 	workflow.id = cfg.WorkflowID
 	workflow.owner = cfg.WorkflowOwner
@@ -1242,6 +1297,8 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		executionStates:      cfg.Store,
 		pendingStepRequests:  make(chan stepRequest, cfg.QueueSize),
 		stepUpdatesChMap:     stepUpdateManager{m: map[string]stepUpdateChannel{}},
+		cancellations:        newExecutionCancellations(),
+		engineRegistry:       cfg.EngineRegistry,
 		triggerEvents:        make(chan capabilities.TriggerResponse),
 		stopCh:               make(chan struct{}),
 		newWorkerTimeout:     cfg.NewWorkerTimeout,
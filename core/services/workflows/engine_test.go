@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -141,13 +142,18 @@ func (t testConfigProvider) ConfigForCapability(ctx context.Context, capabilityI
 }
 
 func newTestEngineWithYAMLSpec(t *testing.T, reg *coreCap.Registry, spec string, opts ...func(c *Config)) (*Engine, *testHooks) {
-	sdkSpec, err := (&job.WorkflowSpec{
+	workflowSpec := &job.WorkflowSpec{
 		Workflow: spec,
 		SpecType: job.YamlSpec,
-	}).SDKSpec(testutils.Context(t))
+	}
+	sdkSpec, err := workflowSpec.SDKSpec(testutils.Context(t))
+	require.NoError(t, err)
+	stepPolicies, err := workflowSpec.StepPolicies(testutils.Context(t))
 	require.NoError(t, err)
 
-	return newTestEngine(t, reg, sdkSpec, opts...)
+	return newTestEngine(t, reg, sdkSpec, append([]func(c *Config){func(c *Config) {
+		c.StepPolicies = stepPolicies
+	}}, opts...)...)
 }
 
 type mockSecretsFetcher struct{}
@@ -1668,3 +1674,219 @@ func TestEngine_FetchesSecrets(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, gotConfig, expm)
 }
+
+type blockingCapability struct {
+	capabilities.CapabilityInfo
+	capabilities.Executable
+	started chan string
+}
+
+func (b *blockingCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	b.started <- req.Metadata.WorkflowExecutionID
+	<-ctx.Done()
+	return capabilities.CapabilityResponse{}, ctx.Err()
+}
+
+func (b *blockingCapability) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (b *blockingCapability) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+func TestEngine_CancelExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	consensus := &blockingCapability{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(
+			"offchain_reporting@1.0.0",
+			capabilities.CapabilityTypeConsensus,
+			"an ocr3 consensus capability",
+		),
+		started: make(chan string, 1),
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	engines := NewEngineRegistry()
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.EngineRegistry = engines
+	})
+	servicetest.Run(t, eng)
+
+	registered, err := engines.Get(testWorkflowId)
+	require.NoError(t, err)
+	assert.Same(t, eng, registered)
+
+	eid := <-consensus.started
+
+	_, err = eng.ReplayExecution(ctx, eid, false)
+	require.ErrorIs(t, err, ErrExecutionRunning)
+
+	require.NoError(t, eng.CancelExecution(ctx, eid))
+	assert.Equal(t, eid, <-hooks.executionFinished)
+
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCancelled, state.Status)
+	assert.NotNil(t, state.FinishedAt)
+	assert.Equal(t, store.StatusCancelled, state.Steps["evm_median"].Status)
+	assert.Equal(t, store.StatusCancelled, state.Steps["write_polygon-testnet-mumbai"].Status)
+	assert.Eventually(t, func() bool {
+		return !eng.cancellations.isCancelled(eid)
+	}, testutils.WaitTimeout(t), 10*time.Millisecond, "finished executions are not remembered once their steps have stopped")
+
+	require.ErrorIs(t, eng.CancelExecution(ctx, eid), store.ErrExecutionFinished)
+	require.ErrorIs(t, eng.CancelExecution(ctx, "unknown"), store.ErrExecutionNotFound)
+}
+
+func TestExecutionCancellations(t *testing.T) {
+	t.Parallel()
+	c := newExecutionCancellations()
+
+	var cancelled int
+	require.True(t, c.track("eid", "step1", func() { cancelled++ }))
+	require.True(t, c.track("eid", "step2", func() { cancelled++ }))
+
+	c.markCancelled("eid")
+	c.cancelInFlight("eid")
+	c.release("eid")
+	assert.Equal(t, 2, cancelled)
+	assert.False(t, c.track("eid", "step3", func() {}), "no steps are started once cancelled")
+
+	c.untrack("eid", "step1")
+	assert.True(t, c.isCancelled("eid"), "cancelled until the steps in flight have stopped")
+	c.untrack("eid", "step2")
+	assert.False(t, c.isCancelled("eid"))
+
+	c.markCancelled("idle")
+	c.release("idle")
+	assert.False(t, c.isCancelled("idle"))
+}
+
+func TestEngine_ReplayExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, cr := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	var failed bool
+	consensus := mockConsensus("")
+	transform := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if !failed {
+			failed = true
+			return capabilities.CapabilityResponse{}, errors.New("transient consensus error")
+		}
+		return transform(req)
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+	target := mockTarget("")
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	require.Equal(t, store.StatusErrored, state.Status)
+
+	replayID, err := eng.ReplayExecution(ctx, eid, true)
+	require.NoError(t, err)
+	assert.NotEqual(t, eid, replayID)
+	assert.Equal(t, replayID, <-hooks.executionFinished)
+
+	resp := <-target.response
+	assert.Equal(t, cr.Event.Outputs, resp.Value)
+
+	replay, err := eng.executionStates.Get(ctx, replayID)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, replay.Status)
+	assert.Equal(t, store.StatusCompleted, replay.Steps[workflows.KeywordTrigger].Status)
+
+	_, err = eng.ReplayExecution(ctx, replayID, true)
+	require.ErrorIs(t, err, ErrNothingToReplay)
+
+	scratchID, err := eng.ReplayExecution(ctx, replayID, false)
+	require.NoError(t, err)
+	assert.Equal(t, scratchID, <-hooks.executionFinished)
+	replay, err = eng.executionStates.Get(ctx, scratchID)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, replay.Status)
+}
+
+func TestEngine_RetriesStepAccordingToPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	var calls int
+	consensus := mockConsensus("")
+	transform := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		calls++
+		assert.NotContains(t, req.Config.Underlying, "retry")
+		assert.NotContains(t, req.Config.Underlying, "timeout")
+		if calls == 1 {
+			return capabilities.CapabilityResponse{}, errors.New("transient consensus error")
+		}
+		return transform(req)
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	spec := strings.Replace(simpleWorkflow, `    ref: "evm_median"
+`, `    ref: "evm_median"
+    timeout: 10s
+    retry:
+      max_attempts: 2
+      backoff: 10ms
+`, 1)
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, spec)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+
+	attempts := state.Steps["evm_median"].Attempts
+	require.Len(t, attempts, 2)
+	assert.Equal(t, 1, attempts[0].Attempt)
+	assert.Contains(t, attempts[0].Error, "transient consensus error")
+	assert.Equal(t, 2, attempts[1].Attempt)
+	assert.Empty(t, attempts[1].Error)
+}
+
+func TestEngine_InvalidStepPolicy(t *testing.T) {
+	t.Parallel()
+
+	spec := strings.Replace(simpleWorkflow, `    ref: "evm_median"
+`, `    ref: "evm_median"
+    retry:
+      max_attempts: 0
+`, 1)
+	_, err := (&job.WorkflowSpec{
+		Workflow: spec,
+		SpecType: job.YamlSpec,
+	}).SDKSpec(testutils.Context(t))
+	require.ErrorContains(t, err, "invalid policy for step evm_median")
+
+	sdkSpec, err := (&job.WorkflowSpec{Workflow: simpleWorkflow, SpecType: job.YamlSpec}).SDKSpec(testutils.Context(t))
+	require.NoError(t, err)
+	wf, err := Parse(sdkSpec)
+	require.NoError(t, err)
+	err = wf.applyStepPolicies(map[string]job.WorkflowStepPolicy{"unknown": {Timeout: time.Second}})
+	require.ErrorContains(t, err, "policy declared for unknown step unknown")
+}
@@ -0,0 +1,324 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+
+	"github.com/smartcontractkit/chainlink/v2/core/platform"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+var (
+	// ErrEngineNotFound is returned when no engine is running for a workflow.
+	ErrEngineNotFound = errors.New("workflow engine not running")
+	// ErrExecutionRunning is returned when replaying an execution which has not finished yet.
+	ErrExecutionRunning = errors.New("workflow execution still running")
+	// ErrNothingToReplay is returned when replaying an execution from its first failed step, but none of its steps failed.
+	ErrNothingToReplay = errors.New("workflow execution has no failed steps to replay")
+)
+
+// EngineRegistry tracks the running workflow engines by workflow ID.
+type EngineRegistry struct {
+	mu      sync.RWMutex
+	engines map[string]*Engine
+}
+
+func NewEngineRegistry() *EngineRegistry {
+	return &EngineRegistry{engines: map[string]*Engine{}}
+}
+
+// Get returns the running engine for the given workflow ID, or ErrEngineNotFound.
+func (r *EngineRegistry) Get(workflowID string) (*Engine, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.engines[workflowID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEngineNotFound, workflowID)
+	}
+	return e, nil
+}
+
+func (r *EngineRegistry) add(workflowID string, e *Engine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.engines[workflowID] = e
+}
+
+// remove unregisters the engine, unless it has already been replaced by another engine for the same workflow.
+func (r *EngineRegistry) remove(workflowID string, e *Engine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.engines[workflowID] == e {
+		delete(r.engines, workflowID)
+	}
+}
+
+// executionCancellations tracks cancelled executions, and the contexts of their steps which are in flight.
+type executionCancellations struct {
+	mu        sync.Mutex
+	cancelled map[string]struct{}
+	inFlight  map[string]map[string]context.CancelFunc
+}
+
+func newExecutionCancellations() executionCancellations {
+	return executionCancellations{
+		cancelled: map[string]struct{}{},
+		inFlight:  map[string]map[string]context.CancelFunc{},
+	}
+}
+
+// markCancelled marks the execution as cancelled, so its steps are no longer started and their updates are dropped.
+func (c *executionCancellations) markCancelled(executionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cancelled[executionID] = struct{}{}
+}
+
+// cancelInFlight cancels the contexts of the execution's steps in flight. The steps stay tracked until they have
+// returned, so the execution is remembered as cancelled until then.
+func (c *executionCancellations) cancelInFlight(executionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cancel := range c.inFlight[executionID] {
+		cancel()
+	}
+}
+
+// release drops a cancelled execution if none of its steps are in flight. Otherwise it is dropped by untrack once
+// the last of them has returned.
+func (c *executionCancellations) release(executionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.inFlight[executionID]; !ok {
+		delete(c.cancelled, executionID)
+	}
+}
+
+// finish drops the execution once it has finished. Step requests and updates which arrive later are dropped
+// because the execution no longer has a step update channel, so it doesn't need to be remembered as cancelled.
+func (c *executionCancellations) finish(executionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.cancelled, executionID)
+	delete(c.inFlight, executionID)
+}
+
+// forget reverts markCancelled, for when the cancellation could not be persisted.
+func (c *executionCancellations) forget(executionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.cancelled, executionID)
+}
+
+func (c *executionCancellations) isCancelled(executionID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.cancelled[executionID]
+	return ok
+}
+
+// track records the cancel func of a step in flight. It returns false if the execution has been cancelled, in
+// which case the step must not be executed.
+func (c *executionCancellations) track(executionID, stepRef string, cancel context.CancelFunc) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.cancelled[executionID]; ok {
+		return false
+	}
+	steps, ok := c.inFlight[executionID]
+	if !ok {
+		steps = map[string]context.CancelFunc{}
+		c.inFlight[executionID] = steps
+	}
+	steps[stepRef] = cancel
+	return true
+}
+
+func (c *executionCancellations) untrack(executionID, stepRef string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	steps, ok := c.inFlight[executionID]
+	if !ok {
+		return
+	}
+	delete(steps, stepRef)
+	if len(steps) == 0 {
+		delete(c.inFlight, executionID)
+		delete(c.cancelled, executionID)
+	}
+}
+
+// CancelExecution stops an execution which is in progress. Steps in flight have their context cancelled, steps
+// waiting to be executed are dropped, and every step which hasn't finished is marked as cancelled.
+func (e *Engine) CancelExecution(ctx context.Context, executionID string) error {
+	execution, err := e.getExecution(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	if execution.Status != store.StatusStarted {
+		return fmt.Errorf("%w: %s has status %s", store.ErrExecutionFinished, executionID, execution.Status)
+	}
+
+	lggr := e.logger.With(platform.KeyWorkflowExecutionID, executionID)
+	lggr.Info("cancelling execution")
+	e.cancellations.markCancelled(executionID)
+
+	var pendingSteps []string
+	err = e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		if s.Ref == workflows.KeywordTrigger {
+			return nil
+		}
+		if _, ok := execution.Steps[s.Ref]; !ok {
+			pendingSteps = append(pendingSteps, s.Ref)
+		}
+		return nil
+	})
+	if err != nil {
+		e.cancellations.forget(executionID)
+		return err
+	}
+
+	// The cancelled status is saved before the steps in flight are cancelled, so their failures can't finish the
+	// execution first.
+	if err = e.executionStates.CancelExecution(ctx, executionID, pendingSteps); err != nil {
+		e.cancellations.forget(executionID)
+		return err
+	}
+	e.cancellations.cancelInFlight(executionID)
+
+	e.stepUpdatesChMap.remove(executionID)
+	e.metrics.with("status", store.StatusCancelled).updateTotalWorkflowsGauge(ctx, e.stepUpdatesChMap.len())
+	e.cancellations.release(executionID)
+	e.onExecutionFinished(executionID)
+	return nil
+}
+
+// ReplayExecution starts a new execution of the workflow with the trigger output of a finished execution, and
+// returns the ID of the new execution. If fromFailedStep is set, the steps which completed in the original execution
+// are carried over and only the remaining steps are executed again.
+func (e *Engine) ReplayExecution(ctx context.Context, executionID string, fromFailedStep bool) (string, error) {
+	execution, err := e.getExecution(ctx, executionID)
+	if err != nil {
+		return "", err
+	}
+	if execution.Status == store.StatusStarted {
+		return "", fmt.Errorf("%w: %s", ErrExecutionRunning, executionID)
+	}
+
+	trigger, ok := execution.Steps[workflows.KeywordTrigger]
+	if !ok {
+		return "", fmt.Errorf("execution %s has no trigger output to replay", executionID)
+	}
+	event, ok := trigger.Outputs.Value.(*values.Map)
+	if !ok {
+		return "", fmt.Errorf("execution %s has an invalid trigger output of type %T", executionID, trigger.Outputs.Value)
+	}
+
+	newExecutionID, err := generateExecutionID(e.workflow.id, "replay:"+executionID+":"+strconv.FormatInt(e.clock.Now().UnixNano(), 10))
+	if err != nil {
+		return "", err
+	}
+
+	ec := &store.WorkflowExecution{
+		Steps:       map[string]*store.WorkflowExecutionStep{},
+		WorkflowID:  e.workflow.id,
+		ExecutionID: newExecutionID,
+		Status:      store.StatusStarted,
+	}
+	var steps []*step
+	if fromFailedStep {
+		steps, err = e.stepsToReplay(execution, ec)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		ec.Steps[workflows.KeywordTrigger] = &store.WorkflowExecutionStep{
+			Outputs:     store.StepOutput{Value: event},
+			Status:      store.StatusCompleted,
+			ExecutionID: newExecutionID,
+			Ref:         workflows.KeywordTrigger,
+		}
+		steps, err = e.workflow.dependents(workflows.KeywordTrigger)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	lggr := e.logger.With(platform.KeyWorkflowExecutionID, newExecutionID, "replayOf", executionID, "fromFailedStep", fromFailedStep)
+	lggr.Info("replaying execution")
+
+	// The execution outlives the request, so it runs on the engine's context.
+	runCtx, cancel := e.stopCh.NewCtx()
+	if err = e.runExecution(runCtx, lggr, ec, steps); err != nil {
+		cancel()
+		return "", err
+	}
+	ch, ok := e.stepUpdatesChMap.get(newExecutionID)
+	if !ok {
+		cancel()
+		return newExecutionID, nil
+	}
+	go func() {
+		defer cancel()
+		select {
+		case <-ch.done:
+		case <-runCtx.Done():
+		}
+	}()
+	return newExecutionID, nil
+}
+
+// stepsToReplay copies the completed steps of execution into ec, and returns the steps which have to be executed
+// again: those which did not complete but whose dependencies did.
+func (e *Engine) stepsToReplay(execution store.WorkflowExecution, ec *store.WorkflowExecution) ([]*step, error) {
+	for ref, s := range execution.Steps {
+		if s.Status != store.StatusCompleted {
+			continue
+		}
+		ec.Steps[ref] = &store.WorkflowExecutionStep{
+			ExecutionID: ec.ExecutionID,
+			Ref:         ref,
+			Status:      s.Status,
+			Inputs:      s.Inputs,
+			Outputs:     s.Outputs,
+		}
+	}
+
+	var steps []*step
+	seen := map[string]bool{}
+	for ref := range ec.Steps {
+		dependents, err := e.workflow.dependents(ref)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range dependents {
+			if _, ok := ec.Steps[d.Ref]; ok || seen[d.Ref] {
+				continue
+			}
+			seen[d.Ref] = true
+			steps = append(steps, d)
+		}
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNothingToReplay, execution.ExecutionID)
+	}
+	return steps, nil
+}
+
+// getExecution loads an execution, and checks it belongs to this engine's workflow.
+func (e *Engine) getExecution(ctx context.Context, executionID string) (store.WorkflowExecution, error) {
+	execution, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return store.WorkflowExecution{}, err
+	}
+	if execution.WorkflowID != e.workflow.id {
+		return store.WorkflowExecution{}, fmt.Errorf("%w: %s is not an execution of workflow %s", store.ErrExecutionNotFound, executionID, e.workflow.id)
+	}
+	return execution, nil
+}
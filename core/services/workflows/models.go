@@ -83,6 +83,7 @@ type step struct {
 	capability capabilities.ExecutableCapability
 	info       capabilities.CapabilityInfo
 	config     *values.Map
+	policy     stepPolicy
 }
 
 type triggerCapability struct {
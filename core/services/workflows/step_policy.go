@@ -0,0 +1,122 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const (
+	// defaultStepBackoff is the delay before the first retry, if a retry policy doesn't set one.
+	defaultStepBackoff = time.Second
+	// defaultStepMaxBackoff caps the delay between retries, if a retry policy doesn't set a maximum.
+	defaultStepMaxBackoff = 5 * time.Minute
+)
+
+// stepPolicy is the execution policy of a step, as declared in the workflow spec. The zero value executes the step
+// once, bounded by the engine's step timeout; in particular targets, whose writes may not be idempotent, are never
+// retried unless their spec asks for it.
+type stepPolicy struct {
+	// Timeout bounds each attempt of the step. Zero means the engine's step timeout.
+	Timeout time.Duration
+	Retry   job.WorkflowStepRetryPolicy
+}
+
+// attempts returns the total number of times the step may be executed.
+func (p stepPolicy) attempts() int {
+	if p.Retry.MaxAttempts < 1 {
+		return 1
+	}
+	return p.Retry.MaxAttempts
+}
+
+// backoff returns the delay before the given retry, counting from 1.
+func (p stepPolicy) backoff(retry int) time.Duration {
+	d := p.Retry.Backoff
+	if d == 0 {
+		d = defaultStepBackoff
+	}
+	limit := p.Retry.MaxBackoff
+	if limit == 0 {
+		limit = max(defaultStepMaxBackoff, d)
+	}
+	for i := 1; i < retry && d < limit; i++ {
+		if d > limit/2 {
+			return limit
+		}
+		d *= 2
+	}
+	return min(d, limit)
+}
+
+// applyStepPolicies sets the policies declared in the workflow spec on the steps they refer to.
+func (w *workflow) applyStepPolicies(policies map[string]job.WorkflowStepPolicy) error {
+	for ref, p := range policies {
+		s, err := w.Vertex(ref)
+		if err != nil || ref == workflows.KeywordTrigger {
+			return fmt.Errorf("policy declared for unknown step %s", ref)
+		}
+		s.policy = stepPolicy{Timeout: p.Timeout}
+		if p.Retry != nil {
+			s.policy.Retry = *p.Retry
+		}
+	}
+	return nil
+}
+
+// executeStepWithPolicy executes the step, retrying it according to its policy. Each attempt is bounded by the step's
+// timeout. The attempts are returned along with the result of the last one.
+func (e *Engine) executeStepWithPolicy(ctx context.Context, lggr logger.Logger, msg stepRequest) (*values.Map, values.Value, []store.StepAttempt, error) {
+	s, err := e.workflow.Vertex(msg.stepRef)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	timeout := s.policy.Timeout
+	if timeout == 0 {
+		timeout = e.stepTimeoutDuration
+	}
+	maxAttempts := s.policy.attempts()
+
+	var (
+		attempts []store.StepAttempt
+		inputs   *values.Map
+		outputs  values.Value
+	)
+	for attempt := 1; ; attempt++ {
+		startedAt := e.clock.Now()
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		inputs, outputs, err = e.executeStep(attemptCtx, lggr, msg)
+		cancel()
+
+		record := store.StepAttempt{Attempt: attempt, StartedAt: startedAt, FinishedAt: e.clock.Now()}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		attempts = append(attempts, record)
+
+		// A step which asked to stop the execution has not failed, so it isn't retried.
+		if err == nil || errors.Is(capabilities.ErrStopExecution, err) || attempt >= maxAttempts {
+			return inputs, outputs, attempts, err
+		}
+
+		backoff := s.policy.backoff(attempt)
+		lggr.Warnw("step attempt failed, retrying", "attempt", attempt, "maxAttempts", maxAttempts, "backoff", backoff, "err", err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return inputs, outputs, attempts, err
+		case <-timer.C:
+		}
+	}
+}
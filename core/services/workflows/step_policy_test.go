@@ -0,0 +1,34 @@
+package workflows
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestStepPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	p := stepPolicy{Retry: job.WorkflowStepRetryPolicy{MaxAttempts: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second}}
+	assert.Equal(t, time.Second, p.backoff(1))
+	assert.Equal(t, 2*time.Second, p.backoff(2))
+	assert.Equal(t, 4*time.Second, p.backoff(3))
+	assert.Equal(t, 5*time.Second, p.backoff(4))
+	assert.Equal(t, 5*time.Second, p.backoff(10))
+
+	assert.Equal(t, defaultStepBackoff, stepPolicy{}.backoff(1))
+
+	unbounded := stepPolicy{Retry: job.WorkflowStepRetryPolicy{MaxAttempts: 1000, Backoff: time.Second}}
+	assert.Equal(t, 256*time.Second, unbounded.backoff(9))
+	assert.Equal(t, defaultStepMaxBackoff, unbounded.backoff(10))
+	assert.Equal(t, defaultStepMaxBackoff, unbounded.backoff(999), "the delay must not overflow")
+	slow := stepPolicy{Retry: job.WorkflowStepRetryPolicy{MaxAttempts: 1000, Backoff: time.Hour}}
+	assert.Equal(t, time.Hour, slow.backoff(999), "a backoff above the default maximum is kept")
+	huge := stepPolicy{Retry: job.WorkflowStepRetryPolicy{MaxAttempts: 1000, Backoff: time.Second, MaxBackoff: math.MaxInt64}}
+	assert.Equal(t, time.Duration(math.MaxInt64), huge.backoff(999))
+	assert.Equal(t, 1, stepPolicy{}.attempts(), "steps without a retry policy run once")
+}
@@ -15,6 +15,7 @@ const (
 	StatusTimeout            = "timeout"
 	StatusCompleted          = "completed"
 	StatusCompletedEarlyExit = "completed_early_exit"
+	StatusCancelled          = "cancelled"
 )
 
 var ValidStatuses = map[string]bool{
@@ -23,6 +24,7 @@ var ValidStatuses = map[string]bool{
 	StatusTimeout:            true,
 	StatusCompleted:          true,
 	StatusCompletedEarlyExit: true,
+	StatusCancelled:          true,
 }
 
 type StepOutput struct {
@@ -30,6 +32,14 @@ type StepOutput struct {
 	Value values.Value
 }
 
+// StepAttempt records a single attempt at executing a step.
+type StepAttempt struct {
+	Attempt    int       `json:"attempt"`
+	Error      string    `json:"error,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
 type WorkflowExecutionStep struct {
 	ExecutionID string
 	Ref         string
@@ -37,6 +47,8 @@ type WorkflowExecutionStep struct {
 
 	Inputs  *values.Map
 	Outputs StepOutput
+	// Attempts lists every attempt at executing the step, oldest first.
+	Attempts []StepAttempt
 
 	UpdatedAt *time.Time
 }
@@ -0,0 +1,172 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	reapReasonThreshold = "threshold"
+	reapReasonCount     = "count"
+)
+
+var (
+	promExecutionsReaped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "workflow_executions_reaped",
+		Help: "The number of finished workflow executions deleted by the reaper",
+	}, []string{"reason"})
+	promReaperErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "workflow_executions_reaper_errors",
+		Help: "The number of failed workflow execution reaper runs",
+	})
+	promReaperDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "workflow_executions_reaper_duration_seconds",
+		Help:    "How long a workflow execution reaper run took",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+	})
+)
+
+type ReaperConfig interface {
+	ReaperInterval() time.Duration
+	ReaperThreshold() time.Duration
+	MaxPerWorkflow() uint32
+	ReaperBatchSize() uint32
+}
+
+// Reaper periodically deletes finished workflow executions which are older than the configured threshold, or which
+// exceed the configured number of executions kept per workflow. Executions still in progress are never deleted.
+type Reaper struct {
+	services.StateMachine
+	store  *DBStore
+	config ReaperConfig
+	clock  clockwork.Clock
+	lggr   logger.Logger
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ services.Service = (*Reaper)(nil)
+
+func NewReaper(store *DBStore, config ReaperConfig, clock clockwork.Clock, lggr logger.Logger) *Reaper {
+	return &Reaper{
+		store:  store,
+		config: config,
+		clock:  clock,
+		lggr:   lggr.Named("WorkflowExecutionReaper"),
+		stopCh: make(services.StopChan),
+	}
+}
+
+func (r *Reaper) Start(context.Context) error {
+	return r.StartOnce("WorkflowExecutionReaper", func() error {
+		if r.config.ReaperInterval() == 0 {
+			r.lggr.Info("Workflow execution reaper disabled")
+			return nil
+		}
+		r.wg.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Reaper) Close() error {
+	return r.StopOnce("WorkflowExecutionReaper", func() error {
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *Reaper) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *Reaper) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *Reaper) run() {
+	defer r.wg.Done()
+
+	ticker := r.clock.NewTicker(r.config.ReaperInterval())
+	defer ticker.Stop()
+	for {
+		r.reapOnce()
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.Chan():
+		}
+	}
+}
+
+func (r *Reaper) reapOnce() {
+	ctx, cancel := r.stopCh.CtxWithTimeout(r.config.ReaperInterval())
+	defer cancel()
+
+	start := r.clock.Now()
+	byThreshold, byCount, err := r.Reap(ctx)
+	promReaperDuration.Observe(r.clock.Since(start).Seconds())
+	if err != nil {
+		select {
+		case <-r.stopCh:
+			// interrupted by shutdown
+			return
+		default:
+		}
+		promReaperErrors.Inc()
+		r.lggr.Errorw("Workflow execution reaper failed", "err", err)
+		r.SvcErrBuffer.Append(err)
+		return
+	}
+	r.lggr.Debugw("Workflow execution reaper completed", "deletedByThreshold", byThreshold, "deletedByCount", byCount)
+}
+
+// Reap deletes finished executions in batches until none are left to delete, and returns the number of executions
+// deleted for being older than the threshold and for exceeding the per-workflow limit.
+func (r *Reaper) Reap(ctx context.Context) (byThreshold, byCount int64, err error) {
+	batchSize := r.config.ReaperBatchSize()
+	if threshold := r.config.ReaperThreshold(); threshold > 0 {
+		before := r.clock.Now().Add(-threshold)
+		byThreshold, err = r.reapBatches(ctx, reapReasonThreshold, batchSize, func(ctx context.Context) (int64, error) {
+			return r.store.DeleteFinishedExecutionsBefore(ctx, before, batchSize)
+		})
+		if err != nil {
+			return byThreshold, byCount, err
+		}
+	}
+	if keep := r.config.MaxPerWorkflow(); keep > 0 {
+		byCount, err = r.reapBatches(ctx, reapReasonCount, batchSize, func(ctx context.Context) (int64, error) {
+			return r.store.DeleteExcessExecutions(ctx, keep, batchSize)
+		})
+	}
+	return byThreshold, byCount, err
+}
+
+func (r *Reaper) reapBatches(ctx context.Context, reason string, batchSize uint32, deleteBatch func(context.Context) (int64, error)) (total int64, err error) {
+	for {
+		if err = ctx.Err(); err != nil {
+			return total, err
+		}
+		var deleted int64
+		deleted, err = deleteBatch(ctx)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		promExecutionsReaped.WithLabelValues(reason).Add(float64(deleted))
+		if deleted < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type testReaperConfig struct {
+	interval, threshold time.Duration
+	maxPerWorkflow      uint32
+	batchSize           uint32
+}
+
+func (c testReaperConfig) ReaperInterval() time.Duration  { return c.interval }
+func (c testReaperConfig) ReaperThreshold() time.Duration { return c.threshold }
+func (c testReaperConfig) MaxPerWorkflow() uint32         { return c.maxPerWorkflow }
+func (c testReaperConfig) ReaperBatchSize() uint32        { return c.batchSize }
+
+func Test_Reaper_Reap(t *testing.T) {
+	store := newTestDBStore(t)
+	clock := store.clock.(clockwork.FakeClock)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	otherWid := randomID()
+	createWorkflow(t, store, otherWid)
+
+	add := func(workflowID string, finished bool) string {
+		id := randomID()
+		_, err := store.Add(ctx, &WorkflowExecution{ExecutionID: id, WorkflowID: workflowID, Status: StatusStarted, Steps: map[string]*WorkflowExecutionStep{
+			"trigger": {ExecutionID: id, Ref: "trigger", Status: StatusCompleted},
+		}})
+		require.NoError(t, err)
+		if finished {
+			require.NoError(t, store.UpdateStatus(ctx, id, StatusCompleted))
+		}
+		clock.Advance(time.Hour)
+		return id
+	}
+
+	// oldest first
+	expired := []string{add(wid, true), add(wid, true), add(wid, true)}
+	unfinished := add(wid, false)
+	clock.Advance(24 * time.Hour)
+	excess := add(wid, true)
+	kept := []string{add(wid, true), add(wid, true)}
+	other := add(otherWid, true)
+
+	reaper := NewReaper(store, testReaperConfig{threshold: 24 * time.Hour, maxPerWorkflow: 2, batchSize: 2}, clock, logger.TestLogger(t))
+	byThreshold, byCount, err := reaper.Reap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(expired)), byThreshold)
+	assert.Equal(t, int64(1), byCount)
+
+	for _, id := range append(expired, excess) {
+		_, err = store.Get(ctx, id)
+		require.ErrorIs(t, err, ErrExecutionNotFound)
+	}
+	for _, id := range append(kept, unfinished, other) {
+		_, err = store.Get(ctx, id)
+		require.NoError(t, err)
+	}
+
+	var steps int
+	require.NoError(t, store.db.GetContext(ctx, &steps, `SELECT count(*) FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(expired)))
+	assert.Zero(t, steps)
+}
+
+func Test_Reaper_Disabled(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	id := randomID()
+	_, err := store.Add(ctx, &WorkflowExecution{ExecutionID: id, WorkflowID: wid, Status: StatusStarted, Steps: map[string]*WorkflowExecutionStep{
+		"trigger": {ExecutionID: id, Ref: "trigger", Status: StatusCompleted},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateStatus(ctx, id, StatusCompleted))
+
+	reaper := NewReaper(store, testReaperConfig{batchSize: 10}, clockwork.NewFakeClock(), logger.TestLogger(t))
+	byThreshold, byCount, err := reaper.Reap(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, byThreshold)
+	assert.Zero(t, byCount)
+
+	require.NoError(t, reaper.Start(ctx))
+	require.NoError(t, reaper.Ready())
+	assert.NoError(t, reaper.HealthReport()[reaper.Name()])
+	require.NoError(t, reaper.Close())
+}
@@ -2,14 +2,34 @@ package store
 
 import (
 	"context"
+	"errors"
+	"time"
 )
 
+var (
+	// ErrExecutionNotFound is returned by Get when no execution with the given ID exists.
+	ErrExecutionNotFound = errors.New("workflow execution not found")
+	// ErrExecutionFinished is returned by UpdateStatus and CancelExecution when the execution is no longer in progress.
+	ErrExecutionFinished = errors.New("workflow execution already finished")
+)
+
+// ExecutionFilter narrows down the executions returned by ListExecutions. Zero values match all executions.
+type ExecutionFilter struct {
+	WorkflowID string
+	Statuses   []string
+	// CreatedAfter and CreatedBefore are inclusive and exclusive bounds on the creation time respectively.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
 type Store interface {
 	Add(ctx context.Context, state *WorkflowExecution) (WorkflowExecution, error)
 	UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error)
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error)
+	ListExecutions(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error)
+	CancelExecution(ctx context.Context, executionID string, pendingSteps []string) error
 }
 
 var _ Store = (*DBStore)(nil)
@@ -2,14 +2,17 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -47,6 +50,7 @@ type workflowStepRow struct {
 	Inputs              []byte
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
+	Attempts            []byte     `db:"attempts"`
 	UpdatedAt           *time.Time `db:"updated_at"`
 }
 
@@ -59,6 +63,7 @@ type workflowExecutionWithStep struct {
 	WSInputs              []byte     `db:"ws_inputs"`
 	WSOutputErr           *string    `db:"ws_output_err"`
 	WSOutputValue         []byte     `db:"ws_output_value"`
+	WSAttempts            []byte     `db:"ws_attempts"`
 	WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 	// WorkflowExecution fields
@@ -70,16 +75,27 @@ type workflowExecutionWithStep struct {
 	WEFinishedAt *time.Time `db:"we_finished_at"`
 }
 
-// `UpdateStatus` updates the status of the given workflow execution
+// `UpdateStatus` updates the status of the given workflow execution. Only executions which are in progress are
+// updated, so a cancelled execution keeps its status; ErrExecutionFinished is returned otherwise.
 func (d *DBStore) UpdateStatus(ctx context.Context, executionID string, status string) error {
-	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2 WHERE id = $3`
+	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2 WHERE id = $3 AND status = $4`
 
 	// If we're completing the workflow execution, let's also set a finished_at timestamp.
 	if status != StatusStarted {
-		sql = "UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = $2 WHERE id = $3"
+		sql = "UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = $2 WHERE id = $3 AND status = $4"
 	}
-	_, err := d.db.ExecContext(ctx, sql, status, d.clock.Now(), executionID)
-	return err
+	result, err := d.db.ExecContext(ctx, sql, status, d.clock.Now(), executionID, StatusStarted)
+	if err != nil {
+		return err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("could not update status of workflow execution %s: %w", executionID, ErrExecutionFinished)
+	}
+	return nil
 }
 
 // `UpsertStep` updates the given step. This will correspond to an insert, or an update
@@ -114,6 +130,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 			workflow_steps.inputs AS ws_inputs,
 			workflow_steps.output_err AS ws_output_err,
 			workflow_steps.output_value AS ws_output_value,
+			workflow_steps.attempts AS ws_attempts,
 			workflow_steps.updated_at AS ws_updated_at
 	FROM workflow_executions JOIN workflow_steps
 	ON workflow_executions.id = workflow_steps.workflow_execution_id
@@ -130,7 +147,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 	}
 	state, ok := idToExecutionState[executionID]
 	if !ok {
-		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s", executionID)
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
 	}
 	return *state, nil
 }
@@ -159,6 +176,7 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 			Ref:                 jr.WSRef,
 			OutputErr:           jr.WSOutputErr,
 			OutputValue:         jr.WSOutputValue,
+			Attempts:            jr.WSAttempts,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
 			UpdatedAt:           jr.WSUpdatedAt,
@@ -211,6 +229,13 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 		}
 	}
 
+	var attempts []StepAttempt
+	if len(step.Attempts) != 0 {
+		if err := json.Unmarshal(step.Attempts, &attempts); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal step attempts: %w", err)
+		}
+	}
+
 	return &WorkflowExecutionStep{
 		ExecutionID: step.WorkflowExecutionID,
 		Ref:         step.Ref,
@@ -220,6 +245,7 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 			Err:   outputErr,
 			Value: outputs,
 		},
+		Attempts: attempts,
 	}, nil
 }
 
@@ -255,6 +281,14 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		errs := state.Outputs.Err.Error()
 		wsr.OutputErr = &errs
 	}
+
+	if len(state.Attempts) > 0 {
+		ab, err := json.Marshal(state.Attempts)
+		if err != nil {
+			return workflowStepRow{}, err
+		}
+		wsr.Attempts = ab
+	}
 	return wsr, nil
 }
 
@@ -318,8 +352,8 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, attempts, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :attempts, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
@@ -328,6 +362,7 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
+		attempts = EXCLUDED.attempts,
 		updated_at = EXCLUDED.updated_at;
 	`
 	stmt, args, err := sqlx.Named(sql, steps)
@@ -371,6 +406,7 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 		workflow_steps.inputs AS ws_inputs,
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.attempts AS ws_attempts,
 		workflow_steps.updated_at AS ws_updated_at,
 		workflow_executions.id AS we_id,
 		workflow_executions.workflow_id AS we_workflow_id,
@@ -406,6 +442,178 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 	return states, nil
 }
 
+// ListExecutions returns a page of executions matching filter, most recent first, along with the total number
+// of matching executions. Executions include all of their steps.
+func (d *DBStore) ListExecutions(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	for _, s := range filter.Statuses {
+		if !ValidStatuses[s] {
+			return nil, 0, fmt.Errorf("invalid workflow execution status %q", s)
+		}
+	}
+
+	var (
+		conds []string
+		args  []any
+	)
+	if filter.WorkflowID != "" {
+		args = append(args, filter.WorkflowID)
+		conds = append(conds, fmt.Sprintf("workflow_id = $%d", len(args)))
+	}
+	if len(filter.Statuses) > 0 {
+		args = append(args, pq.Array(filter.Statuses))
+		conds = append(conds, fmt.Sprintf("status::text = ANY($%d)", len(args)))
+	}
+	if filter.CreatedAfter != nil {
+		args = append(args, *filter.CreatedAfter)
+		conds = append(conds, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.CreatedBefore != nil {
+		args = append(args, *filter.CreatedBefore)
+		conds = append(conds, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var (
+		count int
+		rows  []workflowExecutionRow
+	)
+	err := d.transact(ctx, func(db *DBStore) error {
+		err := db.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions `+where, args...)
+		if err != nil {
+			return fmt.Errorf("failed to count workflow executions: %w", err)
+		}
+		sql := fmt.Sprintf(`SELECT * FROM workflow_executions %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+		if err = db.db.SelectContext(ctx, &rows, sql, append(args, limit, offset)...); err != nil {
+			return fmt.Errorf("failed to list workflow executions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, count, nil
+	}
+
+	ids := make([]string, len(rows))
+	executions := make([]WorkflowExecution, len(rows))
+	byID := make(map[string]*WorkflowExecution, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ID
+		executions[i] = WorkflowExecution{
+			ExecutionID: r.ID,
+			Status:      r.Status,
+			Steps:       map[string]*WorkflowExecutionStep{},
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+			FinishedAt:  r.FinishedAt,
+		}
+		if r.WorkflowID != nil {
+			executions[i].WorkflowID = *r.WorkflowID
+		}
+		byID[r.ID] = &executions[i]
+	}
+
+	var steps []workflowStepRow
+	err = d.db.SelectContext(ctx, &steps, `SELECT * FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflow steps: %w", err)
+	}
+	for _, s := range steps {
+		state, err := stepToState(s)
+		if err != nil {
+			return nil, 0, err
+		}
+		byID[s.WorkflowExecutionID].Steps[state.Ref] = state
+	}
+	return executions, count, nil
+}
+
+// CancelExecution marks an in-progress execution as cancelled, along with the given steps which have not finished yet.
+// ErrExecutionFinished is returned if the execution is not in progress.
+func (d *DBStore) CancelExecution(ctx context.Context, executionID string, pendingSteps []string) error {
+	return d.transact(ctx, func(db *DBStore) error {
+		now := db.clock.Now()
+		result, err := db.db.ExecContext(ctx, `UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = $2 WHERE id = $3 AND status = $4`,
+			StatusCancelled, now, executionID, StatusStarted)
+		if err != nil {
+			return fmt.Errorf("failed to cancel workflow execution: %w", err)
+		}
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			var exists bool
+			if err = db.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM workflow_executions WHERE id = $1)`, executionID); err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+			}
+			return ErrExecutionFinished
+		}
+
+		if len(pendingSteps) == 0 {
+			return nil
+		}
+		steps := make([]workflowStepRow, 0, len(pendingSteps))
+		for _, ref := range pendingSteps {
+			steps = append(steps, workflowStepRow{WorkflowExecutionID: executionID, Ref: ref, Status: StatusCancelled})
+		}
+		return db.upsertSteps(ctx, steps)
+	})
+}
+
+// DeleteFinishedExecutionsBefore deletes up to limit finished executions, oldest first, that finished before the given
+// time. Steps are removed along with their execution. The number of deleted executions is returned.
+func (d *DBStore) DeleteFinishedExecutionsBefore(ctx context.Context, before time.Time, limit uint32) (int64, error) {
+	sql := `
+WITH batched_executions AS (
+	SELECT id FROM workflow_executions
+	WHERE finished_at < $1
+	ORDER BY finished_at ASC
+	LIMIT $2
+)
+DELETE FROM workflow_executions
+USING batched_executions
+WHERE workflow_executions.id = batched_executions.id`
+	result, err := d.db.ExecContext(ctx, sql, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete finished workflow executions: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// DeleteExcessExecutions deletes up to limit finished executions which are not among the keep most recently created
+// executions of their workflow. The executions of each workflow are walked from the keep-th most recent one along
+// the (workflow_id, created_at) index, so the whole table is never ranked. The number of deleted executions is returned.
+func (d *DBStore) DeleteExcessExecutions(ctx context.Context, keep uint32, limit uint32) (int64, error) {
+	sql := `
+WITH batched_executions AS (
+	SELECT excess.id FROM workflow_specs
+	CROSS JOIN LATERAL (
+		SELECT id, finished_at FROM workflow_executions
+		WHERE workflow_executions.workflow_id = workflow_specs.workflow_id
+		ORDER BY created_at DESC, id
+		OFFSET $1
+	) excess
+	WHERE excess.finished_at IS NOT NULL
+	LIMIT $2
+)
+DELETE FROM workflow_executions
+USING batched_executions
+WHERE workflow_executions.id = batched_executions.id`
+	result, err := d.db.ExecContext(ctx, sql, keep, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete excess workflow executions: %w", err)
+	}
+	return result.RowsAffected()
+}
+
 func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, lggr: lggr.Named("WorkflowDBStore"), clock: clock}
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -162,6 +163,11 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 
 	stepOne.Inputs = nm
 	stepOne.Outputs = StepOutput{Err: errors.New("some error")}
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stepOne.Attempts = []StepAttempt{
+		{Attempt: 1, Error: "timeout", StartedAt: startedAt, FinishedAt: startedAt.Add(time.Second)},
+		{Attempt: 2, Error: "some error", StartedAt: startedAt.Add(2 * time.Second), FinishedAt: startedAt.Add(3 * time.Second)},
+	}
 
 	es, err = store.UpsertStep(tests.Context(t), stepOne)
 	require.NoError(t, err)
@@ -313,3 +319,170 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_ListExecutions(t *testing.T) {
+	store := newTestDBStore(t)
+	clock := store.clock.(clockwork.FakeClock)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	otherWid := randomID()
+	createWorkflow(t, store, otherWid)
+
+	start := clock.Now()
+	add := func(workflowID, status string, steps ...*WorkflowExecutionStep) string {
+		id := randomID()
+		es := WorkflowExecution{ExecutionID: id, WorkflowID: workflowID, Status: status, Steps: map[string]*WorkflowExecutionStep{}}
+		for _, s := range steps {
+			s.ExecutionID = id
+			es.Steps[s.Ref] = s
+		}
+		_, err := store.Add(ctx, &es)
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+		return id
+	}
+
+	errored := add(wid, StatusErrored,
+		&WorkflowExecutionStep{Ref: "trigger", Status: StatusCompleted, Inputs: values.EmptyMap()},
+		&WorkflowExecutionStep{Ref: "compute", Status: StatusErrored, Outputs: StepOutput{Err: errors.New("boom")}},
+	)
+	completed := add(wid, StatusCompleted, &WorkflowExecutionStep{Ref: "trigger", Status: StatusCompleted})
+	other := add(otherWid, StatusStarted)
+
+	t.Run("all", func(t *testing.T) {
+		executions, count, err := store.ListExecutions(ctx, ExecutionFilter{}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		require.Len(t, executions, 3)
+		assert.Equal(t, []string{other, completed, errored}, []string{executions[0].ExecutionID, executions[1].ExecutionID, executions[2].ExecutionID})
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		executions, count, err := store.ListExecutions(ctx, ExecutionFilter{}, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		require.Len(t, executions, 1)
+		assert.Equal(t, completed, executions[0].ExecutionID)
+	})
+
+	t.Run("by workflow and status", func(t *testing.T) {
+		executions, count, err := store.ListExecutions(ctx, ExecutionFilter{WorkflowID: wid, Statuses: []string{StatusErrored, StatusTimeout}}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, executions, 1)
+		assert.Equal(t, errored, executions[0].ExecutionID)
+		assert.Equal(t, wid, executions[0].WorkflowID)
+		require.Len(t, executions[0].Steps, 2)
+		assert.EqualError(t, executions[0].Steps["compute"].Outputs.Err, "boom")
+		assert.Equal(t, StatusCompleted, executions[0].Steps["trigger"].Status)
+	})
+
+	t.Run("by time range", func(t *testing.T) {
+		after := start.Add(30 * time.Second)
+		before := start.Add(90 * time.Second)
+		executions, count, err := store.ListExecutions(ctx, ExecutionFilter{CreatedAfter: &after, CreatedBefore: &before}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, executions, 1)
+		assert.Equal(t, completed, executions[0].ExecutionID)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, _, err := store.ListExecutions(ctx, ExecutionFilter{Statuses: []string{"bogus"}}, 0, 10)
+		require.ErrorContains(t, err, "invalid workflow execution status")
+	})
+}
+
+func Test_StoreDB_CancelExecution(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	id := randomID()
+	_, err := store.Add(ctx, &WorkflowExecution{ExecutionID: id, WorkflowID: wid, Status: StatusStarted, Steps: map[string]*WorkflowExecutionStep{
+		"trigger": {ExecutionID: id, Ref: "trigger", Status: StatusCompleted},
+	}})
+	require.NoError(t, err)
+
+	require.NoError(t, store.CancelExecution(ctx, id, []string{"compute", "target"}))
+
+	execution, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCancelled, execution.Status)
+	assert.NotNil(t, execution.FinishedAt)
+	require.Len(t, execution.Steps, 3)
+	assert.Equal(t, StatusCompleted, execution.Steps["trigger"].Status)
+	assert.Equal(t, StatusCancelled, execution.Steps["compute"].Status)
+	assert.Equal(t, StatusCancelled, execution.Steps["target"].Status)
+
+	err = store.CancelExecution(ctx, id, nil)
+	require.ErrorIs(t, err, ErrExecutionFinished)
+
+	err = store.UpdateStatus(ctx, id, StatusCompleted)
+	require.ErrorIs(t, err, ErrExecutionFinished)
+	execution, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCancelled, execution.Status, "a cancelled execution keeps its status")
+
+	err = store.CancelExecution(ctx, randomID(), nil)
+	require.ErrorIs(t, err, ErrExecutionNotFound)
+}
+
+func Test_StoreDB_DeleteExcessExecutions(t *testing.T) {
+	store := newTestDBStore(t)
+	clock := store.clock.(clockwork.FakeClock)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	otherWid := randomID()
+	createWorkflow(t, store, otherWid)
+
+	add := func(workflowID string, finished bool) string {
+		id := randomID()
+		_, err := store.Add(ctx, &WorkflowExecution{ExecutionID: id, WorkflowID: workflowID, Status: StatusStarted})
+		require.NoError(t, err)
+		if finished {
+			require.NoError(t, store.UpdateStatus(ctx, id, StatusCompleted))
+		}
+		return id
+	}
+
+	// oldest first
+	var excess []string
+	for i := 0; i < 2; i++ {
+		excess = append(excess, add(wid, true))
+		clock.Advance(time.Minute)
+	}
+	unfinished := add(wid, false)
+	clock.Advance(time.Minute)
+	kept := add(wid, true)
+	// executions created at the same time are ranked by id
+	tied := []string{add(otherWid, true), add(otherWid, true)}
+	if tied[0] > tied[1] {
+		tied[0], tied[1] = tied[1], tied[0]
+	}
+	excess = append(excess, tied[1])
+
+	deleted, err := store.DeleteExcessExecutions(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	deleted, err = store.DeleteExcessExecutions(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	deleted, err = store.DeleteExcessExecutions(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+
+	for _, id := range excess {
+		_, err = store.Get(ctx, id)
+		require.ErrorIs(t, err, ErrExecutionNotFound)
+	}
+	for _, id := range []string{unfinished, kept, tied[0]} {
+		_, err = store.Get(ctx, id)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,185 @@
+package syncer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// WorkflowRegistryEventType is the name of an event emitted by the WorkflowRegistry contract.
+type WorkflowRegistryEventType string
+
+const (
+	WorkflowRegisteredEvent        WorkflowRegistryEventType = "WorkflowRegisteredV1"
+	WorkflowUpdatedEvent           WorkflowRegistryEventType = "WorkflowUpdatedV1"
+	WorkflowPausedEvent            WorkflowRegistryEventType = "WorkflowPausedV1"
+	WorkflowActivatedEvent         WorkflowRegistryEventType = "WorkflowActivatedV1"
+	WorkflowDeletedEvent           WorkflowRegistryEventType = "WorkflowDeletedV1"
+	ForceUpdateSecretsRequestEvent WorkflowRegistryEventType = "WorkflowForceUpdateSecretsRequestedV1"
+)
+
+var eventTypes = []WorkflowRegistryEventType{
+	WorkflowRegisteredEvent,
+	WorkflowUpdatedEvent,
+	WorkflowPausedEvent,
+	WorkflowActivatedEvent,
+	WorkflowDeletedEvent,
+	ForceUpdateSecretsRequestEvent,
+}
+
+func eventTypeNames() []string {
+	names := make([]string, len(eventTypes))
+	for i, et := range eventTypes {
+		names[i] = string(et)
+	}
+	return names
+}
+
+// WorkflowStatus mirrors the WorkflowStatus enum of the WorkflowRegistry contract.
+type WorkflowStatus uint8
+
+const (
+	WorkflowStatusActive WorkflowStatus = iota
+	WorkflowStatusPaused
+)
+
+// WorkflowRegistryEvent is a decoded event read from the WorkflowRegistry contract.
+type WorkflowRegistryEvent struct {
+	Cursor    string
+	EventType WorkflowRegistryEventType
+	Head      types.Head
+	Data      any
+	// Status is the status of the event's workflow once the event is applied, as tracked by the
+	// registry from the events read so far. Update events do not carry a status of their own.
+	Status WorkflowStatus
+}
+
+type WorkflowRegistryWorkflowRegisteredV1 struct {
+	WorkflowID    [32]byte
+	WorkflowOwner [20]byte
+	DonID         uint32
+	Status        uint8
+	WorkflowName  string
+	BinaryURL     string
+	ConfigURL     string
+	SecretsURL    string
+}
+
+type WorkflowRegistryWorkflowUpdatedV1 struct {
+	OldWorkflowID [32]byte
+	WorkflowOwner [20]byte
+	DonID         uint32
+	NewWorkflowID [32]byte
+	WorkflowName  string
+	BinaryURL     string
+	ConfigURL     string
+	SecretsURL    string
+}
+
+type WorkflowRegistryWorkflowPausedV1 struct {
+	WorkflowID    [32]byte
+	WorkflowOwner [20]byte
+	DonID         uint32
+	WorkflowName  string
+}
+
+type WorkflowRegistryWorkflowActivatedV1 struct {
+	WorkflowID    [32]byte
+	WorkflowOwner [20]byte
+	DonID         uint32
+	WorkflowName  string
+}
+
+type WorkflowRegistryWorkflowDeletedV1 struct {
+	WorkflowID    [32]byte
+	WorkflowOwner [20]byte
+	DonID         uint32
+	WorkflowName  string
+}
+
+type WorkflowRegistryForceUpdateSecretsRequestedV1 struct {
+	Owner          [20]byte
+	SecretsURLHash [32]byte
+	WorkflowName   string
+}
+
+// newEventData returns a pointer to the struct the given event type decodes into.
+func newEventData(et WorkflowRegistryEventType) any {
+	switch et {
+	case WorkflowRegisteredEvent:
+		return &WorkflowRegistryWorkflowRegisteredV1{}
+	case WorkflowUpdatedEvent:
+		return &WorkflowRegistryWorkflowUpdatedV1{}
+	case WorkflowPausedEvent:
+		return &WorkflowRegistryWorkflowPausedV1{}
+	case WorkflowActivatedEvent:
+		return &WorkflowRegistryWorkflowActivatedV1{}
+	case WorkflowDeletedEvent:
+		return &WorkflowRegistryWorkflowDeletedV1{}
+	case ForceUpdateSecretsRequestEvent:
+		return &WorkflowRegistryForceUpdateSecretsRequestedV1{}
+	default:
+		return nil
+	}
+}
+
+func toWorkflowRegistryEvent(et WorkflowRegistryEventType, seq types.Sequence) (WorkflowRegistryEvent, error) {
+	event := WorkflowRegistryEvent{Cursor: seq.Cursor, EventType: et, Head: seq.Head}
+	switch data := seq.Data.(type) {
+	case *WorkflowRegistryWorkflowRegisteredV1:
+		event.Data = *data
+	case *WorkflowRegistryWorkflowUpdatedV1:
+		event.Data = *data
+	case *WorkflowRegistryWorkflowPausedV1:
+		event.Data = *data
+	case *WorkflowRegistryWorkflowActivatedV1:
+		event.Data = *data
+	case *WorkflowRegistryWorkflowDeletedV1:
+		event.Data = *data
+	case *WorkflowRegistryForceUpdateSecretsRequestedV1:
+		event.Data = *data
+	default:
+		return event, fmt.Errorf("unexpected data type %T for event %s", seq.Data, et)
+	}
+	return event, nil
+}
+
+// donID returns the DON the event is scoped to. Events that are not scoped to a DON,
+// such as secrets refresh requests, return false.
+func (e WorkflowRegistryEvent) donID() (uint32, bool) {
+	switch data := e.Data.(type) {
+	case WorkflowRegistryWorkflowRegisteredV1:
+		return data.DonID, true
+	case WorkflowRegistryWorkflowUpdatedV1:
+		return data.DonID, true
+	case WorkflowRegistryWorkflowPausedV1:
+		return data.DonID, true
+	case WorkflowRegistryWorkflowActivatedV1:
+		return data.DonID, true
+	case WorkflowRegistryWorkflowDeletedV1:
+		return data.DonID, true
+	default:
+		return 0, false
+	}
+}
+
+// workflowRef returns the workflow the event is about.
+func (e WorkflowRegistryEvent) workflowRef() workflowRef {
+	switch data := e.Data.(type) {
+	case WorkflowRegistryWorkflowRegisteredV1:
+		return workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+	case WorkflowRegistryWorkflowUpdatedV1:
+		return workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+	case WorkflowRegistryWorkflowPausedV1:
+		return workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+	case WorkflowRegistryWorkflowActivatedV1:
+		return workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+	case WorkflowRegistryWorkflowDeletedV1:
+		return workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+	case WorkflowRegistryForceUpdateSecretsRequestedV1:
+		return workflowRef{owner: hex.EncodeToString(data.Owner[:]), name: data.WorkflowName}
+	default:
+		return workflowRef{}
+	}
+}
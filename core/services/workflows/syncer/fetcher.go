@@ -0,0 +1,40 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// defaultMaxArtifactSize caps the size of workflow binaries, configs and secrets downloaded from the registry URLs.
+const defaultMaxArtifactSize = 20 * 1024 * 1024
+
+// NewHTTPFetcher returns a FetcherFunc that downloads artifacts with a GET request using the given client.
+func NewHTTPFetcher(client *http.Client) FetcherFunc {
+	return func(ctx context.Context, url string) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, defaultMaxArtifactSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > defaultMaxArtifactSize {
+			return nil, fmt.Errorf("artifact exceeds maximum size of %d bytes", defaultMaxArtifactSize)
+		}
+		return body, nil
+	}
+}
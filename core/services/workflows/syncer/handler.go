@@ -0,0 +1,240 @@
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// JobSpawner is the subset of job.Spawner used to manage workflow jobs.
+type JobSpawner interface {
+	CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *job.Job) error
+	DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+}
+
+// JobFinder is the subset of job.ORM used to look up existing workflow jobs.
+type JobFinder interface {
+	FindJobIDByWorkflow(ctx context.Context, spec job.WorkflowSpec) (int32, error)
+}
+
+// jobHandler keeps the node's workflow jobs in sync with the workflow registry. Workflow
+// artifacts are downloaded to dir and referenced from a wasm_file workflow spec.
+type jobHandler struct {
+	lggr    logger.Logger
+	fetcher FetcherFunc
+	spawner JobSpawner
+	finder  JobFinder
+	dir     string
+}
+
+var _ EventHandler = (*jobHandler)(nil)
+
+// NewJobHandler returns an EventHandler that registers, pauses, updates and deletes workflow
+// jobs as registry events arrive.
+func NewJobHandler(lggr logger.Logger, fetcher FetcherFunc, spawner JobSpawner, finder JobFinder, dir string) EventHandler {
+	return &jobHandler{
+		lggr:    lggr.Named("WorkflowJobHandler"),
+		fetcher: fetcher,
+		spawner: spawner,
+		finder:  finder,
+		dir:     dir,
+	}
+}
+
+func (h *jobHandler) Handle(ctx context.Context, event WorkflowRegistryEvent) error {
+	switch data := event.Data.(type) {
+	case WorkflowRegistryWorkflowRegisteredV1:
+		return h.handleRegistered(ctx, data)
+	case WorkflowRegistryWorkflowUpdatedV1:
+		return h.handleUpdated(ctx, data, event.Status)
+	case WorkflowRegistryWorkflowPausedV1:
+		return h.deleteJob(ctx, hex.EncodeToString(data.WorkflowOwner[:]), data.WorkflowName)
+	case WorkflowRegistryWorkflowActivatedV1:
+		return h.handleActivated(ctx, data)
+	case WorkflowRegistryWorkflowDeletedV1:
+		if err := h.deleteJob(ctx, hex.EncodeToString(data.WorkflowOwner[:]), data.WorkflowName); err != nil {
+			return err
+		}
+		return h.removeArtifacts(hex.EncodeToString(data.WorkflowID[:]))
+	case WorkflowRegistryForceUpdateSecretsRequestedV1:
+		// secrets are re-fetched lazily by the registry
+		return nil
+	default:
+		return fmt.Errorf("unsupported event type %s", event.EventType)
+	}
+}
+
+func (h *jobHandler) handleRegistered(ctx context.Context, data WorkflowRegistryWorkflowRegisteredV1) error {
+	workflowID := hex.EncodeToString(data.WorkflowID[:])
+	if err := h.storeArtifacts(ctx, workflowID, data.BinaryURL, data.ConfigURL); err != nil {
+		return err
+	}
+
+	if WorkflowStatus(data.Status) == WorkflowStatusPaused {
+		h.lggr.Debugw("workflow registered in paused state; not starting", "workflowID", workflowID)
+		return nil
+	}
+
+	return h.createJob(ctx, workflowID, hex.EncodeToString(data.WorkflowOwner[:]), data.WorkflowName)
+}
+
+func (h *jobHandler) handleUpdated(ctx context.Context, data WorkflowRegistryWorkflowUpdatedV1, status WorkflowStatus) error {
+	owner := hex.EncodeToString(data.WorkflowOwner[:])
+	workflowID := hex.EncodeToString(data.NewWorkflowID[:])
+	// Store the new artifacts before touching the running job, so that a failed fetch leaves the
+	// previous version running and the event can be retried.
+	if err := h.storeArtifacts(ctx, workflowID, data.BinaryURL, data.ConfigURL); err != nil {
+		return err
+	}
+
+	if err := h.deleteJob(ctx, owner, data.WorkflowName); err != nil {
+		return err
+	}
+	if oldWorkflowID := hex.EncodeToString(data.OldWorkflowID[:]); oldWorkflowID != workflowID {
+		if err := h.removeArtifacts(oldWorkflowID); err != nil {
+			h.lggr.Warnw("failed to remove artifacts for previous workflow version", "err", err)
+		}
+	}
+
+	if status == WorkflowStatusPaused {
+		h.lggr.Debugw("updated workflow is paused; not starting", "workflowID", workflowID)
+		return nil
+	}
+	return h.createJob(ctx, workflowID, owner, data.WorkflowName)
+}
+
+func (h *jobHandler) handleActivated(ctx context.Context, data WorkflowRegistryWorkflowActivatedV1) error {
+	workflowID := hex.EncodeToString(data.WorkflowID[:])
+	if _, err := os.Stat(h.binaryPath(workflowID)); err != nil {
+		return fmt.Errorf("cannot activate workflow %s: artifacts not found: %w", workflowID, err)
+	}
+	return h.createJob(ctx, workflowID, hex.EncodeToString(data.WorkflowOwner[:]), data.WorkflowName)
+}
+
+func (h *jobHandler) createJob(ctx context.Context, workflowID, owner, name string) error {
+	existing, err := h.findJob(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		h.lggr.Debugw("workflow job already exists; skipping", "workflowID", workflowID, "jobID", *existing)
+		return nil
+	}
+
+	spec := job.WorkflowSpec{
+		Workflow: h.binaryPath(workflowID),
+		Config:   h.configPath(workflowID),
+		SpecType: job.WASMFile,
+	}
+	if err = spec.Validate(ctx); err != nil {
+		return fmt.Errorf("invalid workflow %s: %w", workflowID, err)
+	}
+
+	// The registry is the source of truth for identity; reject artifacts that do not
+	// hash to the registered ID or that declare a different owner or name.
+	if spec.WorkflowID != workflowID {
+		return fmt.Errorf("workflow ID mismatch: registry has %s, artifacts hash to %s", workflowID, spec.WorkflowID)
+	}
+	if spec.WorkflowOwner != owner || spec.WorkflowName != name {
+		return fmt.Errorf("workflow identity mismatch: registry has (%s,%s), spec declares (%s,%s)", owner, name, spec.WorkflowOwner, spec.WorkflowName)
+	}
+
+	jb := job.Job{
+		ExternalJobID: uuid.New(),
+		Type:          job.Workflow,
+		SchemaVersion: 1,
+		Name:          null.StringFrom(name),
+		WorkflowSpec:  &spec,
+	}
+	if err = h.spawner.CreateJob(ctx, nil, &jb); err != nil {
+		return fmt.Errorf("failed to create job for workflow %s: %w", workflowID, err)
+	}
+
+	h.lggr.Infow("created workflow job", "workflowID", workflowID, "jobID", jb.ID)
+	return nil
+}
+
+func (h *jobHandler) deleteJob(ctx context.Context, owner, name string) error {
+	jobID, err := h.findJob(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	if jobID == nil {
+		return nil
+	}
+
+	if err = h.spawner.DeleteJob(ctx, nil, *jobID); err != nil {
+		return fmt.Errorf("failed to delete job %d: %w", *jobID, err)
+	}
+
+	h.lggr.Infow("deleted workflow job", "workflowOwner", owner, "workflowName", name, "jobID", *jobID)
+	return nil
+}
+
+func (h *jobHandler) findJob(ctx context.Context, owner, name string) (*int32, error) {
+	jobID, err := h.finder.FindJobIDByWorkflow(ctx, job.WorkflowSpec{WorkflowOwner: owner, WorkflowName: name})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &jobID, nil
+}
+
+func (h *jobHandler) storeArtifacts(ctx context.Context, workflowID, binaryURL, configURL string) error {
+	binary, err := h.fetcher(ctx, binaryURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch binary from %s: %w", binaryURL, err)
+	}
+
+	var config []byte
+	if configURL != "" {
+		config, err = h.fetcher(ctx, configURL)
+		if err != nil {
+			return fmt.Errorf("failed to fetch config from %s: %w", configURL, err)
+		}
+	}
+
+	if err = os.MkdirAll(h.dir, 0700); err != nil {
+		return err
+	}
+	if err = os.WriteFile(h.binaryPath(workflowID), binary, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(h.configPath(workflowID), config, 0600)
+}
+
+func (h *jobHandler) removeArtifacts(workflowID string) error {
+	return errors.Join(
+		removeIfExists(h.binaryPath(workflowID)),
+		removeIfExists(h.configPath(workflowID)),
+	)
+}
+
+func (h *jobHandler) binaryPath(workflowID string) string {
+	return filepath.Join(h.dir, workflowID+".wasm")
+}
+
+func (h *jobHandler) configPath(workflowID string) string {
+	return filepath.Join(h.dir, workflowID+".config")
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
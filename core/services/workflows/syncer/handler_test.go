@@ -0,0 +1,287 @@
+package syncer
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/wasmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+type fakeJobs struct {
+	jobs    map[string]int32
+	deleted []int32
+	created []job.Job
+}
+
+func (f *fakeJobs) CreateJob(_ context.Context, _ sqlutil.DataSource, jb *job.Job) error {
+	f.created = append(f.created, *jb)
+	return nil
+}
+
+func (f *fakeJobs) DeleteJob(_ context.Context, _ sqlutil.DataSource, jobID int32) error {
+	f.deleted = append(f.deleted, jobID)
+	for k, id := range f.jobs {
+		if id == jobID {
+			delete(f.jobs, k)
+		}
+	}
+	return nil
+}
+
+func (f *fakeJobs) FindJobIDByWorkflow(_ context.Context, spec job.WorkflowSpec) (int32, error) {
+	id, ok := f.jobs[spec.WorkflowOwner+"/"+spec.WorkflowName]
+	if !ok {
+		return 0, fmt.Errorf("FindJobIDByWorkflow failed: %w", sql.ErrNoRows)
+	}
+	return id, nil
+}
+
+func TestJobHandler(t *testing.T) {
+	owner := [20]byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	ownerHex := hex.EncodeToString(owner[:])
+	workflowID := [32]byte{1}
+	ctx := testutils.Context(t)
+
+	fetcher := func(_ context.Context, url string) ([]byte, error) {
+		switch url {
+		case "https://binary":
+			return []byte("not a wasm binary"), nil
+		case "https://config":
+			return []byte("config"), nil
+		default:
+			return nil, errors.New("not found")
+		}
+	}
+
+	t.Run("registering a paused workflow stores artifacts without creating a job", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir()).(*jobHandler)
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowRegisteredEvent,
+			Data: WorkflowRegistryWorkflowRegisteredV1{
+				WorkflowID:    workflowID,
+				WorkflowOwner: owner,
+				Status:        uint8(WorkflowStatusPaused),
+				WorkflowName:  "wf",
+				BinaryURL:     "https://binary",
+				ConfigURL:     "https://config",
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, jobs.created)
+
+		config, err := os.ReadFile(h.configPath(hex.EncodeToString(workflowID[:])))
+		require.NoError(t, err)
+		assert.Equal(t, "config", string(config))
+	})
+
+	t.Run("registering an active workflow with an invalid binary fails", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir())
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowRegisteredEvent,
+			Data: WorkflowRegistryWorkflowRegisteredV1{
+				WorkflowID:    workflowID,
+				WorkflowOwner: owner,
+				WorkflowName:  "wf",
+				BinaryURL:     "https://binary",
+				ConfigURL:     "https://config",
+			},
+		})
+		require.ErrorContains(t, err, "invalid workflow")
+		assert.Empty(t, jobs.created)
+	})
+
+	t.Run("fetch failures are surfaced", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir())
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowRegisteredEvent,
+			Data: WorkflowRegistryWorkflowRegisteredV1{
+				WorkflowID:    workflowID,
+				WorkflowOwner: owner,
+				WorkflowName:  "wf",
+				BinaryURL:     "https://missing",
+			},
+		})
+		require.ErrorContains(t, err, "failed to fetch binary")
+	})
+
+	t.Run("pausing deletes the job", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{ownerHex + "/wf": 7}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir())
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowPausedEvent,
+			Data:      WorkflowRegistryWorkflowPausedV1{WorkflowID: workflowID, WorkflowOwner: owner, WorkflowName: "wf"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int32{7}, jobs.deleted)
+	})
+
+	t.Run("updating a paused workflow stores artifacts without creating a job", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir()).(*jobHandler)
+		newWorkflowID := [32]byte{2}
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowUpdatedEvent,
+			Data: WorkflowRegistryWorkflowUpdatedV1{
+				OldWorkflowID: workflowID,
+				WorkflowOwner: owner,
+				NewWorkflowID: newWorkflowID,
+				WorkflowName:  "wf",
+				BinaryURL:     "https://binary",
+				ConfigURL:     "https://config",
+			},
+			Status: WorkflowStatusPaused,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, jobs.deleted)
+		assert.Empty(t, jobs.created)
+
+		_, err = os.Stat(h.binaryPath(hex.EncodeToString(newWorkflowID[:])))
+		require.NoError(t, err)
+	})
+
+	t.Run("updating an active workflow replaces the job", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{ownerHex + "/wf": 7}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir())
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowUpdatedEvent,
+			Data: WorkflowRegistryWorkflowUpdatedV1{
+				OldWorkflowID: workflowID,
+				WorkflowOwner: owner,
+				NewWorkflowID: [32]byte{2},
+				WorkflowName:  "wf",
+				BinaryURL:     "https://binary",
+				ConfigURL:     "https://config",
+			},
+		})
+		// the old job is removed before the new artifacts are validated
+		require.ErrorContains(t, err, "invalid workflow")
+		assert.Equal(t, []int32{7}, jobs.deleted)
+	})
+
+	t.Run("a failed fetch on update keeps the previous job and artifacts", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{ownerHex + "/wf": 7}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir()).(*jobHandler)
+		require.NoError(t, h.storeArtifacts(ctx, hex.EncodeToString(workflowID[:]), "https://binary", "https://config"))
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowUpdatedEvent,
+			Data: WorkflowRegistryWorkflowUpdatedV1{
+				OldWorkflowID: workflowID,
+				WorkflowOwner: owner,
+				NewWorkflowID: [32]byte{2},
+				WorkflowName:  "wf",
+				BinaryURL:     "https://missing",
+			},
+		})
+		require.ErrorContains(t, err, "failed to fetch binary")
+		assert.Empty(t, jobs.deleted)
+
+		_, err = os.Stat(h.binaryPath(hex.EncodeToString(workflowID[:])))
+		require.NoError(t, err)
+	})
+
+	t.Run("retrying an update of an active workflow without a job starts it", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir())
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowUpdatedEvent,
+			Data: WorkflowRegistryWorkflowUpdatedV1{
+				OldWorkflowID: workflowID,
+				WorkflowOwner: owner,
+				NewWorkflowID: [32]byte{2},
+				WorkflowName:  "wf",
+				BinaryURL:     "https://binary",
+				ConfigURL:     "https://config",
+			},
+			Status: WorkflowStatusActive,
+		})
+		// the job is created from the new artifacts rather than the workflow being treated as paused
+		require.ErrorContains(t, err, "invalid workflow")
+	})
+
+	t.Run("deleting removes the job and artifacts", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{ownerHex + "/wf": 7}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir()).(*jobHandler)
+		require.NoError(t, h.storeArtifacts(ctx, hex.EncodeToString(workflowID[:]), "https://binary", "https://config"))
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowDeletedEvent,
+			Data:      WorkflowRegistryWorkflowDeletedV1{WorkflowID: workflowID, WorkflowOwner: owner, WorkflowName: "wf"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int32{7}, jobs.deleted)
+
+		_, err = os.Stat(h.binaryPath(hex.EncodeToString(workflowID[:])))
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("deleting an unknown workflow is a noop", func(t *testing.T) {
+		jobs := &fakeJobs{jobs: map[string]int32{}}
+		h := NewJobHandler(logger.TestLogger(t), fetcher, jobs, jobs, t.TempDir())
+
+		err := h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowDeletedEvent,
+			Data:      WorkflowRegistryWorkflowDeletedV1{WorkflowID: workflowID, WorkflowOwner: owner, WorkflowName: "wf"},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, jobs.deleted)
+	})
+
+	t.Run("registering an active workflow whose spec declares a checksummed owner creates the job", func(t *testing.T) {
+		binary := wasmtest.CreateTestBinary("core/services/job/testdata/wasm", filepath.Join(t.TempDir(), "testmodule.wasm"), false, t)
+		config, err := json.Marshal(sdk.NewWorkflowParams{Owner: common.Address(owner).Hex(), Name: "wf"})
+		require.NoError(t, err)
+		id := sha256.Sum256(append(append([]byte{}, binary...), config...))
+
+		wasmFetcher := func(_ context.Context, url string) ([]byte, error) {
+			if url == "https://binary" {
+				return binary, nil
+			}
+			return config, nil
+		}
+		jobs := &fakeJobs{jobs: map[string]int32{}}
+		h := NewJobHandler(logger.TestLogger(t), wasmFetcher, jobs, jobs, t.TempDir())
+
+		err = h.Handle(ctx, WorkflowRegistryEvent{
+			EventType: WorkflowRegisteredEvent,
+			Data: WorkflowRegistryWorkflowRegisteredV1{
+				WorkflowID:    id,
+				WorkflowOwner: owner,
+				WorkflowName:  "wf",
+				BinaryURL:     "https://binary",
+				ConfigURL:     "https://config",
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, jobs.created, 1)
+		assert.Equal(t, ownerHex, jobs.created[0].WorkflowSpec.WorkflowOwner, "owners are stored in lower case")
+	})
+}
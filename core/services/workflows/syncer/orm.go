@@ -0,0 +1,86 @@
+package syncer
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ORM persists how far the syncer got in handling the events of a workflow registry, so that
+// handlers are not re-run for events they already processed after a restart.
+type ORM interface {
+	// LatestCursors returns the cursor of the last handled event of each type.
+	LatestCursors(ctx context.Context, contractAddress string) (map[WorkflowRegistryEventType]string, error)
+	// UpsertCursor records that all events of the given type up to cursor have been handled,
+	// apart from the pending ones.
+	UpsertCursor(ctx context.Context, contractAddress string, eventType WorkflowRegistryEventType, cursor string) error
+	// PendingEvents returns the cursors of the events that are still waiting to be handled.
+	PendingEvents(ctx context.Context, contractAddress string) (map[string]WorkflowRegistryEventType, error)
+	// AddPendingEvent records that the event at cursor has yet to be handled.
+	AddPendingEvent(ctx context.Context, contractAddress string, eventType WorkflowRegistryEventType, cursor string) error
+	// DeletePendingEvent records that the event at cursor has been handled.
+	DeletePendingEvent(ctx context.Context, contractAddress string, cursor string) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) LatestCursors(ctx context.Context, contractAddress string) (map[WorkflowRegistryEventType]string, error) {
+	var rows []struct {
+		EventType string `db:"event_type"`
+		Cursor    string `db:"cursor"`
+	}
+	err := o.ds.SelectContext(ctx, &rows, `SELECT event_type, cursor FROM workflow_registry_cursors WHERE contract_address = $1`, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	cursors := make(map[WorkflowRegistryEventType]string, len(rows))
+	for _, r := range rows {
+		cursors[WorkflowRegistryEventType(r.EventType)] = r.Cursor
+	}
+	return cursors, nil
+}
+
+func (o *orm) UpsertCursor(ctx context.Context, contractAddress string, eventType WorkflowRegistryEventType, cursor string) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO workflow_registry_cursors (contract_address, event_type, cursor)
+VALUES ($1, $2, $3)
+ON CONFLICT (contract_address, event_type) DO UPDATE SET cursor = EXCLUDED.cursor, updated_at = now()`,
+		contractAddress, string(eventType), cursor)
+	return err
+}
+
+func (o *orm) PendingEvents(ctx context.Context, contractAddress string) (map[string]WorkflowRegistryEventType, error) {
+	var rows []struct {
+		Cursor    string `db:"cursor"`
+		EventType string `db:"event_type"`
+	}
+	err := o.ds.SelectContext(ctx, &rows, `SELECT cursor, event_type FROM workflow_registry_pending_events WHERE contract_address = $1`, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[string]WorkflowRegistryEventType, len(rows))
+	for _, r := range rows {
+		pending[r.Cursor] = WorkflowRegistryEventType(r.EventType)
+	}
+	return pending, nil
+}
+
+func (o *orm) AddPendingEvent(ctx context.Context, contractAddress string, eventType WorkflowRegistryEventType, cursor string) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO workflow_registry_pending_events (contract_address, cursor, event_type)
+VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`, contractAddress, cursor, string(eventType))
+	return err
+}
+
+func (o *orm) DeletePendingEvent(ctx context.Context, contractAddress string, cursor string) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM workflow_registry_pending_events WHERE contract_address = $1 AND cursor = $2`, contractAddress, cursor)
+	return err
+}
@@ -0,0 +1,60 @@
+package syncer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestORM_Cursors(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	o := NewORM(db)
+
+	cursors, err := o.LatestCursors(ctx, "0xabc")
+	require.NoError(t, err)
+	assert.Empty(t, cursors)
+
+	require.NoError(t, o.UpsertCursor(ctx, "0xabc", WorkflowRegisteredEvent, "1-0-0x01"))
+	require.NoError(t, o.UpsertCursor(ctx, "0xabc", WorkflowRegisteredEvent, "2-0-0x02"))
+	require.NoError(t, o.UpsertCursor(ctx, "0xabc", WorkflowPausedEvent, "3-0-0x03"))
+	require.NoError(t, o.UpsertCursor(ctx, "0xdef", WorkflowPausedEvent, "4-0-0x04"))
+
+	cursors, err = o.LatestCursors(ctx, "0xabc")
+	require.NoError(t, err)
+	assert.Equal(t, map[WorkflowRegistryEventType]string{
+		WorkflowRegisteredEvent: "2-0-0x02",
+		WorkflowPausedEvent:     "3-0-0x03",
+	}, cursors)
+}
+
+func TestORM_PendingEvents(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	o := NewORM(db)
+
+	pending, err := o.PendingEvents(ctx, "0xabc")
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	require.NoError(t, o.AddPendingEvent(ctx, "0xabc", WorkflowRegisteredEvent, "1-0-0x01"))
+	require.NoError(t, o.AddPendingEvent(ctx, "0xabc", WorkflowRegisteredEvent, "1-0-0x01"))
+	require.NoError(t, o.AddPendingEvent(ctx, "0xabc", WorkflowUpdatedEvent, "2-0-0x02"))
+	require.NoError(t, o.AddPendingEvent(ctx, "0xdef", WorkflowPausedEvent, "3-0-0x03"))
+
+	pending, err = o.PendingEvents(ctx, "0xabc")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]WorkflowRegistryEventType{
+		"1-0-0x01": WorkflowRegisteredEvent,
+		"2-0-0x02": WorkflowUpdatedEvent,
+	}, pending)
+
+	require.NoError(t, o.DeletePendingEvent(ctx, "0xabc", "1-0-0x01"))
+	pending, err = o.PendingEvents(ctx, "0xabc")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]WorkflowRegistryEventType{"2-0-0x02": WorkflowUpdatedEvent}, pending)
+}
@@ -1,40 +1,696 @@
 package syncer
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/secrets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowkey"
+	evmrelaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
 )
 
+const (
+	workflowRegistryContractName = "WorkflowRegistry"
+
+	defaultPollInterval = 12 * time.Second
+	defaultQueryCount   = 100
+	defaultFetchTimeout = 30 * time.Second
+
+	// maxDispatchAttempts is how many times an event is handed to the handlers before it is left
+	// pending, to be retried on the next sync.
+	maxDispatchAttempts = 5
+)
+
+// ErrWorkflowKeyNotFound is returned when the node has no workflow key to decrypt secrets with.
+var ErrWorkflowKeyNotFound = errors.New("no workflow key found")
+
+// WorkflowRegistryABI contains the events emitted by the WorkflowRegistry contract
+// that the syncer consumes.
+const WorkflowRegistryABI = `[
+{"type":"event","name":"WorkflowRegisteredV1","anonymous":false,"inputs":[
+	{"name":"workflowID","type":"bytes32","indexed":true},
+	{"name":"workflowOwner","type":"address","indexed":true},
+	{"name":"donID","type":"uint32","indexed":true},
+	{"name":"status","type":"uint8","indexed":false},
+	{"name":"workflowName","type":"string","indexed":false},
+	{"name":"binaryURL","type":"string","indexed":false},
+	{"name":"configURL","type":"string","indexed":false},
+	{"name":"secretsURL","type":"string","indexed":false}]},
+{"type":"event","name":"WorkflowUpdatedV1","anonymous":false,"inputs":[
+	{"name":"oldWorkflowID","type":"bytes32","indexed":true},
+	{"name":"workflowOwner","type":"address","indexed":true},
+	{"name":"donID","type":"uint32","indexed":true},
+	{"name":"newWorkflowID","type":"bytes32","indexed":false},
+	{"name":"workflowName","type":"string","indexed":false},
+	{"name":"binaryURL","type":"string","indexed":false},
+	{"name":"configURL","type":"string","indexed":false},
+	{"name":"secretsURL","type":"string","indexed":false}]},
+{"type":"event","name":"WorkflowPausedV1","anonymous":false,"inputs":[
+	{"name":"workflowID","type":"bytes32","indexed":true},
+	{"name":"workflowOwner","type":"address","indexed":true},
+	{"name":"donID","type":"uint32","indexed":true},
+	{"name":"workflowName","type":"string","indexed":false}]},
+{"type":"event","name":"WorkflowActivatedV1","anonymous":false,"inputs":[
+	{"name":"workflowID","type":"bytes32","indexed":true},
+	{"name":"workflowOwner","type":"address","indexed":true},
+	{"name":"donID","type":"uint32","indexed":true},
+	{"name":"workflowName","type":"string","indexed":false}]},
+{"type":"event","name":"WorkflowDeletedV1","anonymous":false,"inputs":[
+	{"name":"workflowID","type":"bytes32","indexed":true},
+	{"name":"workflowOwner","type":"address","indexed":true},
+	{"name":"donID","type":"uint32","indexed":true},
+	{"name":"workflowName","type":"string","indexed":false}]},
+{"type":"event","name":"WorkflowForceUpdateSecretsRequestedV1","anonymous":false,"inputs":[
+	{"name":"owner","type":"address","indexed":true},
+	{"name":"secretsURLHash","type":"bytes32","indexed":false},
+	{"name":"workflowName","type":"string","indexed":false}]}
+]`
+
+// ContractReaderFactory creates contract readers against the chain the registry is deployed to.
+type ContractReaderFactory interface {
+	NewContractReader(context.Context, []byte) (types.ContractReader, error)
+}
+
+// LogPoller is the subset of logpoller.LogPoller the contract reader reads events from. Its
+// latest finalized block bounds every event query of a sync.
+type LogPoller interface {
+	LatestBlock(ctx context.Context) (logpoller.LogPollerBlock, error)
+}
+
+// FetcherFunc retrieves the artifact (binary, config or secrets) stored at the given URL.
+type FetcherFunc func(ctx context.Context, url string) ([]byte, error)
+
+// EventHandler is notified of every event read from the workflow registry, in chain order for
+// each workflow. Events are delivered at least once: an event is handed to the handlers again,
+// with backoff, if any of them failed to handle it. An event that still fails after
+// maxDispatchAttempts is left pending and retried on every sync, together with the later events
+// of the same workflow, so that it does not hold back the events of other workflows.
+type EventHandler interface {
+	Handle(ctx context.Context, event WorkflowRegistryEvent) error
+}
+
+// LocalNodeProvider returns this node as seen by the capabilities registry. The syncer only
+// acts on events for the workflow DON the node belongs to.
+type LocalNodeProvider interface {
+	LocalNode(ctx context.Context) (capabilities.Node, error)
+}
+
+type workflowRef struct {
+	owner string
+	name  string
+}
+
+type workflowRecord struct {
+	id         string
+	status     WorkflowStatus
+	secretsURL string
+}
+
+// WorkflowRegistry polls the WorkflowRegistry contract for workflow lifecycle events and
+// dispatches them to the registered handlers. It also serves as the secrets source for
+// workflow engines: secrets referenced by a registered workflow are fetched and decrypted
+// with the node's workflow key the first time they are requested.
 type WorkflowRegistry struct {
 	services.StateMachine
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+	lggr   logger.Logger
+
+	relayer    ContractReaderFactory
+	lp         LogPoller
+	reader     types.ContractReader
+	initReader func(ctx context.Context, relayer ContractReaderFactory, contract types.BoundContract) (types.ContractReader, error)
+	contract   types.BoundContract
+
+	fetcher      FetcherFunc
+	workflowKey  func() (workflowkey.Key, error)
+	localNode    LocalNodeProvider
+	orm          ORM
+	pollInterval time.Duration
+
+	handlersMu sync.RWMutex
+	handlers   []EventHandler
+	// retryBackoff spaces out the attempts to dispatch an event.
+	retryBackoff backoff.Backoff
+
+	// cursors tracks the last sequence read per event type. Events are read from the start
+	// of the chain after every restart to rebuild the in-memory workflow state.
+	cursors map[WorkflowRegistryEventType]string
+	// handled tracks the last sequence per event type that all handlers processed
+	// successfully. It is persisted and loaded on the first sync.
+	handled map[WorkflowRegistryEventType]string
+
+	mu        sync.RWMutex
+	workflows map[workflowRef]workflowRecord
+	// secrets caches the decrypted secrets keyed by the URL they were fetched from.
+	secrets map[string]map[string]string
+
+	// pendingCursors holds the persisted cursors of the events that are still to be handled. It
+	// is loaded on the first sync, so that pending events are queued again as they are re-read.
+	pendingCursors map[string]WorkflowRegistryEventType
+
+	pendingMu sync.RWMutex
+	// pending queues, per workflow, the events that failed to be handled and the events of the
+	// same workflow that came after them, oldest first. They are reported by HealthReport.
+	pending map[workflowRef]*pendingEvents
 }
 
-func (w *WorkflowRegistry) Start(ctx context.Context) error {
-	return nil
+type pendingEvents struct {
+	events []WorkflowRegistryEvent
+	// err is the error of the last attempt to handle the first event.
+	err error
+}
+
+var _ services.Service = (*WorkflowRegistry)(nil)
+
+// NewWorkflowRegistry returns a syncer for the WorkflowRegistry contract deployed at addr.
+// If addr is empty, the syncer is inert and SecretsFor always returns an empty set.
+func NewWorkflowRegistry(
+	lggr logger.Logger,
+	relayer ContractReaderFactory,
+	lp LogPoller,
+	addr string,
+	fetcher FetcherFunc,
+	workflowKey func() (workflowkey.Key, error),
+	localNode LocalNodeProvider,
+	orm ORM,
+) *WorkflowRegistry {
+	return &WorkflowRegistry{
+		stopCh:  make(services.StopChan),
+		lggr:    lggr.Named("WorkflowRegistrySyncer"),
+		relayer: relayer,
+		lp:      lp,
+		contract: types.BoundContract{
+			Address: addr,
+			Name:    workflowRegistryContractName,
+		},
+		initReader:   newReader,
+		fetcher:      fetcher,
+		workflowKey:  workflowKey,
+		localNode:    localNode,
+		orm:          orm,
+		pollInterval: defaultPollInterval,
+		retryBackoff: backoff.Backoff{
+			Min:    time.Second,
+			Max:    10 * time.Second,
+			Factor: 2,
+		},
+		cursors:   map[WorkflowRegistryEventType]string{},
+		workflows: map[workflowRef]workflowRecord{},
+		secrets:   map[string]map[string]string{},
+		pending:   map[workflowRef]*pendingEvents{},
+	}
+}
+
+// NOTE: as with the capabilities registry syncer, binding makes an onchain call to verify the
+// contract exists, so the reader must be created lazily from within the sync loop.
+func newReader(ctx context.Context, relayer ContractReaderFactory, contract types.BoundContract) (types.ContractReader, error) {
+	cfgs := map[string]*evmrelaytypes.ChainReaderDefinition{}
+	for _, et := range eventTypes {
+		cfgs[string(et)] = &evmrelaytypes.ChainReaderDefinition{
+			ChainSpecificName: string(et),
+			ReadType:          evmrelaytypes.Event,
+		}
+	}
+
+	contractReaderConfig := evmrelaytypes.ChainReaderConfig{
+		Contracts: map[string]evmrelaytypes.ChainContractReader{
+			workflowRegistryContractName: {
+				ContractABI: WorkflowRegistryABI,
+				ContractPollingFilter: evmrelaytypes.ContractPollingFilter{
+					GenericEventNames: eventTypeNames(),
+				},
+				Configs: cfgs,
+			},
+		},
+	}
+
+	encoded, err := json.Marshal(contractReaderConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	cr, err := relayer.NewContractReader(ctx, encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cr.Bind(ctx, []types.BoundContract{contract}); err != nil {
+		return nil, err
+	}
+
+	return cr, cr.Start(ctx)
+}
+
+// AddEventHandler registers handlers that are called for every registry event.
+func (w *WorkflowRegistry) AddEventHandler(h ...EventHandler) {
+	w.handlersMu.Lock()
+	defer w.handlersMu.Unlock()
+	w.handlers = append(w.handlers, h...)
+}
+
+func (w *WorkflowRegistry) Start(_ context.Context) error {
+	return w.StartOnce(w.Name(), func() error {
+		if w.contract.Address == "" {
+			w.lggr.Info("no workflow registry address configured; syncer disabled")
+			return nil
+		}
+
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.syncLoop()
+		}()
+		return nil
+	})
 }
 
 func (w *WorkflowRegistry) Close() error {
+	return w.StopOnce(w.Name(), func() error {
+		close(w.stopCh)
+		w.wg.Wait()
+		return nil
+	})
+}
+
+func (w *WorkflowRegistry) HealthReport() map[string]error {
+	report := map[string]error{w.Name(): w.Healthy()}
+
+	w.pendingMu.RLock()
+	defer w.pendingMu.RUnlock()
+	var pending []error
+	for ref, p := range w.pending {
+		first := p.events[0]
+		pending = append(pending, fmt.Errorf("%d events pending for workflow %s of owner %s, first %s event at %s failed: %w",
+			len(p.events), ref.name, ref.owner, first.EventType, first.Cursor, p.err))
+	}
+	if len(pending) > 0 {
+		slices.SortFunc(pending, func(a, b error) int { return cmp.Compare(a.Error(), b.Error()) })
+		report[w.Name()+".PendingEvents"] = errors.Join(pending...)
+	}
+	return report
+}
+
+func (w *WorkflowRegistry) Name() string {
+	return "WorkflowRegistrySyncer"
+}
+
+func (w *WorkflowRegistry) syncLoop() {
+	ctx, cancel := w.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Sync(ctx); err != nil {
+			w.lggr.Errorw("failed to sync with workflow registry", "err", err)
+		}
+
+		select {
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync reads all finalized events emitted since the last sync and dispatches the ones for the
+// node's workflow DON, oldest first. Every event type is read up to the same finalized block,
+// so that an event finalized while the types are queried one after another is left for the
+// next sync rather than dispatched after newer events of other types.
+//
+// An event that a handler fails to handle is retried with backoff. If it still fails after
+// maxDispatchAttempts, it is persisted as pending and queued with the later events of its
+// workflow. Pending events are retried once per sync, oldest first, and reported by HealthReport
+// until they are handled.
+func (w *WorkflowRegistry) Sync(ctx context.Context) error {
+	if w.reader == nil {
+		reader, err := w.initReader(ctx, w.relayer, w.contract)
+		if err != nil {
+			return fmt.Errorf("failed to initialize contract reader: %w", err)
+		}
+		w.reader = reader
+	}
+
+	if w.handled == nil {
+		handled, err := w.orm.LatestCursors(ctx, w.contract.Address)
+		if err != nil {
+			return fmt.Errorf("failed to load workflow registry cursors: %w", err)
+		}
+		w.handled = handled
+	}
+
+	if w.pendingCursors == nil {
+		pending, err := w.orm.PendingEvents(ctx, w.contract.Address)
+		if err != nil {
+			return fmt.Errorf("failed to load pending workflow registry events: %w", err)
+		}
+		w.pendingCursors = pending
+	}
+
+	if err := w.retryPending(ctx); err != nil {
+		return err
+	}
+
+	node, err := w.localNode.LocalNode(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get local node: %w", err)
+	}
+	donID := node.WorkflowDON.ID
+
+	latest, err := w.lp.LatestBlock(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// no blocks polled yet, so nothing is finalized
+			return nil
+		}
+		return fmt.Errorf("failed to get latest finalized block: %w", err)
+	}
+
+	var events []WorkflowRegistryEvent
+	for _, et := range eventTypes {
+		evts, err := w.queryEvents(ctx, et, latest.FinalizedBlockNumber)
+		if err != nil {
+			return fmt.Errorf("failed to query %s events: %w", et, err)
+		}
+		events = append(events, evts...)
+	}
+
+	slices.SortStableFunc(events, func(a, b WorkflowRegistryEvent) int {
+		return compareCursors(a.Cursor, b.Cursor)
+	})
+
+	for _, event := range events {
+		if id, ok := event.donID(); ok && id != donID {
+			w.cursors[event.EventType] = event.Cursor
+			continue
+		}
+
+		event.Status = w.apply(event)
+
+		_, pending := w.pendingCursors[event.Cursor]
+		handled := w.handled[event.EventType]
+		newer := handled == "" || compareCursors(event.Cursor, handled) > 0
+		if pending || newer {
+			if err := w.handle(ctx, event); err != nil {
+				return err
+			}
+		}
+		if newer {
+			if err := w.orm.UpsertCursor(ctx, w.contract.Address, event.EventType, event.Cursor); err != nil {
+				return fmt.Errorf("failed to persist workflow registry cursor: %w", err)
+			}
+			w.handled[event.EventType] = event.Cursor
+		}
+		w.cursors[event.EventType] = event.Cursor
+	}
 	return nil
 }
 
-func (w *WorkflowRegistry) Ready() error {
+// handle dispatches the event, unless earlier events of its workflow are pending, in which case
+// it is queued behind them. An event that fails to be handled is persisted as pending.
+func (w *WorkflowRegistry) handle(ctx context.Context, event WorkflowRegistryEvent) error {
+	ref := event.workflowRef()
+	queue, queued := w.pending[ref]
+	if !queued {
+		err := w.dispatchWithRetry(ctx, event)
+		if err == nil {
+			return w.deletePending(ctx, event)
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("failed to handle %s event at %s: %w", event.EventType, event.Cursor, err)
+		}
+		w.lggr.Errorw("Workflow registry event failed to be handled, retrying on the next sync", "eventType", event.EventType,
+			"cursor", event.Cursor, "attempts", maxDispatchAttempts, "err", err)
+		queue = &pendingEvents{err: err}
+	}
+
+	if _, persisted := w.pendingCursors[event.Cursor]; !persisted {
+		if err := w.orm.AddPendingEvent(ctx, w.contract.Address, event.EventType, event.Cursor); err != nil {
+			return fmt.Errorf("failed to persist pending workflow registry event: %w", err)
+		}
+		w.pendingCursors[event.Cursor] = event.EventType
+	}
+
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	queue.events = append(queue.events, event)
+	w.pending[ref] = queue
 	return nil
 }
 
-func (w *WorkflowRegistry) HealthReport() map[string]error {
+// retryPending dispatches the pending events of each workflow once, oldest first, up to the
+// first one that fails again.
+func (w *WorkflowRegistry) retryPending(ctx context.Context) error {
+	for ref, queue := range w.pending {
+		for len(queue.events) > 0 {
+			event := queue.events[0]
+			if err := w.dispatch(ctx, event); err != nil {
+				if ctx.Err() != nil {
+					return fmt.Errorf("failed to handle pending %s event at %s: %w", event.EventType, event.Cursor, err)
+				}
+				w.pendingMu.Lock()
+				queue.err = err
+				w.pendingMu.Unlock()
+				break
+			}
+			if err := w.deletePending(ctx, event); err != nil {
+				return err
+			}
+
+			w.pendingMu.Lock()
+			queue.events = queue.events[1:]
+			if len(queue.events) == 0 {
+				delete(w.pending, ref)
+			}
+			w.pendingMu.Unlock()
+		}
+	}
 	return nil
 }
 
-func (w *WorkflowRegistry) Name() string {
-	return "WorkflowRegistrySyncer"
+// deletePending records that the event, if it was pending, has been handled.
+func (w *WorkflowRegistry) deletePending(ctx context.Context, event WorkflowRegistryEvent) error {
+	if _, ok := w.pendingCursors[event.Cursor]; !ok {
+		return nil
+	}
+	if err := w.orm.DeletePendingEvent(ctx, w.contract.Address, event.Cursor); err != nil {
+		return fmt.Errorf("failed to delete pending workflow registry event: %w", err)
+	}
+	delete(w.pendingCursors, event.Cursor)
+	return nil
 }
 
+// dispatchWithRetry dispatches the event up to maxDispatchAttempts times, backing off between attempts.
+func (w *WorkflowRegistry) dispatchWithRetry(ctx context.Context, event WorkflowRegistryEvent) error {
+	b := w.retryBackoff
+	for attempt := 1; ; attempt++ {
+		err := w.dispatch(ctx, event)
+		if err == nil || attempt == maxDispatchAttempts {
+			return err
+		}
+		w.lggr.Warnw("Failed to handle workflow registry event, retrying", "eventType", event.EventType,
+			"cursor", event.Cursor, "attempt", attempt, "err", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(b.Duration()):
+		}
+	}
+}
+
+func (w *WorkflowRegistry) dispatch(ctx context.Context, event WorkflowRegistryEvent) error {
+	w.handlersMu.RLock()
+	handlers := slices.Clone(w.handlers)
+	w.handlersMu.RUnlock()
+
+	for _, h := range handlers {
+		if err := h.Handle(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryEvents reads the events of type et after the last one read, up to and including the finalized block.
+func (w *WorkflowRegistry) queryEvents(ctx context.Context, et WorkflowRegistryEventType, finalized int64) ([]WorkflowRegistryEvent, error) {
+	var events []WorkflowRegistryEvent
+	cursor := w.cursors[et]
+	for {
+		limitAndSort := query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Asc)},
+			Limit:  query.Limit{Count: defaultQueryCount},
+		}
+		if cursor != "" {
+			limitAndSort.Limit = query.CursorLimit(cursor, query.CursorFollowing, defaultQueryCount)
+		}
+
+		seqs, err := w.reader.QueryKey(
+			ctx,
+			w.contract,
+			query.KeyFilter{
+				Key: string(et),
+				Expressions: []query.Expression{
+					query.Confidence(primitives.Finalized),
+					query.Block(strconv.FormatInt(finalized, 10), primitives.Lte),
+				},
+			},
+			limitAndSort,
+			newEventData(et),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var n int
+		prev := cursor
+		for _, seq := range seqs {
+			if seq.Cursor == prev {
+				continue
+			}
+			event, err := toWorkflowRegistryEvent(et, seq)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+			cursor = seq.Cursor
+			n++
+		}
+
+		if n == 0 || len(seqs) < defaultQueryCount {
+			return events, nil
+		}
+	}
+}
+
+// apply records the registry state needed to serve secrets, and returns the status of the
+// event's workflow once the event is applied.
+func (w *WorkflowRegistry) apply(event WorkflowRegistryEvent) WorkflowStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch data := event.Data.(type) {
+	case WorkflowRegistryWorkflowRegisteredV1:
+		ref := workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+		w.workflows[ref] = workflowRecord{id: hex.EncodeToString(data.WorkflowID[:]), status: WorkflowStatus(data.Status), secretsURL: data.SecretsURL}
+	case WorkflowRegistryWorkflowUpdatedV1:
+		ref := workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+		if old, ok := w.workflows[ref]; ok && old.secretsURL != data.SecretsURL {
+			delete(w.secrets, old.secretsURL)
+		}
+		w.workflows[ref] = workflowRecord{id: hex.EncodeToString(data.NewWorkflowID[:]), status: w.workflows[ref].status, secretsURL: data.SecretsURL}
+	case WorkflowRegistryWorkflowPausedV1:
+		ref := workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+		if rec, ok := w.workflows[ref]; ok {
+			rec.status = WorkflowStatusPaused
+			w.workflows[ref] = rec
+		}
+		return WorkflowStatusPaused
+	case WorkflowRegistryWorkflowActivatedV1:
+		ref := workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+		if rec, ok := w.workflows[ref]; ok {
+			rec.status = WorkflowStatusActive
+			w.workflows[ref] = rec
+		}
+	case WorkflowRegistryWorkflowDeletedV1:
+		ref := workflowRef{owner: hex.EncodeToString(data.WorkflowOwner[:]), name: data.WorkflowName}
+		if rec, ok := w.workflows[ref]; ok {
+			delete(w.secrets, rec.secretsURL)
+			delete(w.workflows, ref)
+		}
+	case WorkflowRegistryForceUpdateSecretsRequestedV1:
+		ref := workflowRef{owner: hex.EncodeToString(data.Owner[:]), name: data.WorkflowName}
+		if rec, ok := w.workflows[ref]; ok {
+			delete(w.secrets, rec.secretsURL)
+		}
+	}
+	return w.workflows[event.workflowRef()].status
+}
+
+// SecretsFor returns the decrypted secrets for the given workflow. Workflows that were not
+// registered through the workflow registry, or that have no secrets, get an empty set.
 func (w *WorkflowRegistry) SecretsFor(workflowOwner, workflowName string) (map[string]string, error) {
-	// TODO: actually get this from the right place.
-	return map[string]string{}, nil
+	ref := workflowRef{owner: strings.ToLower(strings.TrimPrefix(workflowOwner, "0x")), name: workflowName}
+
+	w.mu.RLock()
+	rec, ok := w.workflows[ref]
+	cached, cachedOK := w.secrets[rec.secretsURL]
+	w.mu.RUnlock()
+
+	if !ok || rec.secretsURL == "" {
+		return map[string]string{}, nil
+	}
+	if cachedOK {
+		return cached, nil
+	}
+
+	ctx, cancel := w.stopCh.CtxWithTimeout(defaultFetchTimeout)
+	defer cancel()
+
+	payload, err := w.fetcher(ctx, rec.secretsURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch secrets from %s: %w", rec.secretsURL, err)
+	}
+
+	var encrypted secrets.EncryptedSecretsResult
+	if err = json.Unmarshal(payload, &encrypted); err != nil {
+		return nil, fmt.Errorf("failed to parse encrypted secrets: %w", err)
+	}
+
+	key, err := w.workflowKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow key: %w", err)
+	}
+
+	decrypted, err := secrets.DecryptSecretsForNode(encrypted, key, "0x"+ref.owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secrets: %w", err)
+	}
+
+	w.mu.Lock()
+	w.secrets[rec.secretsURL] = decrypted
+	w.mu.Unlock()
+
+	return decrypted, nil
+}
+
+// compareCursors orders evm contract reader cursors (block-logIndex-txHash) chronologically.
+func compareCursors(a, b string) int {
+	ab, ai := parseCursor(a)
+	bb, bi := parseCursor(b)
+	if ab != bb {
+		return cmp.Compare(ab, bb)
+	}
+	return cmp.Compare(ai, bi)
 }
 
-func NewWorkflowRegistry() *WorkflowRegistry {
-	return &WorkflowRegistry{}
+func parseCursor(cursor string) (block int64, logIndex int64) {
+	parts := strings.Split(cursor, "-")
+	if len(parts) != 3 {
+		return 0, 0
+	}
+	block, _ = strconv.ParseInt(parts[0], 10, 64)
+	logIndex, _ = strconv.ParseInt(parts[1], 10, 64)
+	return block, logIndex
 }
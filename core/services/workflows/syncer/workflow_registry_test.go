@@ -0,0 +1,430 @@
+package syncer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/jpillora/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/secrets"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	evmrelaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// logEmitterBytecode deploys a contract that emits whatever log it is called with, so that the
+// syncer can be tested against real WorkflowRegistry logs. The calldata is a word holding the
+// number of topics (2 or 4), followed by the topics and the log data.
+const logEmitterBytecode = "603a80600b6000396000f3" +
+	"600035600214602457" +
+	"60a0360380" + "60a0600037" + "608035" + "606035" + "604035" + "602035" + "84" + "6000a400" +
+	"5b" + "6060360380" + "6060600037" + "604035" + "602035" + "82" + "6000a200"
+
+const testFinalityDepth = 2
+
+type registryBackend struct {
+	t        *testing.T
+	backend  *simulated.Backend
+	auth     *bind.TransactOpts
+	address  common.Address
+	contract *bind.BoundContract
+	abi      abi.ABI
+	lp       logpoller.LogPollerTest
+	factory  *crFactory
+}
+
+func newRegistryBackend(t *testing.T) *registryBackend {
+	auth := testutils.MustNewSimTransactor(t)
+	balance, _ := new(big.Int).SetString("100000000000000000000", 10)
+	backend := simulated.NewBackend(gethtypes.GenesisAlloc{auth.From: {Balance: balance}})
+	backend.Commit()
+
+	address, _, contract, err := bind.DeployContract(auth, abi.ABI{}, common.FromHex(logEmitterBytecode), backend.Client())
+	require.NoError(t, err)
+	backend.Commit()
+
+	registryABI, err := abi.JSON(strings.NewReader(WorkflowRegistryABI))
+	require.NoError(t, err)
+
+	lggr := logger.TestLogger(t)
+	client := evmclient.NewSimulatedBackendClient(t, backend, testutils.SimulatedChainID)
+	ht := headtracker.NewSimulatedHeadTracker(client, false, testFinalityDepth)
+	lp := logpoller.NewLogPoller(
+		logpoller.NewORM(testutils.SimulatedChainID, pgtest.NewSqlxDB(t), lggr),
+		client,
+		lggr,
+		ht,
+		logpoller.Opts{
+			PollPeriod:               time.Hour,
+			FinalityDepth:            testFinalityDepth,
+			BackfillBatchSize:        10,
+			RpcBatchSize:             2,
+			KeepFinalizedBlocksDepth: 1000,
+		},
+	)
+
+	return &registryBackend{
+		t:        t,
+		backend:  backend,
+		auth:     auth,
+		address:  address,
+		contract: contract,
+		abi:      registryABI,
+		lp:       lp,
+		factory:  &crFactory{lggr: lggr, lp: lp, ht: ht, client: client},
+	}
+}
+
+// emit emits the named WorkflowRegistry event, with args given in ABI order.
+func (b *registryBackend) emit(name string, args ...any) {
+	event, ok := b.abi.Events[name]
+	require.True(b.t, ok, "unknown event %s", name)
+
+	topics := []common.Hash{event.ID}
+	var data []any
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			data = append(data, args[i])
+			continue
+		}
+		topic, err := abi.MakeTopics([]any{args[i]})
+		require.NoError(b.t, err)
+		topics = append(topics, topic[0][0])
+	}
+	encoded, err := event.Inputs.NonIndexed().Pack(data...)
+	require.NoError(b.t, err)
+
+	calldata := common.LeftPadBytes(big.NewInt(int64(len(topics))).Bytes(), 32)
+	for _, topic := range topics {
+		calldata = append(calldata, topic.Bytes()...)
+	}
+	calldata = append(calldata, encoded...)
+
+	_, err = b.contract.RawTransact(b.auth, calldata)
+	require.NoError(b.t, err)
+	b.backend.Commit()
+}
+
+// finalize mines enough blocks for every emitted event to be finalized and lets the log poller
+// pick them up.
+func (b *registryBackend) finalize(ctx context.Context) {
+	for i := 0; i < testFinalityDepth+1; i++ {
+		b.backend.Commit()
+	}
+	from := int64(1)
+	if latest, err := b.lp.LatestBlock(ctx); err == nil {
+		from = latest.BlockNumber + 1
+	}
+	b.lp.PollAndSaveLogs(ctx, from)
+}
+
+func (b *registryBackend) newRegistry(fetcher FetcherFunc, key workflowkey.Key, orm ORM, donID uint32) *WorkflowRegistry {
+	return NewWorkflowRegistry(
+		logger.TestLogger(b.t),
+		b.factory,
+		b.lp,
+		b.address.Hex(),
+		fetcher,
+		func() (workflowkey.Key, error) { return key, nil },
+		localNode{node: capabilities.Node{WorkflowDON: capabilities.DON{ID: donID}}},
+		orm,
+	)
+}
+
+type crFactory struct {
+	lggr   logger.Logger
+	lp     logpoller.LogPoller
+	ht     logpoller.HeadTracker
+	client evmclient.Client
+}
+
+func (c *crFactory) NewContractReader(ctx context.Context, cfg []byte) (types.ContractReader, error) {
+	crCfg := &evmrelaytypes.ChainReaderConfig{}
+	if err := json.Unmarshal(cfg, crCfg); err != nil {
+		return nil, err
+	}
+	return evm.NewChainReaderService(ctx, c.lggr, c.lp, c.ht, c.client, *crCfg)
+}
+
+type localNode struct {
+	node capabilities.Node
+}
+
+func (l localNode) LocalNode(context.Context) (capabilities.Node, error) {
+	return l.node, nil
+}
+
+type recordingHandler struct {
+	events []WorkflowRegistryEvent
+	// failures is the number of calls that fail before the handler starts succeeding.
+	failures int
+}
+
+func (r *recordingHandler) Handle(_ context.Context, event WorkflowRegistryEvent) error {
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("handler failed")
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+// pinnedLogPoller reports finalized as the latest finalized block, if set.
+type pinnedLogPoller struct {
+	LogPoller
+	finalized int64
+}
+
+func (p *pinnedLogPoller) LatestBlock(ctx context.Context) (logpoller.LogPollerBlock, error) {
+	b, err := p.LogPoller.LatestBlock(ctx)
+	if err == nil && p.finalized != 0 {
+		b.FinalizedBlockNumber = p.finalized
+	}
+	return b, err
+}
+
+func eventTypesOf(events []WorkflowRegistryEvent) []WorkflowRegistryEventType {
+	ets := make([]WorkflowRegistryEventType, len(events))
+	for i, e := range events {
+		ets[i] = e.EventType
+	}
+	return ets
+}
+
+func TestWorkflowRegistry_Sync(t *testing.T) {
+	ctx := testutils.Context(t)
+	b := newRegistryBackend(t)
+	orm := NewORM(pgtest.NewSqlxDB(t))
+
+	owner := common.Address{1}
+	ref := workflowRef{owner: hex.EncodeToString(owner[:]), name: "wf"}
+	const donID = 1
+
+	h := &recordingHandler{}
+	wr := b.newRegistry(nil, workflowkey.Key{}, orm, donID)
+	wr.retryBackoff = backoff.Backoff{Min: time.Millisecond, Max: time.Millisecond}
+	wr.AddEventHandler(h)
+
+	// the first sync binds the reader, which registers the log poller filter
+	require.NoError(t, wr.Sync(ctx))
+	assert.Empty(t, h.events)
+
+	b.emit(string(WorkflowRegisteredEvent), [32]byte{1}, owner, uint32(donID), uint8(WorkflowStatusActive), "wf", "https://binary", "", "")
+	b.emit(string(WorkflowRegisteredEvent), [32]byte{9}, owner, uint32(donID+1), uint8(WorkflowStatusActive), "other-don", "https://binary", "", "")
+	b.emit(string(WorkflowUpdatedEvent), [32]byte{1}, owner, uint32(donID), [32]byte{2}, "wf", "https://binary", "", "")
+	b.emit(string(WorkflowPausedEvent), [32]byte{2}, owner, uint32(donID), "wf")
+	b.finalize(ctx)
+
+	require.NoError(t, wr.Sync(ctx))
+	assert.Equal(t, []WorkflowRegistryEventType{WorkflowRegisteredEvent, WorkflowUpdatedEvent, WorkflowPausedEvent}, eventTypesOf(h.events))
+	assert.NotContains(t, wr.workflows, workflowRef{owner: ref.owner, name: "other-don"}, "events for other DONs are ignored")
+	assert.Equal(t, []WorkflowStatus{WorkflowStatusActive, WorkflowStatusActive, WorkflowStatusPaused},
+		[]WorkflowStatus{h.events[0].Status, h.events[1].Status, h.events[2].Status}, "events carry the workflow status tracked by the registry")
+
+	t.Run("events already seen are not dispatched again", func(t *testing.T) {
+		require.NoError(t, wr.Sync(ctx))
+		assert.Len(t, h.events, 3)
+	})
+
+	t.Run("a failed event is retried", func(t *testing.T) {
+		h.failures = 1
+		b.emit(string(WorkflowActivatedEvent), [32]byte{2}, owner, uint32(donID), "wf")
+		b.finalize(ctx)
+
+		require.NoError(t, wr.Sync(ctx))
+		require.Len(t, h.events, 4)
+		assert.Equal(t, WorkflowActivatedEvent, h.events[3].EventType)
+		assert.NotContains(t, wr.HealthReport(), wr.Name()+".SkippedEvents")
+
+		rec := wr.workflows[ref]
+		newID := [32]byte{2}
+		assert.Equal(t, hex.EncodeToString(newID[:]), rec.id)
+		assert.Equal(t, WorkflowStatusActive, rec.status)
+	})
+
+	t.Run("an event that keeps failing is kept pending and does not block other workflows", func(t *testing.T) {
+		h.failures = maxDispatchAttempts + 1
+		b.emit(string(WorkflowPausedEvent), [32]byte{2}, owner, uint32(donID), "wf")
+		b.emit(string(WorkflowRegisteredEvent), [32]byte{3}, owner, uint32(donID), uint8(WorkflowStatusActive), "wf2", "https://binary", "", "")
+		b.finalize(ctx)
+
+		require.NoError(t, wr.Sync(ctx))
+		require.Len(t, h.events, 5)
+		assert.Equal(t, WorkflowRegisteredEvent, h.events[4].EventType)
+		assert.Contains(t, wr.workflows, workflowRef{owner: ref.owner, name: "wf2"})
+
+		report := wr.HealthReport()
+		require.Contains(t, report, wr.Name()+".PendingEvents")
+		assert.ErrorContains(t, report[wr.Name()+".PendingEvents"], "first "+string(WorkflowPausedEvent))
+		assert.ErrorContains(t, report[wr.Name()+".PendingEvents"], "handler failed")
+
+		// later events of the workflow are queued behind the pending one
+		b.emit(string(WorkflowActivatedEvent), [32]byte{2}, owner, uint32(donID), "wf")
+		b.finalize(ctx)
+
+		require.NoError(t, wr.Sync(ctx))
+		assert.Len(t, h.events, 5)
+		assert.ErrorContains(t, wr.HealthReport()[wr.Name()+".PendingEvents"], "2 events pending")
+
+		// pending events survive a restart
+		restarted := &recordingHandler{}
+		wr2 := b.newRegistry(nil, workflowkey.Key{}, orm, donID)
+		wr2.AddEventHandler(restarted)
+		require.NoError(t, wr2.Sync(ctx))
+		assert.Equal(t, []WorkflowRegistryEventType{WorkflowPausedEvent, WorkflowActivatedEvent}, eventTypesOf(restarted.events))
+		assert.NotContains(t, wr2.HealthReport(), wr2.Name()+".PendingEvents")
+
+		require.NoError(t, wr.Sync(ctx))
+		require.Len(t, h.events, 7)
+		assert.Equal(t, []WorkflowRegistryEventType{WorkflowPausedEvent, WorkflowActivatedEvent}, eventTypesOf(h.events[5:]))
+		assert.NotContains(t, wr.HealthReport(), wr.Name()+".PendingEvents")
+	})
+
+	t.Run("handled events are not dispatched again after a restart", func(t *testing.T) {
+		restarted := &recordingHandler{}
+		wr2 := b.newRegistry(nil, workflowkey.Key{}, orm, donID)
+		wr2.AddEventHandler(restarted)
+
+		require.NoError(t, wr2.Sync(ctx))
+		assert.Empty(t, restarted.events)
+		assert.Equal(t, wr.workflows[ref], wr2.workflows[ref], "state is rebuilt from the chain")
+
+		b.emit(string(WorkflowDeletedEvent), [32]byte{2}, owner, uint32(donID), "wf")
+		b.finalize(ctx)
+
+		require.NoError(t, wr2.Sync(ctx))
+		assert.Equal(t, []WorkflowRegistryEventType{WorkflowDeletedEvent}, eventTypesOf(restarted.events))
+		assert.NotContains(t, wr2.workflows, ref)
+	})
+}
+
+func TestWorkflowRegistry_SyncReadsUpToOneFinalizedBlock(t *testing.T) {
+	ctx := testutils.Context(t)
+	b := newRegistryBackend(t)
+
+	owner := common.Address{1}
+	const donID = 1
+
+	h := &recordingHandler{}
+	wr := b.newRegistry(nil, workflowkey.Key{}, NewORM(pgtest.NewSqlxDB(t)), donID)
+	lp := &pinnedLogPoller{LogPoller: b.lp}
+	wr.lp = lp
+	wr.AddEventHandler(h)
+	require.NoError(t, wr.Sync(ctx))
+
+	b.emit(string(WorkflowRegisteredEvent), [32]byte{1}, owner, uint32(donID), uint8(WorkflowStatusActive), "wf", "https://binary", "", "")
+	registered, err := b.backend.Client().BlockNumber(ctx)
+	require.NoError(t, err)
+	b.emit(string(WorkflowDeletedEvent), [32]byte{1}, owner, uint32(donID), "wf")
+	b.finalize(ctx)
+
+	// events finalized after the block read at the start of the sync are left for the next one,
+	// whatever type they are
+	lp.finalized = int64(registered)
+	require.NoError(t, wr.Sync(ctx))
+	assert.Equal(t, []WorkflowRegistryEventType{WorkflowRegisteredEvent}, eventTypesOf(h.events))
+
+	lp.finalized = 0
+	require.NoError(t, wr.Sync(ctx))
+	assert.Equal(t, []WorkflowRegistryEventType{WorkflowRegisteredEvent, WorkflowDeletedEvent}, eventTypesOf(h.events))
+}
+
+func TestWorkflowRegistry_SecretsFor(t *testing.T) {
+	ctx := testutils.Context(t)
+	b := newRegistryBackend(t)
+
+	key, err := workflowkey.New()
+	require.NoError(t, err)
+
+	owner := common.Address{0xaa}
+	ownerHex := "0x" + hex.EncodeToString(owner[:])
+	payload, err := json.Marshal(secrets.SecretPayloadToEncrypt{
+		WorkflowOwner: ownerHex,
+		Secrets:       map[string]string{"API_KEY": "s3cr3t"},
+	})
+	require.NoError(t, err)
+	encrypted, err := key.Encrypt(payload)
+	require.NoError(t, err)
+
+	result, err := json.Marshal(secrets.EncryptedSecretsResult{
+		EncryptedSecrets: map[string]string{"p2p": base64.StdEncoding.EncodeToString(encrypted)},
+		Metadata: secrets.Metadata{
+			WorkflowOwner:            ownerHex,
+			NodePublicEncryptionKeys: map[string]string{"p2p": key.PublicKeyString()},
+		},
+	})
+	require.NoError(t, err)
+
+	var fetches int
+	fetcher := func(_ context.Context, url string) ([]byte, error) {
+		fetches++
+		require.Equal(t, "https://secrets", url)
+		return result, nil
+	}
+
+	wr := b.newRegistry(fetcher, key, NewORM(pgtest.NewSqlxDB(t)), 1)
+	require.NoError(t, wr.Sync(ctx))
+
+	t.Run("unknown workflow has no secrets", func(t *testing.T) {
+		s, err := wr.SecretsFor(ownerHex, "wf")
+		require.NoError(t, err)
+		assert.Empty(t, s)
+	})
+
+	b.emit(string(WorkflowRegisteredEvent), [32]byte{1}, owner, uint32(1), uint8(WorkflowStatusActive), "wf", "https://binary", "", "https://secrets")
+	b.finalize(ctx)
+	require.NoError(t, wr.Sync(ctx))
+
+	t.Run("secrets are fetched, decrypted and cached", func(t *testing.T) {
+		s, err := wr.SecretsFor(ownerHex, "wf")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"API_KEY": "s3cr3t"}, s)
+
+		_, err = wr.SecretsFor(ownerHex, "wf")
+		require.NoError(t, err)
+		assert.Equal(t, 1, fetches)
+	})
+
+	t.Run("force update invalidates the cache", func(t *testing.T) {
+		b.emit(string(ForceUpdateSecretsRequestEvent), owner, [32]byte{}, "wf")
+		b.finalize(ctx)
+		require.NoError(t, wr.Sync(ctx))
+
+		_, err = wr.SecretsFor(ownerHex, "wf")
+		require.NoError(t, err)
+		assert.Equal(t, 2, fetches)
+	})
+
+	t.Run("secrets for another node cannot be decrypted", func(t *testing.T) {
+		other, err := workflowkey.New()
+		require.NoError(t, err)
+		wr.workflowKey = func() (workflowkey.Key, error) { return other, nil }
+		wr.secrets = map[string]map[string]string{}
+
+		_, err = wr.SecretsFor(ownerHex, "wf")
+		require.ErrorContains(t, err, "failed to decrypt secrets")
+	})
+}
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE workflow_steps ADD COLUMN attempts JSONB;
+
+-- +goose Down
+ALTER TABLE workflow_steps DROP COLUMN attempts;
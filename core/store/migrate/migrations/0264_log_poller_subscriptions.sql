@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE evm.log_poller_subscriptions (
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    name TEXT NOT NULL,
+    block_number BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, name)
+);
+
+-- +goose Down
+DROP TABLE evm.log_poller_subscriptions;
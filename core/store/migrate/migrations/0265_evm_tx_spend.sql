@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE evm.tx_spend (
+    eth_tx_id BIGINT PRIMARY KEY REFERENCES evm.txes(id) ON DELETE CASCADE,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    from_address BYTEA NOT NULL,
+    job_id INTEGER,
+    tx_hash BYTEA NOT NULL,
+    gas_used BIGINT NOT NULL,
+    effective_gas_price NUMERIC(78,0) NOT NULL,
+    -- L1 fees which neither the receipt reported nor the L1 oracle could compute are left unknown
+    l1_fee NUMERIC(78,0),
+    total_fee NUMERIC(78,0) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_evm_tx_spend_job_id ON evm.tx_spend (evm_chain_id, job_id, created_at) WHERE job_id IS NOT NULL;
+CREATE INDEX idx_evm_tx_spend_from_address ON evm.tx_spend (evm_chain_id, from_address, created_at);
+
+ALTER TABLE jobs ADD COLUMN max_daily_gas_spend NUMERIC(78,0);
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN max_daily_gas_spend;
+DROP TABLE evm.tx_spend;
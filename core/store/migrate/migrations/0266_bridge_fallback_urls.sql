@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE bridge_types ADD COLUMN fallback_urls TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE bridge_types DROP COLUMN fallback_urls;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE workflow_registry_cursors (
+    contract_address TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    cursor TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (contract_address, event_type)
+);
+
+-- +goose Down
+DROP TABLE workflow_registry_cursors;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN revert_reason text;
+
+-- +goose Down
+ALTER TABLE evm.txes DROP COLUMN revert_reason;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE workflow_registry_pending_events (
+    contract_address TEXT NOT NULL,
+    cursor TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (contract_address, cursor)
+);
+
+-- +goose Down
+DROP TABLE workflow_registry_pending_events;
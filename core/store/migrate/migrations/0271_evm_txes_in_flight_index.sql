@@ -0,0 +1,7 @@
+-- +goose Up
+-- Backs the in-flight spend of job spend caps, which is summed over the unfinished transactions of a chain whenever
+-- a job creates a transaction.
+CREATE INDEX idx_evm_txes_in_flight_evm_chain_id ON evm.txes (evm_chain_id) WHERE state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt');
+
+-- +goose Down
+DROP INDEX evm.idx_evm_txes_in_flight_evm_chain_id;
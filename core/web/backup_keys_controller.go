@@ -0,0 +1,12 @@
+package web
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func NewBackupKeysController(app chainlink.Application) KeysController {
+	return NewKeysController[backupkey.Key, presenters.BackupKeyResource](app.GetKeyStore().Backup(), app.GetLogger(), app.GetAuditLogger(),
+		"backupKey", presenters.NewBackupKeyResource, presenters.NewBackupKeyResources)
+}
@@ -46,6 +46,16 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 	if len(strings.TrimSpace(u)) == 0 {
 		fe.Add("URL must be present")
 	}
+	seen := map[string]bool{u: true}
+	for _, f := range bt.FallbackURLs {
+		fu := f.String()
+		if len(strings.TrimSpace(fu)) == 0 {
+			fe.Add("Fallback URLs must be present")
+		} else if seen[fu] {
+			fe.Add(fmt.Sprintf("Fallback URL %s is a duplicate", fu))
+		}
+		seen[fu] = true
+	}
 	if bt.MinimumContractPayment != nil &&
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
@@ -104,6 +114,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeFallbackURLs":           bta.FallbackURLs,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
@@ -143,7 +154,12 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	if health := btc.App.BridgeHealth(); health != nil {
+		resource.Health = presenters.NewBridgeURLHealth(health.Status(bt))
+	}
+
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -187,6 +203,7 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeFallbackURLs":           bt.FallbackURLs,
 	})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
@@ -2,13 +2,21 @@ package web
 
 import (
 	"database/sql"
+	"math/big"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	commontxmgr "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 )
 
@@ -47,3 +55,162 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+const defaultTxSpendWindow = 24 * time.Hour
+
+// Spend returns the gas spent by EVM transactions over a rolling window, aggregated per job or per key.
+// Example:
+//
+//	"<application>/transactions/evm/spend?evmChainID=1&groupBy=key&window=168h"
+func (tc *TransactionsController) Spend(c *gin.Context) {
+	var chainID *big.Int
+	if s := c.Query("evmChainID"); s != "" {
+		var ok bool
+		if chainID, ok = new(big.Int).SetString(s, 10); !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, ErrInvalidChainID)
+			return
+		}
+	}
+	window := defaultTxSpendWindow
+	if s := c.Query("window"); s != "" {
+		var err error
+		if window, err = time.ParseDuration(s); err != nil || window <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid window: %q", s))
+			return
+		}
+	}
+	since := time.Now().Add(-window)
+
+	var spend []txmgr.TxSpend
+	var err error
+	switch groupBy := c.DefaultQuery("groupBy", "job"); groupBy {
+	case "job":
+		spend, err = tc.App.TxmStorageService().TxSpendByJob(c, chainID, since)
+	case "key":
+		spend, err = tc.App.TxmStorageService().TxSpendByKey(c, chainID, since)
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid groupBy: %q, must be job or key", groupBy))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := make([]presenters.EthTxSpendResource, len(spend))
+	for i, s := range spend {
+		resources[i] = presenters.NewEthTxSpendResource(s)
+	}
+	jsonAPIResponse(c, resources, "evm_transaction_spend")
+}
+
+const txEventsWriteTimeout = 10 * time.Second
+
+var txEventsUpgrader = websocket.Upgrader{}
+
+// Events streams the lifecycle events of EVM transactions over a websocket, as JSON messages.
+// Events may be filtered by transaction ID, idempotency key, pipeline task run ID and event types.
+// Example:
+//
+//	"<application>/transactions/evm/events?evmChainID=1&types=confirmed,fatal_error"
+func (tc *TransactionsController) Events(c *gin.Context) {
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	filter, err := parseTxEventFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	conn, err := txEventsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrade has already replied to the client.
+		return
+	}
+	defer conn.Close()
+	// Clear the read deadline inherited from the HTTP server, the stream is long-lived.
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return
+	}
+
+	sub := chain.TxManager().SubscribeTxEvents(filter)
+	defer sub.Close()
+
+	// The client is not expected to send anything: read only to notice when it goes away.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	lggr := tc.App.GetLogger()
+	for {
+		select {
+		case <-done:
+			return
+		case ev, ok := <-sub.Events():
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "transaction manager stopped"), time.Now().Add(txEventsWriteTimeout))
+				return
+			}
+			if err := conn.SetWriteDeadline(time.Now().Add(txEventsWriteTimeout)); err != nil {
+				return
+			}
+			if err := conn.WriteJSON(ev); err != nil {
+				lggr.Debugw("Failed to write transaction event", "err", err)
+				return
+			}
+		}
+	}
+}
+
+func parseTxEventFilter(c *gin.Context) (func(txmgr.TxEvent) bool, error) {
+	var txID int64
+	if s := c.Query("txID"); s != "" {
+		var err error
+		if txID, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, errors.Wrap(err, "invalid txID")
+		}
+	}
+	var runID uuid.UUID
+	if s := c.Query("pipelineTaskRunID"); s != "" {
+		var err error
+		if runID, err = uuid.Parse(s); err != nil {
+			return nil, errors.Wrap(err, "invalid pipelineTaskRunID")
+		}
+	}
+	idempotencyKey := c.Query("idempotencyKey")
+	types := make(map[commontxmgr.TxEventType]bool)
+	if s := c.Query("types"); s != "" {
+		for _, t := range strings.Split(s, ",") {
+			types[commontxmgr.TxEventType(strings.TrimSpace(t))] = true
+		}
+	}
+
+	return func(ev txmgr.TxEvent) bool {
+		if txID != 0 && ev.TxID != txID {
+			return false
+		}
+		if runID != uuid.Nil && (!ev.PipelineTaskRunID.Valid || ev.PipelineTaskRunID.UUID != runID) {
+			return false
+		}
+		if idempotencyKey != "" && (ev.IdempotencyKey == nil || *ev.IdempotencyKey != idempotencyKey) {
+			return false
+		}
+		if len(types) > 0 && !types[ev.Type] {
+			return false
+		}
+		return true
+	}, nil
+}
@@ -2,19 +2,36 @@ package web_test
 
 import (
 	"fmt"
+	"math/big"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	legacyevmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	chainlinkmocks "github.com/smartcontractkit/chainlink/v2/core/services/chainlink/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -129,3 +146,131 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Events_InvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	for _, query := range []string{"evmChainID=abc", "txID=abc", "pipelineTaskRunID=abc"} {
+		resp, cleanup := client.Get("/v2/transactions/evm/events?" + query)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestTransactionsController_Events(t *testing.T) {
+	t.Parallel()
+
+	events := txmgrcommon.NewTxEvents[common.Address, common.Hash](logger.TestLogger(t))
+	subs := make(chan *txmgr.TxEventSubscription, 1)
+	txm := txmmocks.NewMockEvmTxManager(t)
+	txm.EXPECT().SubscribeTxEvents(mock.Anything).RunAndReturn(func(filter func(txmgr.TxEvent) bool) *txmgr.TxEventSubscription {
+		sub := events.Subscribe(filter)
+		subs <- sub
+		return sub
+	})
+	chain := legacyevmmocks.NewChain(t)
+	chain.On("TxManager").Return(txm)
+	chains := legacyevmmocks.NewLegacyChainContainer(t)
+	chains.On("Get", "0").Return(chain, nil)
+	app := mocks.NewApplication(t)
+	app.On("GetRelayers").Return(&chainlinkmocks.FakeRelayerChainInteroperators{EVMChains: chains})
+	app.On("GetLogger").Return(logger.TestLogger(t)).Maybe()
+
+	router := gin.New()
+	router.GET("/v2/transactions/evm/events", (&web.TransactionsController{App: app}).Events)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v2/transactions/evm/events?evmChainID=0&idempotencyKey=watched&types=unconfirmed,confirmed"
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	sub := <-subs
+
+	watched, other := "watched", "other"
+	hash := evmutils.NewHash()
+	events.Publish(txmgrcommon.TxEvent[common.Address, common.Hash]{Type: txmgrcommon.TxEventUnconfirmed, TxID: 1, IdempotencyKey: &other})
+	events.Publish(txmgrcommon.TxEvent[common.Address, common.Hash]{Type: txmgrcommon.TxEventUnstarted, TxID: 2, IdempotencyKey: &watched})
+	events.Publish(txmgrcommon.TxEvent[common.Address, common.Hash]{Type: txmgrcommon.TxEventUnconfirmed, TxID: 2, IdempotencyKey: &watched, TxHash: &hash})
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(testutils.WaitTimeout(t))))
+	var ev txmgr.TxEvent
+	require.NoError(t, conn.ReadJSON(&ev))
+	assert.Equal(t, txmgrcommon.TxEventUnconfirmed, ev.Type)
+	assert.Equal(t, int64(2), ev.TxID)
+	require.NotNil(t, ev.IdempotencyKey)
+	assert.Equal(t, watched, *ev.IdempotencyKey)
+	require.NotNil(t, ev.TxHash)
+	assert.Equal(t, hash, *ev.TxHash)
+
+	t.Run("closes the subscription when the client disconnects", func(t *testing.T) {
+		require.NoError(t, conn.Close())
+		require.Eventually(t, func() bool {
+			select {
+			case _, ok := <-sub.Events():
+				return !ok
+			default:
+				return false
+			}
+		}, testutils.WaitTimeout(t), testutils.TestInterval)
+	})
+
+	t.Run("closes the connection when the Txm stops", func(t *testing.T) {
+		conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+		<-subs
+
+		events.Close()
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(testutils.WaitTimeout(t))))
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), "unexpected error: %v", err)
+	})
+}
+
+func TestTransactionsController_Spend(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+	receipt := evmtypes.Receipt{
+		TxHash:            tx.TxAttempts[0].Hash,
+		BlockHash:         evmutils.NewHash(),
+		BlockNumber:       big.NewInt(42),
+		GasUsed:           21_000,
+		EffectiveGasPrice: big.NewInt(10),
+	}
+	require.NoError(t, txStore.SaveFetchedReceipts(ctx, []*evmtypes.Receipt{&receipt}, txmgrcommon.TxConfirmed, nil, testutils.FixtureChainID))
+
+	resp, cleanup := client.Get("/v2/transactions/evm/spend?groupBy=key&window=1h")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var spend []presenters.EthTxSpendResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &spend))
+	require.Len(t, spend, 1)
+	require.NotNil(t, spend[0].Address)
+	assert.Equal(t, from, *spend[0].Address)
+	assert.Equal(t, int64(1), spend[0].TxCount)
+	assert.Equal(t, "210000", spend[0].TotalFee.ToInt().String())
+
+	for _, query := range []string{"evmChainID=abc", "window=abc", "window=-1h", "groupBy=abc"} {
+		resp, cleanup := client.Get("/v2/transactions/evm/spend?" + query)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+}
@@ -0,0 +1,84 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// HTTPCredentialsController manages the credentials used by HTTP tasks
+type HTTPCredentialsController struct {
+	App chainlink.Application
+}
+
+// Index lists HTTP credentials, without their secrets
+// Example:
+// "GET <application>/keys/http"
+func (ctrl *HTTPCredentialsController) Index(c *gin.Context) {
+	creds, err := ctrl.App.GetKeyStore().HTTPCredentials().GetAll()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewHTTPCredentialResources(creds), "httpCredentials")
+}
+
+// Create stores an HTTP credential
+// Example:
+// "POST <application>/keys/http"
+func (ctrl *HTTPCredentialsController) Create(c *gin.Context) {
+	var cred httpcredential.Credential
+	if err := json.NewDecoder(c.Request.Body).Decode(&cred); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := cred.Validate(); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := ctrl.App.GetKeyStore().HTTPCredentials().Add(c.Request.Context(), cred); err != nil {
+		if errors.Is(err, keystore.ErrKeyExists) {
+			jsonAPIError(c, http.StatusConflict, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctrl.App.GetAuditLogger().Audit(audit.HTTPCredentialCreated, map[string]interface{}{
+		"name":    cred.Name,
+		"schemes": cred.Schemes(),
+	})
+
+	jsonAPIResponseWithStatus(c, presenters.NewHTTPCredentialResource(cred), "httpCredential", http.StatusCreated)
+}
+
+// Delete removes an HTTP credential
+// Example:
+// "DELETE <application>/keys/http/:name"
+func (ctrl *HTTPCredentialsController) Delete(c *gin.Context) {
+	ks := ctrl.App.GetKeyStore().HTTPCredentials()
+	name := c.Param("name")
+	if _, err := ks.Get(name); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	cred, err := ks.Delete(c.Request.Context(), name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctrl.App.GetAuditLogger().Audit(audit.HTTPCredentialDeleted, map[string]interface{}{"name": name})
+
+	jsonAPIResponse(c, presenters.NewHTTPCredentialResource(cred), "httpCredential")
+}
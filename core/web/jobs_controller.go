@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -135,6 +136,41 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to simulate the pipeline of a job without creating it.
+type SimulateJobRequest struct {
+	TOML string `json:"toml"`
+	// Fixtures are the stubbed results of http, bridge, ethcall and ethtx tasks, keyed by task DOT ID
+	Fixtures pipeline.SimulationFixtures `json:"fixtures"`
+	// Vars are the pipeline variables, such as jobRun.meta, which would be provided by the job's trigger
+	Vars map[string]interface{} `json:"vars"`
+}
+
+// Simulate validates a job spec and executes its pipeline in memory, with stubbed results for tasks which would
+// reach out to external adapters, HTTP endpoints or chains. Nothing is created, broadcast or persisted.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	result, err := jc.App.SimulateJobV2(c.Request.Context(), jb, request.Vars, request.Fixtures)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineSimulationResource(*result, jc.App.GetLogger()), "pipelineSimulation")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -0,0 +1,32 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/backupkey"
+)
+
+// BackupKeyResource represents a backup key JSONAPI resource. Backup keys are symmetric, so only their ID is shown.
+type BackupKeyResource struct {
+	JAID
+}
+
+// GetName implements the api2go EntityNamer interface
+func (BackupKeyResource) GetName() string {
+	return "encryptedBackupKeys"
+}
+
+func NewBackupKeyResource(key backupkey.Key) *BackupKeyResource {
+	r := &BackupKeyResource{
+		JAID: JAID{ID: key.ID()},
+	}
+
+	return r
+}
+
+func NewBackupKeyResources(keys []backupkey.Key) []BackupKeyResource {
+	rs := []BackupKeyResource{}
+	for _, key := range keys {
+		rs = append(rs, *NewBackupKeyResource(key))
+	}
+
+	return rs
+}
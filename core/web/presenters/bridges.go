@@ -10,14 +10,49 @@ import (
 // BridgeResource represents a Bridge JSONAPI resource.
 type BridgeResource struct {
 	JAID
-	Name          string `json:"name"`
-	URL           string `json:"url"`
-	Confirmations uint32 `json:"confirmations"`
+	Name          string   `json:"name"`
+	URL           string   `json:"url"`
+	FallbackURLs  []string `json:"fallbackURLs"`
+	Confirmations uint32   `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 	CreatedAt              time.Time    `json:"createdAt"`
+	// Health is only provided when showing a single Bridge
+	Health []BridgeURLHealth `json:"health,omitempty"`
+}
+
+// BridgeURLHealth is the circuit breaker state and recent latency of a bridge URL.
+type BridgeURLHealth struct {
+	URL                 string     `json:"url"`
+	BreakerState        string     `json:"breakerState"`
+	ConsecutiveFailures uint32     `json:"consecutiveFailures"`
+	LastError           string     `json:"lastError,omitempty"`
+	LastCheckedAt       *time.Time `json:"lastCheckedAt"`
+	LatencyP50          string     `json:"latencyP50"`
+	LatencyP90          string     `json:"latencyP90"`
+	LatencyP99          string     `json:"latencyP99"`
+	LatencySamples      int        `json:"latencySamples"`
+}
+
+// NewBridgeURLHealth constructs the health of each URL of a bridge.
+func NewBridgeURLHealth(statuses []bridges.EndpointStatus) []BridgeURLHealth {
+	health := make([]BridgeURLHealth, len(statuses))
+	for i, s := range statuses {
+		health[i] = BridgeURLHealth{
+			URL:                 s.URL,
+			BreakerState:        string(s.State),
+			ConsecutiveFailures: s.ConsecutiveFailures,
+			LastError:           s.LastError,
+			LastCheckedAt:       s.LastCheckedAt,
+			LatencyP50:          s.Latency.P50.String(),
+			LatencyP90:          s.Latency.P90.String(),
+			LatencyP99:          s.Latency.P99.String(),
+			LatencySamples:      s.Latency.Samples,
+		}
+	}
+	return health
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -32,6 +67,7 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		JAID:                   NewJAID(b.Name.String()),
 		Name:                   b.Name.String(),
 		URL:                    b.URL.String(),
+		FallbackURLs:           b.FallbackURLs.Strings(),
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
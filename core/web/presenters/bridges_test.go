@@ -18,12 +18,15 @@ func TestBridgeResource(t *testing.T) {
 	t.Parallel()
 
 	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	fallbackURL, err := url.Parse("https://fallback.example.com/api")
+	require.NoError(t, err)
 	url, err := url.Parse("https://bridge.example.com/api")
 	require.NoError(t, err)
 
 	bridge := bridges.BridgeType{
 		Name:                   "test",
 		URL:                    models.WebURL(*url),
+		FallbackURLs:           bridges.WebURLs{models.WebURL(*fallbackURL)},
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
@@ -43,6 +46,7 @@ func TestBridgeResource(t *testing.T) {
 		"attributes":{
 			"name":"test",
 			"url":"https://bridge.example.com/api",
+			"fallbackURLs":["https://fallback.example.com/api"],
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
@@ -67,6 +71,7 @@ func TestBridgeResource(t *testing.T) {
 		"attributes":{
 			"name":"test",
 			"url":"https://bridge.example.com/api",
+			"fallbackURLs":["https://fallback.example.com/api"],
 			"confirmations":1,
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
@@ -75,6 +80,50 @@ func TestBridgeResource(t *testing.T) {
 		}
 	}
 }
+`
+
+	assert.JSONEq(t, expected, string(b))
+
+	// Test insertion of Health
+	r.IncomingToken = ""
+	r.Health = NewBridgeURLHealth([]bridges.EndpointStatus{{
+		URL:                 url.String(),
+		State:               bridges.BreakerOpen,
+		ConsecutiveFailures: 5,
+		LastError:           "connection refused",
+		LastCheckedAt:       &timestamp,
+		Latency:             bridges.LatencyPercentiles{P50: 100 * time.Millisecond, P90: 250 * time.Millisecond, P99: time.Second, Samples: 10},
+	}})
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"fallbackURLs":["https://fallback.example.com/api"],
+			"confirmations":1,
+			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"minimumContractPayment":"1",
+			"createdAt":"2000-01-01T00:00:00Z",
+			"health":[{
+				"url":"https://bridge.example.com/api",
+				"breakerState":"open",
+				"consecutiveFailures":5,
+				"lastError":"connection refused",
+				"lastCheckedAt":"2000-01-01T00:00:00Z",
+				"latencyP50":"100ms",
+				"latencyP90":"250ms",
+				"latencyP99":"1s",
+				"latencySamples":10
+			}]
+		}
+	}
+}
 `
 
 	assert.JSONEq(t, expected, string(b))
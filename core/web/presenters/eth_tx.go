@@ -77,3 +77,40 @@ func NewEthTxResourceFromAttempt(txa txmgr.TxAttempt) EthTxResource {
 	}
 	return r
 }
+
+// EthTxSpendResource represents the gas spent by the EVM transactions of a job or a key.
+type EthTxSpendResource struct {
+	JAID
+	EVMChainID big.Big         `json:"evmChainID"`
+	JobID      *int32          `json:"jobID,omitempty"`
+	Address    *common.Address `json:"address,omitempty"`
+	TxCount    int64           `json:"txCount"`
+	GasUsed    int64           `json:"gasUsed"`
+	L1Fee      assets.Eth      `json:"l1Fee"`
+	TotalFee   assets.Eth      `json:"totalFee"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthTxSpendResource) GetName() string {
+	return "evm_transaction_spend"
+}
+
+// NewEthTxSpendResource generates a EthTxSpendResource from a txmgr.TxSpend.
+func NewEthTxSpendResource(s txmgr.TxSpend) EthTxSpendResource {
+	var id string
+	if s.JobID != nil {
+		id = "job-" + strconv.FormatInt(int64(*s.JobID), 10)
+	} else if s.FromAddress != nil {
+		id = "key-" + s.FromAddress.Hex()
+	}
+	return EthTxSpendResource{
+		JAID:       NewPrefixedJAID(id, s.EVMChainID.String()),
+		EVMChainID: s.EVMChainID,
+		JobID:      s.JobID,
+		Address:    s.FromAddress,
+		TxCount:    s.TxCount,
+		GasUsed:    s.GasUsed,
+		L1Fee:      assets.Eth(s.L1Fee),
+		TotalFee:   assets.Eth(s.TotalFee),
+	}
+}
@@ -0,0 +1,57 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/httpcredential"
+)
+
+// HTTPCredentialResource represents an HTTP credential JSONAPI resource. It never includes secrets.
+type HTTPCredentialResource struct {
+	JAID
+	Name           string     `json:"name"`
+	Schemes        []string   `json:"schemes"`
+	AllowedHosts   []string   `json:"allowedHosts"`
+	Headers        []string   `json:"headers"`
+	OAuth2TokenURL string     `json:"oauth2TokenURL,omitempty"`
+	OAuth2ClientID string     `json:"oauth2ClientID,omitempty"`
+	HMACKeyID      string     `json:"hmacKeyID,omitempty"`
+	TLSNotAfter    *time.Time `json:"tlsNotAfter,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (HTTPCredentialResource) GetName() string {
+	return "httpCredentials"
+}
+
+func NewHTTPCredentialResource(cred httpcredential.Credential) *HTTPCredentialResource {
+	r := &HTTPCredentialResource{
+		JAID:         NewJAID(cred.ID()),
+		Name:         cred.Name,
+		Schemes:      cred.Schemes(),
+		AllowedHosts: cred.AllowedHosts,
+		Headers:      cred.HeaderNames(),
+	}
+	if cred.OAuth2 != nil {
+		r.OAuth2TokenURL = cred.OAuth2.TokenURL
+		r.OAuth2ClientID = cred.OAuth2.ClientID
+	}
+	if cred.HMAC != nil {
+		r.HMACKeyID = cred.HMAC.KeyID
+	}
+	if cred.TLS != nil {
+		if notAfter, err := cred.TLS.NotAfter(); err == nil {
+			r.TLSNotAfter = &notAfter
+		}
+	}
+	return r
+}
+
+func NewHTTPCredentialResources(creds []httpcredential.Credential) []HTTPCredentialResource {
+	rs := []HTTPCredentialResource{}
+	for _, cred := range creds {
+		rs = append(rs, *NewHTTPCredentialResource(cred))
+	}
+
+	return rs
+}
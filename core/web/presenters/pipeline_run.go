@@ -104,3 +104,90 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineSimulationResource presents an in-memory pipeline run with stubbed task results
+type PipelineSimulationResource struct {
+	JAID
+	Outputs     []*string                          `json:"outputs"`
+	AllErrors   []*string                          `json:"allErrors"`
+	FatalErrors []*string                          `json:"fatalErrors"`
+	TaskRuns    []PipelineSimulatedTaskRunResource `json:"taskRuns"`
+	CreatedAt   time.Time                          `json:"createdAt"`
+	FinishedAt  null.Time                          `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineSimulationResource) GetName() string {
+	return "pipelineSimulation"
+}
+
+func NewPipelineSimulationResource(sr pipeline.SimulationResult, lggr logger.Logger) PipelineSimulationResource {
+	lggr = lggr.Named("PipelineSimulationResource")
+	outputs, err := sr.Run.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", sr.Run.Outputs)
+	}
+	var trs []PipelineSimulatedTaskRunResource
+	for _, tr := range sr.TaskRuns {
+		trs = append(trs, NewPipelineSimulatedTaskRunResource(tr))
+	}
+	return PipelineSimulationResource{
+		JAID:        NewJAIDInt64(sr.Run.ID),
+		Outputs:     outputs,
+		AllErrors:   sr.Run.StringAllErrors(),
+		FatalErrors: sr.Run.StringFatalErrors(),
+		TaskRuns:    trs,
+		CreatedAt:   sr.Run.CreatedAt,
+		FinishedAt:  sr.Run.FinishedAt,
+	}
+}
+
+// PipelineSimulatedTaskRunResource presents a task executed, or stubbed, in a simulation.
+// Inputs, Output and Error are JSON encoded, like PipelineTaskRunResource.
+type PipelineSimulatedTaskRunResource struct {
+	DotID      string                       `json:"dotId"`
+	Type       pipeline.TaskType            `json:"type"`
+	Stubbed    bool                         `json:"stubbed"`
+	Inputs     []PipelineSimulatedTaskInput `json:"inputs"`
+	Output     *string                      `json:"output"`
+	Error      *string                      `json:"error"`
+	CreatedAt  time.Time                    `json:"createdAt"`
+	FinishedAt null.Time                    `json:"finishedAt"`
+	Duration   string                       `json:"duration"`
+}
+
+type PipelineSimulatedTaskInput struct {
+	Value *string `json:"value"`
+	Error *string `json:"error"`
+}
+
+func NewPipelineSimulatedTaskRunResource(tr pipeline.SimulatedTaskRun) PipelineSimulatedTaskRunResource {
+	inputs := make([]PipelineSimulatedTaskInput, len(tr.Inputs))
+	for i, in := range tr.Inputs {
+		inputs[i].Value, inputs[i].Error = resultStrings(in)
+	}
+	output, errString := resultStrings(tr.Result)
+	return PipelineSimulatedTaskRunResource{
+		DotID:      tr.DotID,
+		Type:       tr.Type,
+		Stubbed:    tr.Stubbed,
+		Inputs:     inputs,
+		Output:     output,
+		Error:      errString,
+		CreatedAt:  tr.CreatedAt,
+		FinishedAt: tr.FinishedAt,
+		Duration:   tr.Duration().String(),
+	}
+}
+
+func resultStrings(r pipeline.Result) (output *string, errString *string) {
+	if out := r.OutputDB(); out.Valid {
+		outputBytes, _ := out.MarshalJSON()
+		outputStr := string(outputBytes)
+		output = &outputStr
+	}
+	if e := r.ErrorDB(); e.Valid {
+		errString = &e.String
+	}
+	return
+}
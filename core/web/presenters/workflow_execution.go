@@ -0,0 +1,99 @@
+package presenters
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a single execution of a workflow.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecution"
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource. Steps are ordered by their ref.
+func NewWorkflowExecutionResource(e store.WorkflowExecution) WorkflowExecutionResource {
+	steps := make([]WorkflowExecutionStepResource, 0, len(e.Steps))
+	for _, s := range e.Steps {
+		steps = append(steps, NewWorkflowExecutionStepResource(*s))
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Ref < steps[j].Ref })
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(e.ExecutionID),
+		WorkflowID: e.WorkflowID,
+		Status:     e.Status,
+		Steps:      steps,
+		CreatedAt:  e.CreatedAt,
+		UpdatedAt:  e.UpdatedAt,
+		FinishedAt: e.FinishedAt,
+	}
+}
+
+// NewWorkflowExecutionResources constructs a slice of WorkflowExecutionResource.
+func NewWorkflowExecutionResources(executions []store.WorkflowExecution) []WorkflowExecutionResource {
+	rs := make([]WorkflowExecutionResource, 0, len(executions))
+	for _, e := range executions {
+		rs = append(rs, NewWorkflowExecutionResource(e))
+	}
+	return rs
+}
+
+// WorkflowExecutionStepResource represents the state of a single step of a workflow execution.
+type WorkflowExecutionStepResource struct {
+	Ref       string              `json:"ref"`
+	Status    string              `json:"status"`
+	Inputs    json.RawMessage     `json:"inputs"`
+	Outputs   json.RawMessage     `json:"outputs"`
+	Error     *string             `json:"error"`
+	Attempts  []store.StepAttempt `json:"attempts"`
+	UpdatedAt *time.Time          `json:"updatedAt"`
+}
+
+// NewWorkflowExecutionStepResource constructs a new WorkflowExecutionStepResource.
+func NewWorkflowExecutionStepResource(s store.WorkflowExecutionStep) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:       s.Ref,
+		Status:    s.Status,
+		Attempts:  s.Attempts,
+		UpdatedAt: s.UpdatedAt,
+	}
+	if s.Inputs != nil {
+		r.Inputs = valueToJSON(s.Inputs)
+	}
+	if s.Outputs.Value != nil {
+		r.Outputs = valueToJSON(s.Outputs.Value)
+	}
+	if s.Outputs.Err != nil {
+		msg := s.Outputs.Err.Error()
+		r.Error = &msg
+	}
+	return r
+}
+
+// valueToJSON renders v as JSON, falling back to null for values which cannot be represented.
+func valueToJSON(v values.Value) json.RawMessage {
+	unwrapped, err := v.Unwrap()
+	if err != nil {
+		return nil
+	}
+	b, err := json.Marshal(unwrapped)
+	if err != nil {
+		return nil
+	}
+	return b
+}
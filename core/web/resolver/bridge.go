@@ -39,6 +39,11 @@ func (r *BridgeResolver) URL() string {
 	return r.bridge.URL.String()
 }
 
+// FallbackURLs resolves the bridge's fallback urls.
+func (r *BridgeResolver) FallbackURLs() []string {
+	return r.bridge.FallbackURLs.Strings()
+}
+
 // Confirmations resolves the bridge's url.
 func (r *BridgeResolver) Confirmations() int32 {
 	return int32(r.bridge.Confirmations)
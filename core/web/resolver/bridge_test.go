@@ -95,6 +95,7 @@ func Test_Bridge(t *testing.T) {
 						id
 						name
 						url
+						fallbackURLs
 						confirmations
 						outgoingToken
 						minimumContractPayment
@@ -111,6 +112,8 @@ func Test_Bridge(t *testing.T) {
 	)
 	bridgeURL, err := url.Parse("https://external.adapter")
 	require.NoError(t, err)
+	fallbackURL, err := url.Parse("https://fallback.adapter")
+	require.NoError(t, err)
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: query}, "bridge"),
@@ -122,6 +125,7 @@ func Test_Bridge(t *testing.T) {
 				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridges.BridgeType{
 					Name:                   name,
 					URL:                    models.WebURL(*bridgeURL),
+					FallbackURLs:           bridges.WebURLs{models.WebURL(*fallbackURL)},
 					Confirmations:          uint32(1),
 					OutgoingToken:          "outgoingToken",
 					MinimumContractPayment: assets.NewLinkFromJuels(1),
@@ -134,6 +138,7 @@ func Test_Bridge(t *testing.T) {
 					"id": "bridge1",
 					"name": "bridge1",
 					"url": "https://external.adapter",
+					"fallbackURLs": ["https://fallback.adapter"],
 					"confirmations": 1,
 					"outgoingToken": "outgoingToken",
 					"minimumContractPayment": "1",
@@ -174,6 +179,7 @@ func Test_CreateBridge(t *testing.T) {
 							id
 							name
 							url
+							fallbackURLs
 							confirmations
 							outgoingToken
 							minimumContractPayment
@@ -186,6 +192,7 @@ func Test_CreateBridge(t *testing.T) {
 			"input": map[string]interface{}{
 				"name":                   "bridge1",
 				"url":                    "https://external.adapter",
+				"fallbackURLs":           []interface{}{"https://fallback.adapter"},
 				"confirmations":          1,
 				"minimumContractPayment": "1",
 			},
@@ -193,6 +200,8 @@ func Test_CreateBridge(t *testing.T) {
 	)
 	bridgeURL, err := url.Parse("https://external.adapter")
 	require.NoError(t, err)
+	fallbackURL, err := url.Parse("https://fallback.adapter")
+	require.NoError(t, err)
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createBridge"),
@@ -205,9 +214,11 @@ func Test_CreateBridge(t *testing.T) {
 				f.Mocks.bridgeORM.On("CreateBridgeType", mock.Anything, mock.IsType(&bridges.BridgeType{})).
 					Run(func(args mock.Arguments) {
 						arg := args.Get(1).(*bridges.BridgeType)
+						assert.Equal(t, []string{"https://fallback.adapter"}, arg.FallbackURLs.Strings())
 						*arg = bridges.BridgeType{
 							Name:                   name,
 							URL:                    models.WebURL(*bridgeURL),
+							FallbackURLs:           bridges.WebURLs{models.WebURL(*fallbackURL)},
 							Confirmations:          uint32(1),
 							OutgoingToken:          "outgoingToken",
 							MinimumContractPayment: assets.NewLinkFromJuels(1),
@@ -227,6 +238,7 @@ func Test_CreateBridge(t *testing.T) {
 							"id": "bridge1",
 							"name": "bridge1",
 							"url": "https://external.adapter",
+							"fallbackURLs": ["https://fallback.adapter"],
 							"confirmations": 1,
 							"outgoingToken": "outgoingToken",
 							"minimumContractPayment": "1",
@@ -254,6 +266,7 @@ func Test_UpdateBridge(t *testing.T) {
 							id
 							name
 							url
+							fallbackURLs
 							confirmations
 							outgoingToken
 							minimumContractPayment
@@ -282,45 +295,59 @@ func Test_UpdateBridge(t *testing.T) {
 	newBridgeURL, err := url.Parse("https://external.adapter.new")
 	require.NoError(t, err)
 
-	testCases := []GQLTestCase{
-		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateBridge"),
-		{
-			name:          "success",
-			authenticated: true,
-			before: func(ctx context.Context, f *gqlTestFramework) {
-				// Initialize the existing bridge
-				bridge := bridges.BridgeType{
-					Name:                   name,
-					URL:                    models.WebURL(*bridgeURL),
-					Confirmations:          uint32(1),
-					OutgoingToken:          "outgoingToken",
-					MinimumContractPayment: assets.NewLinkFromJuels(1),
-					CreatedAt:              f.Timestamp(),
-				}
+	fallbackURL, err := url.Parse("https://fallback.adapter")
+	require.NoError(t, err)
 
-				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
-				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridge, nil)
+	newFallbackURL, err := url.Parse("https://fallback.adapter.new")
+	require.NoError(t, err)
+
+	// mockUpdate expects the bridge to be updated with fallbackURLs
+	mockUpdate := func(f *gqlTestFramework, fallbackURLs bridges.WebURLs) {
+		// Initialize the existing bridge
+		bridge := bridges.BridgeType{
+			Name:                   name,
+			URL:                    models.WebURL(*bridgeURL),
+			FallbackURLs:           bridges.WebURLs{models.WebURL(*fallbackURL)},
+			Confirmations:          uint32(1),
+			OutgoingToken:          "outgoingToken",
+			MinimumContractPayment: assets.NewLinkFromJuels(1),
+			CreatedAt:              f.Timestamp(),
+		}
+
+		f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+		f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridge, nil)
+
+		btr := &bridges.BridgeTypeRequest{
+			Name:                   bridges.BridgeName("bridge-updated"),
+			URL:                    models.WebURL(*newBridgeURL),
+			FallbackURLs:           fallbackURLs,
+			Confirmations:          2,
+			MinimumContractPayment: assets.NewLinkFromJuels(2),
+		}
 
-				btr := &bridges.BridgeTypeRequest{
-					Name:                   bridges.BridgeName("bridge-updated"),
+		f.Mocks.bridgeORM.On("UpdateBridgeType", mock.Anything, mock.IsType(&bridges.BridgeType{}), btr).
+			Run(func(args mock.Arguments) {
+				arg := args.Get(1).(*bridges.BridgeType)
+				*arg = bridges.BridgeType{
+					Name:                   "bridge-updated",
 					URL:                    models.WebURL(*newBridgeURL),
+					FallbackURLs:           fallbackURLs,
 					Confirmations:          2,
+					OutgoingToken:          "outgoingToken",
 					MinimumContractPayment: assets.NewLinkFromJuels(2),
+					CreatedAt:              f.Timestamp(),
 				}
+			}).
+			Return(nil)
+	}
 
-				f.Mocks.bridgeORM.On("UpdateBridgeType", mock.Anything, mock.IsType(&bridges.BridgeType{}), btr).
-					Run(func(args mock.Arguments) {
-						arg := args.Get(1).(*bridges.BridgeType)
-						*arg = bridges.BridgeType{
-							Name:                   "bridge-updated",
-							URL:                    models.WebURL(*newBridgeURL),
-							Confirmations:          2,
-							OutgoingToken:          "outgoingToken",
-							MinimumContractPayment: assets.NewLinkFromJuels(2),
-							CreatedAt:              f.Timestamp(),
-						}
-					}).
-					Return(nil)
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateBridge"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				mockUpdate(f, bridges.WebURLs{models.WebURL(*fallbackURL)})
 			},
 			query:     mutation,
 			variables: variables,
@@ -330,6 +357,39 @@ func Test_UpdateBridge(t *testing.T) {
 						"id": "bridge-updated",
 						"name": "bridge-updated",
 						"url": "https://external.adapter.new",
+						"fallbackURLs": ["https://fallback.adapter"],
+						"confirmations": 2,
+						"outgoingToken": "outgoingToken",
+						"minimumContractPayment": "2",
+						"createdAt": "2021-01-01T00:00:00Z"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "replaces fallback urls",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				mockUpdate(f, bridges.WebURLs{models.WebURL(*newFallbackURL)})
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": "bridge1",
+				"input": map[string]interface{}{
+					"name":                   "bridge-updated",
+					"url":                    "https://external.adapter.new",
+					"fallbackURLs":           []interface{}{"https://fallback.adapter.new"},
+					"confirmations":          2,
+					"minimumContractPayment": "2",
+				},
+			},
+			result: `{
+				"updateBridge": {
+					"bridge": {
+						"id": "bridge-updated",
+						"name": "bridge-updated",
+						"url": "https://external.adapter.new",
+						"fallbackURLs": ["https://fallback.adapter.new"],
 						"confirmations": 2,
 						"outgoingToken": "outgoingToken",
 						"minimumContractPayment": "2",
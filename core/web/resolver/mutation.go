@@ -57,10 +57,27 @@ type Resolver struct {
 type createBridgeInput struct {
 	Name                   string
 	URL                    string
+	FallbackURLs           *[]string
 	Confirmations          int32
 	MinimumContractPayment string
 }
 
+// parseBridgeFallbackURLs parses the fallback URLs of a bridge input, which are optional.
+func parseBridgeFallbackURLs(urls *[]string) (bridges.WebURLs, error) {
+	if urls == nil {
+		return nil, nil
+	}
+	webURLs := make(bridges.WebURLs, 0, len(*urls))
+	for _, u := range *urls {
+		rURL, err := url.ParseRequestURI(u)
+		if err != nil {
+			return nil, err
+		}
+		webURLs = append(webURLs, models.WebURL(*rURL))
+	}
+	return webURLs, nil
+}
+
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
 	if err := authenticateUserCanEdit(ctx); err != nil {
@@ -75,14 +92,19 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		}
 		webURL = models.WebURL(*rURL)
 	}
+	fallbackURLs, err := parseBridgeFallbackURLs(args.Input.FallbackURLs)
+	if err != nil {
+		return nil, err
+	}
 	minContractPayment := &assets.Link{}
-	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
+	if err = minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
+		FallbackURLs:           fallbackURLs,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
@@ -107,6 +129,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeFallbackURLs":           bta.FallbackURLs,
 	})
 
 	return NewCreateBridgePayload(*bt, bta.IncomingToken), nil
@@ -450,6 +473,7 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 type updateBridgeInput struct {
 	Name                   string
 	URL                    string
+	FallbackURLs           *[]string
 	Confirmations          int32
 	MinimumContractPayment string
 }
@@ -496,6 +520,12 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	// Fallback URLs are replaced only when given, so that clients unaware of them do not clear them
+	if args.Input.FallbackURLs == nil {
+		btr.FallbackURLs = bridge.FallbackURLs
+	} else if btr.FallbackURLs, err = parseBridgeFallbackURLs(args.Input.FallbackURLs); err != nil {
+		return nil, err
+	}
 
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
@@ -511,6 +541,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		"bridgeConfirmations":          bridge.Confirmations,
 		"bridgeMinimumContractPayment": bridge.MinimumContractPayment,
 		"bridgeURL":                    bridge.URL,
+		"bridgeFallbackURLs":           bridge.FallbackURLs,
 	})
 
 	return NewUpdateBridgePayload(&bridge, nil), nil
@@ -1310,3 +1341,76 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+// CancelWorkflowExecution cancels a workflow execution which is in progress.
+func (r *Resolver) CancelWorkflowExecution(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*CancelWorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id := string(args.ID)
+	engine, err := r.workflowEngineForExecution(ctx, id)
+	if err == nil {
+		err = engine.CancelExecution(ctx, id)
+	}
+	if err != nil {
+		if isWorkflowExecutionMutationError(err) {
+			return NewCancelWorkflowExecutionPayload(nil, err), nil
+		}
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowORM().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WorkflowExecutionCancelled, map[string]interface{}{"executionID": id})
+	return NewCancelWorkflowExecutionPayload(&execution, nil), nil
+}
+
+// ReplayWorkflowExecution starts a new execution with the trigger output of a finished workflow execution.
+func (r *Resolver) ReplayWorkflowExecution(ctx context.Context, args struct {
+	ID             graphql.ID
+	FromFailedStep *bool
+}) (*ReplayWorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id := string(args.ID)
+	fromFailedStep := args.FromFailedStep != nil && *args.FromFailedStep
+	var replayID string
+	engine, err := r.workflowEngineForExecution(ctx, id)
+	if err == nil {
+		replayID, err = engine.ReplayExecution(ctx, id, fromFailedStep)
+	}
+	if err != nil {
+		if isWorkflowExecutionMutationError(err) {
+			return NewReplayWorkflowExecutionPayload(nil, err), nil
+		}
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowORM().Get(ctx, replayID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WorkflowExecutionReplayed, map[string]interface{}{
+		"executionID":    id,
+		"replayID":       replayID,
+		"fromFailedStep": fromFailedStep,
+	})
+	return NewReplayWorkflowExecutionPayload(&execution, nil), nil
+}
+
+func (r *Resolver) workflowEngineForExecution(ctx context.Context, executionID string) (*workflows.Engine, error) {
+	execution, err := r.App.WorkflowORM().Get(ctx, executionID)
+	if err != nil {
+		return nil, err
+	}
+	return r.App.WorkflowEngines().Get(execution.WorkflowID)
+}
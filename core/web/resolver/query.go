@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -445,6 +446,61 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 	return NewJobRunPayload(&jr, r.App, err), nil
 }
 
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	WorkflowID    *string
+	Statuses      *[]WorkflowExecutionStatus
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+	Offset        *int32
+	Limit         *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var filter store.ExecutionFilter
+	if args.WorkflowID != nil {
+		filter.WorkflowID = *args.WorkflowID
+	}
+	if args.Statuses != nil {
+		for _, s := range *args.Statuses {
+			filter.Statuses = append(filter.Statuses, FromWorkflowExecutionStatus(s))
+		}
+	}
+	if args.CreatedAfter != nil {
+		filter.CreatedAfter = &args.CreatedAfter.Time
+	}
+	if args.CreatedBefore != nil {
+		filter.CreatedBefore = &args.CreatedBefore.Time
+	}
+
+	executions, count, err := r.App.WorkflowORM().ListExecutions(ctx, filter, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
+
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowORM().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, store.ErrExecutionNotFound) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&execution, nil), nil
+}
+
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -0,0 +1,299 @@
+package resolver
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type WorkflowExecutionStatus string
+
+// ToWorkflowExecutionStatus converts a store status into the GraphQL enum value.
+func ToWorkflowExecutionStatus(status string) WorkflowExecutionStatus {
+	return WorkflowExecutionStatus(strings.ToUpper(status))
+}
+
+// FromWorkflowExecutionStatus converts the GraphQL enum value into a store status.
+func FromWorkflowExecutionStatus(status WorkflowExecutionStatus) string {
+	return strings.ToLower(string(status))
+}
+
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecution(execution store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: execution}
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	resolvers := make([]*WorkflowExecutionResolver, 0, len(executions))
+	for _, e := range executions {
+		resolvers = append(resolvers, NewWorkflowExecution(e))
+	}
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() WorkflowExecutionStatus {
+	return ToWorkflowExecutionStatus(r.execution.Status)
+}
+
+// Steps resolves the steps of the execution, ordered by their ref.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	steps := make([]*WorkflowExecutionStepResolver, 0, len(r.execution.Steps))
+	for _, s := range r.execution.Steps {
+		steps = append(steps, &WorkflowExecutionStepResolver{step: presenters.NewWorkflowExecutionStepResource(*s)})
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].step.Ref < steps[j].step.Ref })
+	return steps
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return optionalTime(r.execution.CreatedAt)
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.execution.UpdatedAt)
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return optionalTime(r.execution.FinishedAt)
+}
+
+type WorkflowExecutionStepResolver struct {
+	step presenters.WorkflowExecutionStepResource
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() string {
+	return r.step.Status
+}
+
+// Inputs resolves the step inputs encoded as JSON.
+func (r *WorkflowExecutionStepResolver) Inputs() *string {
+	return optionalJSON(r.step.Inputs)
+}
+
+// Outputs resolves the step outputs encoded as JSON.
+func (r *WorkflowExecutionStepResolver) Outputs() *string {
+	return optionalJSON(r.step.Outputs)
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	return r.step.Error
+}
+
+// Attempts resolves every attempt at executing the step, oldest first.
+func (r *WorkflowExecutionStepResolver) Attempts() []*WorkflowExecutionStepAttemptResolver {
+	attempts := make([]*WorkflowExecutionStepAttemptResolver, 0, len(r.step.Attempts))
+	for _, a := range r.step.Attempts {
+		attempts = append(attempts, &WorkflowExecutionStepAttemptResolver{attempt: a})
+	}
+	return attempts
+}
+
+func (r *WorkflowExecutionStepResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.step.UpdatedAt)
+}
+
+type WorkflowExecutionStepAttemptResolver struct {
+	attempt store.StepAttempt
+}
+
+func (r *WorkflowExecutionStepAttemptResolver) Attempt() int32 {
+	return int32(r.attempt.Attempt)
+}
+
+func (r *WorkflowExecutionStepAttemptResolver) Error() *string {
+	if r.attempt.Error == "" {
+		return nil
+	}
+	return &r.attempt.Error
+}
+
+func (r *WorkflowExecutionStepAttemptResolver) StartedAt() graphql.Time {
+	return graphql.Time{Time: r.attempt.StartedAt}
+}
+
+func (r *WorkflowExecutionStepAttemptResolver) FinishedAt() graphql.Time {
+	return graphql.Time{Time: r.attempt.FinishedAt}
+}
+
+// -- WorkflowExecution query --
+
+type WorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, store.ErrExecutionNotFound)
+	}}
+
+	return &WorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(*r.execution), true
+}
+
+// -- WorkflowExecutions query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{executions: executions, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// -- CancelWorkflowExecution mutation --
+
+type CancelWorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	workflowExecutionErrorUnionType
+}
+
+func NewCancelWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *CancelWorkflowExecutionPayloadResolver {
+	return &CancelWorkflowExecutionPayloadResolver{execution: execution, workflowExecutionErrorUnionType: newWorkflowExecutionErrorUnionType(err)}
+}
+
+func (r *CancelWorkflowExecutionPayloadResolver) ToCancelWorkflowExecutionSuccess() (*CancelWorkflowExecutionSuccessResolver, bool) {
+	if r.execution == nil {
+		return nil, false
+	}
+
+	return &CancelWorkflowExecutionSuccessResolver{execution: *r.execution}, true
+}
+
+type CancelWorkflowExecutionSuccessResolver struct {
+	execution store.WorkflowExecution
+}
+
+func (r *CancelWorkflowExecutionSuccessResolver) Execution() *WorkflowExecutionResolver {
+	return NewWorkflowExecution(r.execution)
+}
+
+// -- ReplayWorkflowExecution mutation --
+
+type ReplayWorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	workflowExecutionErrorUnionType
+}
+
+func NewReplayWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *ReplayWorkflowExecutionPayloadResolver {
+	return &ReplayWorkflowExecutionPayloadResolver{execution: execution, workflowExecutionErrorUnionType: newWorkflowExecutionErrorUnionType(err)}
+}
+
+func (r *ReplayWorkflowExecutionPayloadResolver) ToReplayWorkflowExecutionSuccess() (*ReplayWorkflowExecutionSuccessResolver, bool) {
+	if r.execution == nil {
+		return nil, false
+	}
+
+	return &ReplayWorkflowExecutionSuccessResolver{execution: *r.execution}, true
+}
+
+type ReplayWorkflowExecutionSuccessResolver struct {
+	execution store.WorkflowExecution
+}
+
+func (r *ReplayWorkflowExecutionSuccessResolver) Execution() *WorkflowExecutionResolver {
+	return NewWorkflowExecution(r.execution)
+}
+
+// workflowExecutionErrorUnionType resolves the errors shared by the workflow execution mutations.
+type workflowExecutionErrorUnionType struct {
+	NotFoundErrorUnionType
+}
+
+func newWorkflowExecutionErrorUnionType(err error) workflowExecutionErrorUnionType {
+	return workflowExecutionErrorUnionType{NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, store.ErrExecutionNotFound)
+	}}}
+}
+
+func (r *workflowExecutionErrorUnionType) ToWorkflowExecutionConflictError() (*WorkflowExecutionConflictErrorResolver, bool) {
+	if r.err == nil || errors.Is(r.err, store.ErrExecutionNotFound) {
+		return nil, false
+	}
+
+	code := ErrorCodeStatusConflict
+	if errors.Is(r.err, workflows.ErrEngineNotFound) {
+		code = ErrorCodeUnprocessable
+	}
+	return &WorkflowExecutionConflictErrorResolver{message: r.err.Error(), code: code}, true
+}
+
+// isWorkflowExecutionMutationError reports whether err is an expected error of a workflow execution mutation, which
+// is resolved as part of the payload rather than failing the request.
+func isWorkflowExecutionMutationError(err error) bool {
+	return errors.Is(err, store.ErrExecutionNotFound) ||
+		errors.Is(err, store.ErrExecutionFinished) ||
+		errors.Is(err, workflows.ErrEngineNotFound) ||
+		errors.Is(err, workflows.ErrExecutionRunning) ||
+		errors.Is(err, workflows.ErrNothingToReplay)
+}
+
+type WorkflowExecutionConflictErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func (r *WorkflowExecutionConflictErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *WorkflowExecutionConflictErrorResolver) Code() ErrorCode {
+	return r.code
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+func optionalJSON(b json.RawMessage) *string {
+	if len(b) == 0 {
+		return nil
+	}
+	s := string(b)
+	return &s
+}
@@ -0,0 +1,397 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestResolver_WorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions($workflowID: String, $statuses: [WorkflowExecutionStatus!], $createdAfter: Time, $offset: Int, $limit: Int) {
+			workflowExecutions(workflowID: $workflowID, statuses: $statuses, createdAfter: $createdAfter, offset: $offset, limit: $limit) {
+				results {
+					id
+					workflowID
+					status
+					createdAt
+					finishedAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"workflowID":   "workflow-1",
+		"statuses":     []interface{}{"ERRORED", "CANCELLED"},
+		"createdAfter": "2021-01-01T00:00:00Z",
+		"offset":       5,
+		"limit":        10,
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				createdAt := f.Timestamp()
+				filter := store.ExecutionFilter{
+					WorkflowID:   "workflow-1",
+					Statuses:     []string{store.StatusErrored, store.StatusCancelled},
+					CreatedAfter: &createdAt,
+				}
+				f.Mocks.workflowORM.On("ListExecutions", mock.Anything, filter, 5, 10).Return([]store.WorkflowExecution{
+					{
+						ExecutionID: "execution-1",
+						WorkflowID:  "workflow-1",
+						Status:      store.StatusErrored,
+						CreatedAt:   &createdAt,
+						FinishedAt:  &createdAt,
+					},
+				}, 11, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-1",
+							"workflowID": "workflow-1",
+							"status": "ERRORED",
+							"createdAt": "2021-01-01T00:00:00Z",
+							"finishedAt": "2021-01-01T00:00:00Z"
+						}],
+						"metadata": {
+							"total": 11
+						}
+					}
+				}`,
+		},
+		{
+			name:          "success without filters",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("ListExecutions", mock.Anything, store.ExecutionFilter{}, PageDefaultOffset, PageDefaultLimit).Return(nil, 0, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query: query,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [],
+						"metadata": {
+							"total": 0
+						}
+					}
+				}`,
+		},
+		{
+			name:          "generic error on ListExecutions()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("ListExecutions", mock.Anything, store.ExecutionFilter{}, PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecutions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					workflowID
+					status
+					steps {
+						ref
+						status
+						inputs
+						outputs
+						error
+						attempts {
+							attempt
+							error
+							startedAt
+							finishedAt
+						}
+						updatedAt
+					}
+					createdAt
+					updatedAt
+					finishedAt
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "execution-1",
+	}
+	gError := errors.New("error")
+
+	inputs, err := values.NewMap(map[string]any{"price": 100})
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				createdAt := f.Timestamp()
+				updatedAt := createdAt.Add(time.Minute)
+				f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{
+					ExecutionID: "execution-1",
+					WorkflowID:  "workflow-1",
+					Status:      store.StatusCompletedEarlyExit,
+					Steps: map[string]*store.WorkflowExecutionStep{
+						"write": {
+							Ref:     "write",
+							Status:  store.StatusErrored,
+							Outputs: store.StepOutput{Err: errors.New("write failed")},
+							Attempts: []store.StepAttempt{
+								{Attempt: 1, Error: "write failed", StartedAt: createdAt, FinishedAt: updatedAt},
+							},
+							UpdatedAt: &updatedAt,
+						},
+						"compute": {
+							Ref:     "compute",
+							Status:  store.StatusCompleted,
+							Inputs:  inputs,
+							Outputs: store.StepOutput{Value: values.NewString("done")},
+						},
+					},
+					CreatedAt: &createdAt,
+					UpdatedAt: &updatedAt,
+				}, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"id": "execution-1",
+						"workflowID": "workflow-1",
+						"status": "COMPLETED_EARLY_EXIT",
+						"steps": [{
+							"ref": "compute",
+							"status": "completed",
+							"inputs": "{\"price\":100}",
+							"outputs": "\"done\"",
+							"error": null,
+							"attempts": [],
+							"updatedAt": null
+						}, {
+							"ref": "write",
+							"status": "errored",
+							"inputs": null,
+							"outputs": null,
+							"error": "write failed",
+							"attempts": [{
+								"attempt": 1,
+								"error": "write failed",
+								"startedAt": "2021-01-01T00:00:00Z",
+								"finishedAt": "2021-01-01T00:01:00Z"
+							}],
+							"updatedAt": "2021-01-01T00:01:00Z"
+						}],
+						"createdAt": "2021-01-01T00:00:00Z",
+						"updatedAt": "2021-01-01T00:01:00Z",
+						"finishedAt": null
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{}, store.ErrExecutionNotFound)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error on Get()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{}, gError)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecution"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecutionMutations(t *testing.T) {
+	t.Parallel()
+
+	cancelMutation := `
+		mutation CancelWorkflowExecution($id: ID!) {
+			cancelWorkflowExecution(id: $id) {
+				... on CancelWorkflowExecutionSuccess {
+					execution {
+						id
+					}
+				}
+				... on WorkflowExecutionConflictError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	replayMutation := `
+		mutation ReplayWorkflowExecution($id: ID!, $fromFailedStep: Boolean) {
+			replayWorkflowExecution(id: $id, fromFailedStep: $fromFailedStep) {
+				... on ReplayWorkflowExecutionSuccess {
+					execution {
+						id
+					}
+				}
+				... on WorkflowExecutionConflictError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	gError := errors.New("error")
+
+	for _, m := range []struct {
+		name      string
+		mutation  string
+		variables map[string]interface{}
+	}{
+		{name: "cancelWorkflowExecution", mutation: cancelMutation, variables: map[string]interface{}{"id": "execution-1"}},
+		{name: "replayWorkflowExecution", mutation: replayMutation, variables: map[string]interface{}{"id": "execution-1", "fromFailedStep": true}},
+	} {
+		t.Run(m.name, func(t *testing.T) {
+			t.Parallel()
+
+			testCases := []GQLTestCase{
+				unauthorizedTestCase(GQLTestCase{query: m.mutation, variables: m.variables}, m.name),
+				{
+					name:          "not found error",
+					authenticated: true,
+					before: func(ctx context.Context, f *gqlTestFramework) {
+						f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{}, store.ErrExecutionNotFound)
+						f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+					},
+					query:     m.mutation,
+					variables: m.variables,
+					result: `
+						{
+							"` + m.name + `": {
+								"code": "NOT_FOUND",
+								"message": "workflow execution not found"
+							}
+						}`,
+				},
+				{
+					name:          "engine not running",
+					authenticated: true,
+					before: func(ctx context.Context, f *gqlTestFramework) {
+						f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{
+							ExecutionID: "execution-1",
+							WorkflowID:  "workflow-1",
+							Status:      store.StatusErrored,
+						}, nil)
+						f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+						f.App.On("WorkflowEngines").Return(workflows.NewEngineRegistry())
+					},
+					query:     m.mutation,
+					variables: m.variables,
+					result: `
+						{
+							"` + m.name + `": {
+								"code": "UNPROCESSABLE",
+								"message": "workflow engine not running: workflow-1"
+							}
+						}`,
+				},
+				{
+					name:          "generic error on Get()",
+					authenticated: true,
+					before: func(ctx context.Context, f *gqlTestFramework) {
+						f.Mocks.workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{}, gError)
+						f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+					},
+					query:     m.mutation,
+					variables: m.variables,
+					result:    `null`,
+					errors: []*gqlerrors.QueryError{
+						{
+							Extensions:    nil,
+							ResolverError: gError,
+							Path:          []interface{}{m.name},
+							Message:       gError.Error(),
+						},
+					},
+				},
+			}
+
+			RunGQLTests(t, testCases)
+		})
+	}
+}
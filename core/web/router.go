@@ -285,6 +285,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/evm/events", txs.Events)
+		authv2.GET("/transactions/evm/spend", txs.Spend)
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
@@ -351,6 +353,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"cosmos", NewCosmosKeysController(app)},
 			{"starknet", NewStarkNetKeysController(app)},
 			{"aptos", NewAptosKeysController(app)},
+			{"backup", NewBackupKeysController(app)},
 		} {
 			authv2.GET("/keys/"+keys.path, keys.kc.Index)
 			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
@@ -359,6 +362,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
 		}
 
+		httpcc := HTTPCredentialsController{app}
+		authv2.GET("/keys/http", httpcc.Index)
+		authv2.POST("/keys/http", auth.RequiresAdminRole(httpcc.Create))
+		authv2.DELETE("/keys/http/:name", auth.RequiresAdminRole(httpcc.Delete))
+
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
 		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
@@ -370,6 +378,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresRunRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
@@ -378,6 +387,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflows/executions/:executionID", wec.Show)
+		authv2.POST("/workflows/executions/:executionID/cancel", auth.RequiresEditRole(wec.Cancel))
+		authv2.POST("/workflows/executions/:executionID/replay", auth.RequiresEditRole(wec.Replay))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
@@ -0,0 +1,194 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController exposes the execution history of workflows.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index lists workflow executions, newest first. Results can be narrowed with the workflowID, status (repeated or
+// comma separated), createdAfter and createdBefore (RFC3339) query parameters.
+// Example:
+// "GET <application>/workflows/executions?workflowID=<id>&status=errored,timeout"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseExecutionFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().ListExecutions(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResources(executions)
+	paginatedResponse(c, "workflowExecutions", size, page, res, count, err)
+}
+
+// Show returns a workflow execution along with the state of its steps.
+// Example:
+// "GET <application>/workflows/executions/:executionID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), c.Param("executionID"))
+	if errors.Is(err, store.ErrExecutionNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution")
+}
+
+// Cancel stops a workflow execution which is in progress. Steps which have not finished are marked as cancelled.
+// Example:
+// "POST <application>/workflows/executions/:executionID/cancel"
+func (wec *WorkflowExecutionsController) Cancel(c *gin.Context) {
+	ctx := c.Request.Context()
+	executionID := c.Param("executionID")
+	engine, ok := wec.engineForExecution(c, executionID)
+	if !ok {
+		return
+	}
+
+	if err := engine.CancelExecution(ctx, executionID); err != nil {
+		jsonAPIError(c, executionErrorStatus(err), err)
+		return
+	}
+	wec.App.GetAuditLogger().Audit(audit.WorkflowExecutionCancelled, map[string]interface{}{"executionID": executionID})
+
+	execution, err := wec.App.WorkflowORM().Get(ctx, executionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution")
+}
+
+// Replay starts a new execution of the workflow with the trigger output of a finished execution, and returns the new
+// execution. With fromFailedStep=true the completed steps are carried over, and only the remaining steps are run.
+// Example:
+// "POST <application>/workflows/executions/:executionID/replay?fromFailedStep=true"
+func (wec *WorkflowExecutionsController) Replay(c *gin.Context) {
+	ctx := c.Request.Context()
+	executionID := c.Param("executionID")
+
+	var fromFailedStep bool
+	if raw := c.Query("fromFailedStep"); raw != "" {
+		var err error
+		if fromFailedStep, err = strconv.ParseBool(raw); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid fromFailedStep"))
+			return
+		}
+	}
+
+	engine, ok := wec.engineForExecution(c, executionID)
+	if !ok {
+		return
+	}
+
+	replayID, err := engine.ReplayExecution(ctx, executionID, fromFailedStep)
+	if err != nil {
+		jsonAPIError(c, executionErrorStatus(err), err)
+		return
+	}
+	wec.App.GetAuditLogger().Audit(audit.WorkflowExecutionReplayed, map[string]interface{}{
+		"executionID":    executionID,
+		"replayID":       replayID,
+		"fromFailedStep": fromFailedStep,
+	})
+
+	execution, err := wec.App.WorkflowORM().Get(ctx, replayID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution", http.StatusCreated)
+}
+
+// engineForExecution looks up the running engine of the execution's workflow, and renders an error if there is none.
+func (wec *WorkflowExecutionsController) engineForExecution(c *gin.Context, executionID string) (*workflows.Engine, bool) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), executionID)
+	if err != nil {
+		jsonAPIError(c, executionErrorStatus(err), err)
+		return nil, false
+	}
+
+	engine, err := wec.App.WorkflowEngines().Get(execution.WorkflowID)
+	if err != nil {
+		jsonAPIError(c, executionErrorStatus(err), err)
+		return nil, false
+	}
+	return engine, true
+}
+
+func executionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, store.ErrExecutionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, store.ErrExecutionFinished), errors.Is(err, workflows.ErrExecutionRunning), errors.Is(err, workflows.ErrNothingToReplay):
+		return http.StatusConflict
+	case errors.Is(err, workflows.ErrEngineNotFound):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func parseExecutionFilter(c *gin.Context) (store.ExecutionFilter, error) {
+	filter := store.ExecutionFilter{WorkflowID: c.Query("workflowID")}
+	for _, param := range c.QueryArray("status") {
+		for _, status := range strings.Split(param, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filter.Statuses = append(filter.Statuses, status)
+			}
+		}
+	}
+	for _, status := range filter.Statuses {
+		if !store.ValidStatuses[status] {
+			return filter, errors.Errorf("invalid status %q", status)
+		}
+	}
+
+	var err error
+	if filter.CreatedAfter, err = parseTimeQuery(c, "createdAfter"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedBefore, err = parseTimeQuery(c, "createdBefore"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+func parseTimeQuery(c *gin.Context, key string) (*time.Time, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", key)
+	}
+	return &t, nil
+}
@@ -0,0 +1,118 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+	ctx := testutils.Context(t)
+
+	workflowID := uuid.NewString()
+	_, err := app.GetDB().ExecContext(ctx, `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at)
+	VALUES ('', $1, 'owner', 'name', NOW(), NOW())`, workflowID)
+	require.NoError(t, err)
+
+	add := func(status string) string {
+		id := uuid.NewString()
+		_, err = app.WorkflowORM().Add(ctx, &store.WorkflowExecution{
+			ExecutionID: id,
+			WorkflowID:  workflowID,
+			Status:      status,
+			Steps: map[string]*store.WorkflowExecutionStep{
+				"trigger": {ExecutionID: id, Ref: "trigger", Status: status, Inputs: values.EmptyMap()},
+			},
+		})
+		require.NoError(t, err)
+		return id
+	}
+	errored := add(store.StatusErrored)
+	add(store.StatusCompleted)
+
+	t.Run("index", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?workflowID=" + workflowID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		body := cltest.ParseResponseBody(t, resp)
+		count, err := cltest.ParseJSONAPIResponseMetaCount(body)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("index by status", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?status=errored,timeout&workflowID=" + workflowID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, errored, resources[0].ID)
+		assert.Equal(t, store.StatusErrored, resources[0].Status)
+	})
+
+	t.Run("index with invalid filter", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?status=bogus")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		resp, cleanup = client.Get("/v2/workflows/executions?createdAfter=yesterday")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/" + errored)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resource presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+		assert.Equal(t, errored, resource.ID)
+		assert.Equal(t, workflowID, resource.WorkflowID)
+		require.Len(t, resource.Steps, 1)
+		assert.Equal(t, "trigger", resource.Steps[0].Ref)
+		assert.JSONEq(t, `{}`, string(resource.Steps[0].Inputs))
+	})
+
+	t.Run("show not found", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/" + uuid.NewString())
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("cancel not found", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/"+uuid.NewString()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("replay without running engine", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/"+errored+"/replay?fromFailedStep=true", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("replay with invalid flag", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/"+errored+"/replay?fromFailedStep=maybe", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}